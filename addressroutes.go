@@ -0,0 +1,114 @@
+package here
+
+import (
+	"context"
+	"fmt"
+
+	"go.einride.tech/here/geocodingv7"
+	"go.einride.tech/here/routingv8"
+)
+
+// AddressRoutesRequest is a routes request with free-text origin and destination, e.g. addresses.
+type AddressRoutesRequest struct {
+	// Origin to geocode, e.g. "Invalidenstraße 116, Berlin".
+	Origin string
+	// Destination to geocode.
+	Destination string
+	// Geocode is the template of the geocode requests, e.g. to limit the countries. The query and the limit are set
+	// for each waypoint. The destination is geocoded near the origin unless a position is set.
+	Geocode geocodingv7.GeocodeRequest
+	// Routes is the template of the routes request. The origin and the destination are set to the resolved places.
+	Routes routingv8.RoutesRequest
+	// MinConfidence rejects resolved places with a lower confidence, between 0 and 1. Zero accepts any match.
+	MinConfidence float64
+}
+
+// ResolvedPlace is a free-text waypoint resolved by geocoding.
+type ResolvedPlace struct {
+	// Query of the waypoint.
+	Query string
+	// Item is the best match of the query.
+	Item geocodingv7.Item
+	// Confidence of the match between 0 and 1, the query score of the item. Zero if the item is not scored.
+	Confidence float64
+	// Location routed to, the first access position of the item or its position if it has no access positions.
+	Location routingv8.GeoWaypoint
+}
+
+// AddressRoutesResponse contains the routes between the resolved places.
+type AddressRoutesResponse struct {
+	Origin      ResolvedPlace
+	Destination ResolvedPlace
+	Routes      *routingv8.RoutesResponse
+}
+
+// AddressRoutes geocodes the origin and the destination of the request and returns the routes between the best
+// matches, together with the resolved places to let callers confirm that the right places were routed between.
+func (c *Client) AddressRoutes(
+	ctx context.Context,
+	req *AddressRoutesRequest,
+) (_ *AddressRoutesResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("address routes: %w", err)
+		}
+	}()
+	origin, err := c.resolvePlace(ctx, "origin", req.Origin, req.Geocode, req.MinConfidence)
+	if err != nil {
+		return nil, err
+	}
+	destinationGeocode := req.Geocode
+	if destinationGeocode.At == nil {
+		destinationGeocode.At = &geocodingv7.GeoWaypoint{Lat: origin.Location.Lat, Long: origin.Location.Long}
+	}
+	destination, err := c.resolvePlace(ctx, "destination", req.Destination, destinationGeocode, req.MinConfidence)
+	if err != nil {
+		return nil, err
+	}
+	routesRequest := req.Routes
+	routesRequest.Origin = origin.Location
+	routesRequest.Destination = destination.Location
+	routes, err := c.Routing().Routes(ctx, &routesRequest)
+	if err != nil {
+		return nil, err
+	}
+	return &AddressRoutesResponse{Origin: *origin, Destination: *destination, Routes: routes}, nil
+}
+
+func (c *Client) resolvePlace(
+	ctx context.Context,
+	name string,
+	query string,
+	geocode geocodingv7.GeocodeRequest,
+	minConfidence float64,
+) (*ResolvedPlace, error) {
+	if query == "" {
+		return nil, fmt.Errorf("invalid %s: empty", name)
+	}
+	geocode.Q = query
+	geocode.QualifiedQuery = nil
+	geocode.Limit = 1
+	resp, err := c.Search().Geocode(ctx, &geocode)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+	if len(resp.Items) == 0 {
+		return nil, fmt.Errorf("%s: no match for %q", name, query)
+	}
+	item := resp.Items[0]
+	place := ResolvedPlace{Query: query, Item: item}
+	if item.Scoring != nil {
+		place.Confidence = item.Scoring.QueryScore
+	}
+	if place.Confidence < minConfidence {
+		return nil, fmt.Errorf(
+			"%s: match %q for %q has confidence %v, below %v", name, item.Title, query, place.Confidence, minConfidence,
+		)
+	}
+	position := item.Position
+	if len(item.Access) > 0 {
+		position = item.Access[0]
+	}
+	place.Location = routingv8.GeoWaypoint{Lat: position.Lat, Long: position.Long}
+	return &place, nil
+}
@@ -0,0 +1,81 @@
+package here_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"go.einride.tech/here"
+	"go.einride.tech/here/geocodingv7"
+	"go.einride.tech/here/routingv8"
+	"gotest.tools/v3/assert"
+)
+
+func TestClient_AddressRoutes(t *testing.T) {
+	t.Parallel()
+	var routesQuery, destinationQuery url.Values
+	client := here.NewClient(httpClientFunc(func(req *http.Request) (*http.Response, error) {
+		body := `{"routes": [{"id": "r1", "sections": []}]}`
+		if req.URL.Host == "geocode.search.hereapi.com" {
+			switch req.URL.Query().Get("q") {
+			case "Lindholmspiren 3, Göteborg":
+				body = `{"items": [{
+					"title": "Lindholmspiren 3, 417 56 Göteborg, Sverige",
+					"position": {"lat": 57.7063, "lng": 11.9383},
+					"access": [{"lat": 57.7061, "lng": 11.9385}],
+					"scoring": {"queryScore": 0.98}
+				}]}`
+			case "Kungsgatan 1, Stockholm":
+				destinationQuery = req.URL.Query()
+				body = `{"items": [{
+					"title": "Kungsgatan 1, 111 43 Stockholm, Sverige",
+					"position": {"lat": 59.3349, "lng": 18.0686},
+					"scoring": {"queryScore": 0.9}
+				}]}`
+			default:
+				body = `{"items": []}`
+			}
+		} else {
+			routesQuery = req.URL.Query()
+		}
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(body))}, nil
+	}))
+	resp, err := client.AddressRoutes(context.Background(), &here.AddressRoutesRequest{
+		Origin:        "Lindholmspiren 3, Göteborg",
+		Destination:   "Kungsgatan 1, Stockholm",
+		Geocode:       geocodingv7.GeocodeRequest{InCountries: []string{"SWE"}},
+		Routes:        routingv8.RoutesRequest{TransportMode: routingv8.TransportModeTruck},
+		MinConfidence: 0.8,
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 0.98, resp.Origin.Confidence)
+	assert.Equal(t, routingv8.GeoWaypoint{Lat: 57.7061, Long: 11.9385}, resp.Origin.Location)
+	assert.Equal(t, "Kungsgatan 1, 111 43 Stockholm, Sverige", resp.Destination.Item.Title)
+	assert.Equal(t, "57.7061,11.9385", destinationQuery.Get("at"))
+	assert.Equal(t, "countryCode:SWE", destinationQuery.Get("in"))
+	assert.Equal(t, "1", destinationQuery.Get("limit"))
+	assert.Equal(t, "57.7061,11.9385", routesQuery.Get("origin"))
+	assert.Equal(t, "59.3349,18.0686", routesQuery.Get("destination"))
+	assert.Equal(t, "truck", routesQuery.Get("transportMode"))
+	assert.Equal(t, "r1", resp.Routes.Routes[0].ID)
+
+	_, err = client.AddressRoutes(context.Background(), &here.AddressRoutesRequest{
+		Origin:      "Lindholmspiren 3, Göteborg",
+		Destination: "Nowhere",
+	})
+	assert.Error(t, err, `address routes: destination: no match for "Nowhere"`)
+	_, err = client.AddressRoutes(context.Background(), &here.AddressRoutesRequest{
+		Origin:        "Kungsgatan 1, Stockholm",
+		Destination:   "Lindholmspiren 3, Göteborg",
+		MinConfidence: 0.95,
+	})
+	assert.Error(
+		t,
+		err,
+		`address routes: origin: match "Kungsgatan 1, 111 43 Stockholm, Sverige" for "Kungsgatan 1, Stockholm" `+
+			`has confidence 0.9, below 0.95`,
+	)
+}
@@ -0,0 +1,223 @@
+// Package bulk runs large numbers of API requests, such as geocoding or routing backfills, with bounded
+// concurrency, rate limiting, retries and checkpointing of progress.
+package bulk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.einride.tech/here/internal/hereapi"
+	"go.einride.tech/here/routingv8"
+)
+
+// Task is a unit of work of a bulk run.
+type Task struct {
+	// Key uniquely identifies the task across runs, e.g. the ID of the geocoded record.
+	Key string
+	// Do performs the task. The context carries the Checkpoint of the task, see CheckpointFromContext.
+	Do func(ctx context.Context) error
+}
+
+// Orchestrator runs tasks concurrently while respecting a rate limit, and checkpoints completed tasks to a Store.
+type Orchestrator struct {
+	// Concurrency is the maximum number of tasks running at a time. Defaults to 1.
+	Concurrency int
+	// RequestsPerSecond limits the rate at which task attempts are started. Zero means no limit.
+	RequestsPerSecond float64
+	// MaxAttempts of each task, including the first one. Defaults to 1.
+	MaxAttempts int
+	// Backoff is the delay before the first retry of a task, doubled for every following retry. The delay suggested
+	// by the server in the Retry-After header of a failed request is used instead, if present.
+	Backoff time.Duration
+	// Retryable decides whether a failed task is retried. Defaults to routingv8.IsRetryable. Cancellation of the
+	// context is never retried.
+	Retryable func(err error) bool
+	// Store for checkpointing completed tasks. If it implements StateStore, it also holds the checkpoints of the
+	// tasks, otherwise loading and saving checkpoints fails. Defaults to an in-memory store, which does not survive
+	// a restart.
+	Store Store
+	// Clock used for the rate limit and the backoff. Defaults to routingv8.SystemClock.
+	Clock routingv8.Clock
+
+	mu   sync.Mutex
+	next time.Time
+}
+
+// Report summarizes a bulk run.
+type Report struct {
+	// Completed is the number of tasks completed by the run.
+	Completed int
+	// Skipped is the number of tasks skipped because they completed in an earlier run.
+	Skipped int
+	// Failed maps the keys of the failed tasks to their last error.
+	Failed map[string]error
+}
+
+// Run runs the tasks and returns a report of the run. An error is returned if the context is done or the store
+// fails, failed tasks are reported in the report. The checkpoints of completed tasks are deleted.
+func (o *Orchestrator) Run(ctx context.Context, tasks []Task) (*Report, error) {
+	store := o.Store
+	if store == nil {
+		store = NewMemoryStore()
+	}
+	states, _ := store.(StateStore)
+	concurrency := o.Concurrency
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	report := &Report{Failed: map[string]error{}}
+	var mu sync.Mutex
+	var runErr error
+	fail := func(err error) {
+		mu.Lock()
+		defer mu.Unlock()
+		if runErr == nil {
+			runErr = err
+		}
+		cancel()
+	}
+	queue := make(chan Task)
+	var wg sync.WaitGroup
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for task := range queue {
+				completed, err := store.Completed(ctx, task.Key)
+				if err != nil {
+					fail(fmt.Errorf("bulk run: task %s: %v", task.Key, err))
+					continue
+				}
+				if completed {
+					mu.Lock()
+					report.Skipped++
+					mu.Unlock()
+					continue
+				}
+				checkpoint := &Checkpoint{key: task.Key, store: states, storeType: fmt.Sprintf("%T", store)}
+				if err := o.do(withCheckpoint(ctx, checkpoint), task); err != nil {
+					mu.Lock()
+					report.Failed[task.Key] = err
+					mu.Unlock()
+					continue
+				}
+				if err := store.MarkCompleted(ctx, task.Key); err != nil {
+					fail(fmt.Errorf("bulk run: task %s: %v", task.Key, err))
+					continue
+				}
+				// The checkpoint is deleted after the task is marked as completed, a restart in between must not
+				// repeat the task.
+				if states != nil {
+					if err := states.DeleteState(ctx, task.Key); err != nil {
+						fail(fmt.Errorf("bulk run: task %s: %v", task.Key, err))
+						continue
+					}
+				}
+				mu.Lock()
+				report.Completed++
+				mu.Unlock()
+			}
+		}()
+	}
+Tasks:
+	for _, task := range tasks {
+		select {
+		case queue <- task:
+		case <-ctx.Done():
+			break Tasks
+		}
+	}
+	close(queue)
+	wg.Wait()
+	if runErr != nil {
+		return report, runErr
+	}
+	if err := ctx.Err(); err != nil {
+		return report, fmt.Errorf("bulk run: %v", err)
+	}
+	return report, nil
+}
+
+// do runs a task until it succeeds, fails with an error that is not retryable or the attempts are exhausted.
+func (o *Orchestrator) do(ctx context.Context, task Task) error {
+	maxAttempts := o.MaxAttempts
+	if maxAttempts < 1 {
+		maxAttempts = 1
+	}
+	backoff := o.Backoff
+	var err error
+	for attempt := 0; attempt < maxAttempts; attempt++ {
+		if attempt > 0 {
+			delay := backoff
+			var responseErr *hereapi.ResponseError
+			if errors.As(err, &responseErr) && responseErr.RetryAfter > 0 {
+				delay = responseErr.RetryAfter
+			}
+			if err := sleep(ctx, o.clock(), delay); err != nil {
+				return err
+			}
+			backoff *= 2
+		}
+		if err := o.wait(ctx); err != nil {
+			return err
+		}
+		if err = task.Do(ctx); err == nil || !o.retryable(err) {
+			return err
+		}
+	}
+	return err
+}
+
+func (o *Orchestrator) retryable(err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	if o.Retryable != nil {
+		return o.Retryable(err)
+	}
+	return routingv8.IsRetryable(err)
+}
+
+// wait blocks until the rate limit allows another attempt to start.
+func (o *Orchestrator) wait(ctx context.Context) error {
+	if o.RequestsPerSecond <= 0 {
+		return nil
+	}
+	interval := time.Duration(float64(time.Second) / o.RequestsPerSecond)
+	clock := o.clock()
+	o.mu.Lock()
+	now := clock.Now()
+	start := o.next
+	if start.Before(now) {
+		start = now
+	}
+	o.next = start.Add(interval)
+	o.mu.Unlock()
+	return sleep(ctx, clock, start.Sub(now))
+}
+
+func (o *Orchestrator) clock() routingv8.Clock {
+	if o.Clock != nil {
+		return o.Clock
+	}
+	return routingv8.SystemClock{}
+}
+
+func sleep(ctx context.Context, clock routingv8.Clock, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	timer := clock.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C():
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
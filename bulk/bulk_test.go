@@ -0,0 +1,224 @@
+package bulk_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"go.einride.tech/here/bulk"
+	"go.einride.tech/here/routingv8"
+	"gotest.tools/v3/assert"
+)
+
+func TestOrchestrator_Run(t *testing.T) {
+	t.Parallel()
+	store := bulk.NewMemoryStore()
+	assert.NilError(t, store.MarkCompleted(context.Background(), "0"))
+	var mu sync.Mutex
+	attempts := map[string]int{}
+	tasks := make([]bulk.Task, 0, 5)
+	for i := 0; i < 5; i++ {
+		key := strconv.Itoa(i)
+		tasks = append(tasks, bulk.Task{
+			Key: key,
+			Do: func(ctx context.Context) error {
+				mu.Lock()
+				defer mu.Unlock()
+				attempts[key]++
+				switch {
+				case key == "3" && attempts[key] == 1:
+					return errors.New("rate limited")
+				case key == "4":
+					return errors.New("invalid input")
+				}
+				return nil
+			},
+		})
+	}
+	clock := &fakeClock{}
+	orchestrator := &bulk.Orchestrator{
+		Concurrency: 2,
+		MaxAttempts: 3,
+		Backoff:     time.Second,
+		Store:       store,
+		Clock:       clock,
+		Retryable: func(err error) bool {
+			return err.Error() == "rate limited"
+		},
+	}
+	report, err := orchestrator.Run(context.Background(), tasks)
+	assert.NilError(t, err)
+	assert.Equal(t, 3, report.Completed)
+	assert.Equal(t, 1, report.Skipped)
+	assert.Equal(t, 1, len(report.Failed))
+	assert.Error(t, report.Failed["4"], "invalid input")
+	assert.DeepEqual(t, map[string]int{"1": 1, "2": 1, "3": 2, "4": 1}, attempts)
+	assert.DeepEqual(t, []time.Duration{time.Second}, clock.waits)
+	completed, err := store.Completed(context.Background(), "3")
+	assert.NilError(t, err)
+	assert.Assert(t, completed)
+}
+
+func TestOrchestrator_Run_DefaultRetryable(t *testing.T) {
+	t.Parallel()
+	var requests int
+	client := routingv8.NewClient(httpClientFunc(func(req *http.Request) (*http.Response, error) {
+		requests++
+		if requests == 1 {
+			return &http.Response{
+				StatusCode: http.StatusTooManyRequests,
+				Header:     http.Header{"Retry-After": []string{"7"}},
+				Body:       io.NopCloser(strings.NewReader(`{"title":"Too Many Requests","status":429}`)),
+			}, nil
+		}
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(`{"routes":[]}`))}, nil
+	}))
+	var invalidAttempts int
+	tasks := []bulk.Task{
+		{
+			Key: "route",
+			Do: func(ctx context.Context) error {
+				_, err := client.Routing.Routes(ctx, &routingv8.RoutesRequest{TransportMode: routingv8.TransportModeCar})
+				return err
+			},
+		},
+		{
+			Key: "invalid",
+			Do: func(ctx context.Context) error {
+				invalidAttempts++
+				return errors.New("invalid input")
+			},
+		},
+	}
+	clock := &fakeClock{}
+	orchestrator := &bulk.Orchestrator{MaxAttempts: 3, Backoff: time.Second, Clock: clock}
+	report, err := orchestrator.Run(context.Background(), tasks)
+	assert.NilError(t, err)
+	assert.Equal(t, 1, report.Completed)
+	assert.Equal(t, 2, requests)
+	assert.Equal(t, 1, invalidAttempts)
+	assert.Error(t, report.Failed["invalid"], "invalid input")
+	assert.DeepEqual(t, []time.Duration{7 * time.Second}, clock.waits)
+}
+
+type httpClientFunc func(*http.Request) (*http.Response, error)
+
+func (f httpClientFunc) Do(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestOrchestrator_Run_RateLimit(t *testing.T) {
+	t.Parallel()
+	tasks := make([]bulk.Task, 0, 5)
+	for i := 0; i < 5; i++ {
+		tasks = append(tasks, bulk.Task{Key: strconv.Itoa(i), Do: func(ctx context.Context) error { return nil }})
+	}
+	clock := &fakeClock{}
+	orchestrator := &bulk.Orchestrator{Concurrency: 5, RequestsPerSecond: 100, Clock: clock}
+	report, err := orchestrator.Run(context.Background(), tasks)
+	assert.NilError(t, err)
+	assert.Equal(t, 5, report.Completed)
+	// The clock does not advance, so the attempts are scheduled 10 milliseconds apart from the first one.
+	sort.Slice(clock.waits, func(i, j int) bool { return clock.waits[i] < clock.waits[j] })
+	assert.DeepEqual(
+		t,
+		[]time.Duration{10 * time.Millisecond, 20 * time.Millisecond, 30 * time.Millisecond, 40 * time.Millisecond},
+		clock.waits,
+	)
+}
+
+func TestOrchestrator_Run_Checkpoint(t *testing.T) {
+	t.Parallel()
+	store := bulk.NewMemoryStore()
+	var submitted int
+	task := bulk.Task{
+		Key: "matrix",
+		Do: func(ctx context.Context) error {
+			checkpoint := bulk.CheckpointFromContext(ctx)
+			matrixID, ok, err := checkpoint.Load(ctx)
+			if err != nil {
+				return err
+			}
+			if !ok {
+				submitted++
+				matrixID = []byte("matrix-" + strconv.Itoa(submitted))
+				if err := checkpoint.Save(ctx, matrixID); err != nil {
+					return err
+				}
+				// Simulate a crash of the worker after submitting the calculation.
+				return errors.New("worker stopped")
+			}
+			if string(matrixID) != "matrix-1" {
+				return errors.New("unexpected matrix ID")
+			}
+			return nil
+		},
+	}
+	orchestrator := &bulk.Orchestrator{Store: store}
+	report, err := orchestrator.Run(context.Background(), []bulk.Task{task})
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(report.Failed))
+	report, err = orchestrator.Run(context.Background(), []bulk.Task{task})
+	assert.NilError(t, err)
+	assert.Equal(t, 1, report.Completed)
+	assert.Equal(t, 1, submitted)
+	_, ok, err := store.LoadState(context.Background(), "matrix")
+	assert.NilError(t, err)
+	assert.Assert(t, !ok, "checkpoint of completed task is deleted")
+}
+
+func TestOrchestrator_Run_CheckpointUnsupported(t *testing.T) {
+	t.Parallel()
+	task := bulk.Task{
+		Key: "matrix",
+		Do: func(ctx context.Context) error {
+			return bulk.CheckpointFromContext(ctx).Save(ctx, []byte("matrix-1"))
+		},
+	}
+	orchestrator := &bulk.Orchestrator{Store: completedStore{}}
+	report, err := orchestrator.Run(context.Background(), []bulk.Task{task})
+	assert.NilError(t, err)
+	assert.Error(
+		t,
+		report.Failed["matrix"],
+		"checkpoint matrix: store bulk_test.completedStore does not implement StateStore",
+	)
+}
+
+// completedStore is a Store without checkpoints, in which no task has completed.
+type completedStore struct{}
+
+func (completedStore) Completed(context.Context, string) (bool, error) { return false, nil }
+func (completedStore) MarkCompleted(context.Context, string) error     { return nil }
+
+// fakeClock is a routingv8.Clock which does not advance, fires timers immediately and records their durations.
+type fakeClock struct {
+	mu    sync.Mutex
+	waits []time.Duration
+}
+
+func (f *fakeClock) Now() time.Time {
+	return time.Time{}
+}
+
+func (f *fakeClock) NewTimer(d time.Duration) routingv8.Timer {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.waits = append(f.waits, d)
+	fired := make(firedTimer, 1)
+	fired <- time.Time{}
+	return fired
+}
+
+// firedTimer is a routingv8.Timer which has already fired.
+type firedTimer chan time.Time
+
+func (t firedTimer) C() <-chan time.Time { return t }
+func (t firedTimer) Stop() bool          { return false }
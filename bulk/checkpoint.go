@@ -0,0 +1,60 @@
+package bulk
+
+import (
+	"context"
+	"fmt"
+)
+
+// StateStore persists the intermediate state of tasks, such as the IDs of submitted asynchronous calculations or
+// polling cursors, so that a restarted run resumes a task instead of resubmitting billable requests.
+// A Store used by an Orchestrator which also implements StateStore is used for the checkpoints of its tasks.
+type StateStore interface {
+	// LoadState returns the state saved for the key, and false if there is none.
+	LoadState(ctx context.Context, key string) ([]byte, bool, error)
+	// SaveState saves the state for the key, replacing any previous state.
+	SaveState(ctx context.Context, key string, state []byte) error
+	// DeleteState deletes the state of the key, if any.
+	DeleteState(ctx context.Context, key string) error
+}
+
+// Checkpoint loads and saves the state of a running task.
+type Checkpoint struct {
+	key   string
+	store StateStore
+	// storeType is the type of a store which does not implement StateStore, for errors.
+	storeType string
+}
+
+// Load returns the state saved by an earlier attempt or run of the task, and false if there is none. Load fails if
+// the Store of the Orchestrator does not implement StateStore.
+func (c *Checkpoint) Load(ctx context.Context) ([]byte, bool, error) {
+	if c.store == nil {
+		return nil, false, c.unsupported()
+	}
+	return c.store.LoadState(ctx, c.key)
+}
+
+// Save saves the state of the task. Save fails if the Store of the Orchestrator does not implement StateStore.
+func (c *Checkpoint) Save(ctx context.Context, state []byte) error {
+	if c.store == nil {
+		return c.unsupported()
+	}
+	return c.store.SaveState(ctx, c.key, state)
+}
+
+func (c *Checkpoint) unsupported() error {
+	return fmt.Errorf("checkpoint %s: store %s does not implement StateStore", c.key, c.storeType)
+}
+
+type checkpointContextKey struct{}
+
+func withCheckpoint(ctx context.Context, checkpoint *Checkpoint) context.Context {
+	return context.WithValue(ctx, checkpointContextKey{}, checkpoint)
+}
+
+// CheckpointFromContext returns the checkpoint of the task running with the context, nil if the context does not
+// belong to a task of an Orchestrator.
+func CheckpointFromContext(ctx context.Context) *Checkpoint {
+	checkpoint, _ := ctx.Value(checkpointContextKey{}).(*Checkpoint)
+	return checkpoint
+}
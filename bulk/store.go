@@ -0,0 +1,70 @@
+package bulk
+
+import (
+	"context"
+	"sync"
+)
+
+// Store records the progress of a bulk run, so that a restarted run skips the tasks that already completed.
+type Store interface {
+	// Completed reports whether the task with the key has completed.
+	Completed(ctx context.Context, key string) (bool, error)
+	// MarkCompleted records that the task with the key has completed.
+	MarkCompleted(ctx context.Context, key string) error
+}
+
+// MemoryStore is a Store kept in memory, for tests and runs that do not need to survive a restart.
+type MemoryStore struct {
+	mu        sync.Mutex
+	completed map[string]bool
+	states    map[string][]byte
+}
+
+var (
+	_ Store      = &MemoryStore{}
+	_ StateStore = &MemoryStore{}
+)
+
+// NewMemoryStore returns an empty MemoryStore.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{completed: map[string]bool{}, states: map[string][]byte{}}
+}
+
+// Completed implements Store.
+func (s *MemoryStore) Completed(_ context.Context, key string) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.completed[key], nil
+}
+
+// MarkCompleted implements Store.
+func (s *MemoryStore) MarkCompleted(_ context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.completed[key] = true
+	return nil
+}
+
+// LoadState implements StateStore.
+func (s *MemoryStore) LoadState(_ context.Context, key string) ([]byte, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	state, ok := s.states[key]
+	return state, ok, nil
+}
+
+// SaveState implements StateStore.
+func (s *MemoryStore) SaveState(_ context.Context, key string, state []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.states[key] = append([]byte(nil), state...)
+	return nil
+}
+
+// DeleteState implements StateStore.
+func (s *MemoryStore) DeleteState(_ context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.states, key)
+	return nil
+}
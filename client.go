@@ -0,0 +1,219 @@
+// Package here provides a single entry point to the HERE APIs.
+package here
+
+import (
+	"net/http"
+	"sync"
+
+	"go.einride.tech/here/geocodingv7"
+	"go.einride.tech/here/geofencing"
+	"go.einride.tech/here/intermodalv8"
+	"go.einride.tech/here/isolinev8"
+	"go.einride.tech/here/mapimage"
+	"go.einride.tech/here/maptile"
+	"go.einride.tech/here/parkingv2"
+	"go.einride.tech/here/routematching"
+	"go.einride.tech/here/routingv8"
+	"go.einride.tech/here/trafficv7"
+	"go.einride.tech/here/transitv8"
+	"go.einride.tech/here/waypointsequence"
+)
+
+type HTTPClient interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Client gives access to the services of all HERE APIs. The API clients are created on first use and shared by
+// all callers, so that a Client can be created up front and passed around.
+type Client struct {
+	// HTTP client used to communicate with the APIs.
+	client HTTPClient
+
+	// ProjectHRN scopes the requests of all services to a HERE platform project, see routingv8.Client.ProjectHRN.
+	ProjectHRN string
+
+	// Realm of the HERE platform organization, sent with the requests of all services.
+	Realm string
+
+	mu       sync.Mutex
+	versions map[Service]string
+	shims    []CompatibilityShim
+
+	routingOnce sync.Once
+	routing     *routingv8.Client
+
+	geocodingOnce sync.Once
+	geocoding     *geocodingv7.Client
+
+	parkingOnce sync.Once
+	parking     *parkingv2.Client
+
+	isolineOnce sync.Once
+	isoline     *isolinev8.Client
+
+	trafficOnce sync.Once
+	traffic     *trafficv7.Client
+
+	transitOnce sync.Once
+	transit     *transitv8.Client
+
+	intermodalOnce sync.Once
+	intermodal     *intermodalv8.Client
+
+	waypointSequenceOnce sync.Once
+	waypointSequence     *waypointsequence.Client
+
+	geofencingOnce sync.Once
+	geofencing     *geofencing.Client
+
+	routeMatchingOnce sync.Once
+	routeMatching     *routematching.Client
+
+	mapImageOnce sync.Once
+	mapImage     *mapimage.Client
+
+	mapTileOnce sync.Once
+	mapTile     *maptile.Client
+}
+
+// NewClient returns a new HERE API Client. If a nil httpClient is
+// provided, a new http.Client will be used. To use API methods which require
+// authentication, provide an http.Client that will perform the authentication
+// for you (such as that provided by the golang.org/x/oauth2 library).
+func NewClient(httpClient HTTPClient) *Client {
+	if httpClient == nil {
+		httpClient = &http.Client{}
+	}
+	return &Client{client: httpClient}
+}
+
+// RoutingClient returns the client of the Routing API v8, which can be used to configure retries and other options
+// shared by the Routing and Matrix services.
+func (c *Client) RoutingClient() *routingv8.Client {
+	c.routingOnce.Do(func() {
+		c.routing = routingv8.NewClient(shimClient{c: c})
+		c.routing.Routing.URL = c.versionURL(ServiceRouting, c.routing.Routing.URL)
+		c.routing.Matrix.URL = c.versionURL(ServiceMatrix, c.routing.Matrix.URL)
+	})
+	return c.routing
+}
+
+// Routing returns the routing service of the Routing API v8.
+func (c *Client) Routing() *routingv8.RoutingService {
+	return c.RoutingClient().Routing
+}
+
+// Matrix returns the matrix service of the Routing API v8.
+func (c *Client) Matrix() *routingv8.MatrixService {
+	return c.RoutingClient().Matrix
+}
+
+// Search returns the search service of the Geocoding and Search API v7.
+func (c *Client) Search() *geocodingv7.SearchService {
+	c.geocodingOnce.Do(func() {
+		c.geocoding = geocodingv7.NewClient(shimClient{c: c})
+		c.geocoding.Search.URL = c.versionURL(ServiceSearch, c.geocoding.Search.URL)
+	})
+	return c.geocoding.Search
+}
+
+// Parking returns the parking service of the Parking API v2.
+func (c *Client) Parking() *parkingv2.ParkingService {
+	c.parkingOnce.Do(func() {
+		c.parking = parkingv2.NewClient(shimClient{c: c})
+		c.parking.Parking.URL = c.versionURL(ServiceParking, c.parking.Parking.URL)
+	})
+	return c.parking.Parking
+}
+
+// Isoline returns the isoline service of the Isoline Routing API v8.
+func (c *Client) Isoline() *isolinev8.IsolineService {
+	c.isolineOnce.Do(func() {
+		c.isoline = isolinev8.NewClient(shimClient{c: c})
+		c.isoline.Isoline.URL = c.versionURL(ServiceIsoline, c.isoline.Isoline.URL)
+	})
+	return c.isoline.Isoline
+}
+
+// Traffic returns the traffic service of the Traffic API v7.
+func (c *Client) Traffic() *trafficv7.TrafficService {
+	c.trafficOnce.Do(func() {
+		c.traffic = trafficv7.NewClient(shimClient{c: c})
+		c.traffic.Traffic.URL = c.versionURL(ServiceTraffic, c.traffic.Traffic.URL)
+	})
+	return c.traffic.Traffic
+}
+
+// Transit returns the transit service of the Public Transit API v8.
+func (c *Client) Transit() *transitv8.TransitService {
+	c.transitOnce.Do(func() {
+		c.transit = transitv8.NewClient(shimClient{c: c})
+		c.transit.Transit.URL = c.versionURL(ServiceTransit, c.transit.Transit.URL)
+	})
+	return c.transit.Transit
+}
+
+// Intermodal returns the intermodal service of the Intermodal Routing API v8.
+func (c *Client) Intermodal() *intermodalv8.IntermodalService {
+	c.intermodalOnce.Do(func() {
+		c.intermodal = intermodalv8.NewClient(shimClient{c: c})
+		c.intermodal.Intermodal.URL = c.versionURL(ServiceIntermodal, c.intermodal.Intermodal.URL)
+	})
+	return c.intermodal.Intermodal
+}
+
+// WaypointSequence returns the sequence service of the Waypoints Sequence API v8.
+func (c *Client) WaypointSequence() *waypointsequence.SequenceService {
+	c.waypointSequenceOnce.Do(func() {
+		c.waypointSequence = waypointsequence.NewClient(shimClient{c: c})
+		c.waypointSequence.Sequence.URL = c.versionURL(ServiceWaypointSequence, c.waypointSequence.Sequence.URL)
+	})
+	return c.waypointSequence.Sequence
+}
+
+// Geofencing returns the geofencing service of the Geofencing API.
+func (c *Client) Geofencing() *geofencing.GeofencingService {
+	c.geofencingOnce.Do(func() {
+		c.geofencing = geofencing.NewClient(shimClient{c: c})
+	})
+	return c.geofencing.Geofencing
+}
+
+// RouteMatching returns the matching service of the Route Matching API v8.
+func (c *Client) RouteMatching() *routematching.MatchingService {
+	c.routeMatchingOnce.Do(func() {
+		c.routeMatching = routematching.NewClient(shimClient{c: c})
+		c.routeMatching.Matching.URL = c.versionURL(ServiceRouteMatching, c.routeMatching.Matching.URL)
+	})
+	return c.routeMatching.Matching
+}
+
+// MapImage returns the map image service of the Map Image API v3.
+func (c *Client) MapImage() *mapimage.MapImageService {
+	c.mapImageOnce.Do(func() {
+		c.mapImage = mapimage.NewClient(shimClient{c: c})
+		c.mapImage.MapImage.URL = c.versionURL(ServiceMapImage, c.mapImage.MapImage.URL)
+	})
+	return c.mapImage.MapImage
+}
+
+// MapTileClient returns the client of the Raster Tile API v3 and Vector Tile API v2, which can be used to configure
+// the tile cache shared by the RasterTiles and VectorTiles services.
+func (c *Client) MapTileClient() *maptile.Client {
+	c.mapTileOnce.Do(func() {
+		c.mapTile = maptile.NewClient(shimClient{c: c})
+		c.mapTile.Raster.URL = c.versionURL(ServiceRasterTile, c.mapTile.Raster.URL)
+		c.mapTile.Vector.URL = c.versionURL(ServiceVectorTile, c.mapTile.Vector.URL)
+	})
+	return c.mapTile
+}
+
+// RasterTiles returns the raster service of the Raster Tile API v3.
+func (c *Client) RasterTiles() *maptile.RasterService {
+	return c.MapTileClient().Raster
+}
+
+// VectorTiles returns the vector service of the Vector Tile API v2.
+func (c *Client) VectorTiles() *maptile.VectorService {
+	return c.MapTileClient().Vector
+}
@@ -0,0 +1,134 @@
+package here_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"go.einride.tech/here"
+	"go.einride.tech/here/geocodingv7"
+	"go.einride.tech/here/geofencing"
+	"go.einride.tech/here/intermodalv8"
+	"go.einride.tech/here/isolinev8"
+	"go.einride.tech/here/mapimage"
+	"go.einride.tech/here/maptile"
+	"go.einride.tech/here/parkingv2"
+	"go.einride.tech/here/routematching"
+	"go.einride.tech/here/routingv8"
+	"go.einride.tech/here/trafficv7"
+	"go.einride.tech/here/transitv8"
+	"go.einride.tech/here/waypointsequence"
+	"gotest.tools/v3/assert"
+)
+
+type httpClientFunc func(*http.Request) (*http.Response, error)
+
+func (f httpClientFunc) Do(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestClient_Lazy(t *testing.T) {
+	t.Parallel()
+	client := here.NewClient(nil)
+	var wg sync.WaitGroup
+	routing := make([]*routingv8.RoutingService, 8)
+	search := make([]*geocodingv7.SearchService, 8)
+	for i := range routing {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			routing[i] = client.Routing()
+			search[i] = client.Search()
+		}(i)
+	}
+	wg.Wait()
+	for i := range routing {
+		assert.Assert(t, routing[i] == routing[0])
+		assert.Assert(t, search[i] == search[0])
+	}
+	assert.Assert(t, client.Matrix().Client == client.Routing().Client)
+	assert.Assert(t, client.Parking() == client.Parking())
+	assert.Assert(t, client.Traffic() == client.Traffic())
+	assert.Assert(t, client.Transit() == client.Transit())
+	assert.Assert(t, client.Intermodal() == client.Intermodal())
+	assert.Assert(t, client.WaypointSequence() == client.WaypointSequence())
+	assert.Assert(t, client.Geofencing() == client.Geofencing())
+	assert.Assert(t, client.RouteMatching() == client.RouteMatching())
+	assert.Assert(t, client.MapImage() == client.MapImage())
+	assert.Assert(t, client.VectorTiles().Client == client.RasterTiles().Client)
+}
+
+func TestClient_SharedHTTPClient(t *testing.T) {
+	t.Parallel()
+	var hosts []string
+	client := here.NewClient(httpClientFunc(func(req *http.Request) (*http.Response, error) {
+		hosts = append(hosts, req.URL.Host)
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(`{}`))}, nil
+	}))
+	ctx := context.Background()
+	_, err := client.Routing().Routes(ctx, &routingv8.RoutesRequest{TransportMode: routingv8.TransportModeCar})
+	assert.NilError(t, err)
+	_, err = client.Search().ReverseGeocode(ctx, &geocodingv7.ReverseGeocodeRequest{})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, []string{"router.hereapi.com", "revgeocode.search.hereapi.com"}, hosts)
+}
+
+func TestClient_PlatformHeaders(t *testing.T) {
+	t.Parallel()
+	var headers []http.Header
+	client := here.NewClient(httpClientFunc(func(req *http.Request) (*http.Response, error) {
+		headers = append(headers, req.Header)
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(`{}`))}, nil
+	}))
+	client.ProjectHRN = "hrn:here:authorization::myrealm:project/my-project"
+	client.Realm = "myrealm"
+	ctx := context.Background()
+	_, err := client.Routing().Routes(ctx, &routingv8.RoutesRequest{TransportMode: routingv8.TransportModeCar})
+	assert.NilError(t, err)
+	_, err = client.Search().ReverseGeocode(ctx, &geocodingv7.ReverseGeocodeRequest{})
+	assert.NilError(t, err)
+	assert.Equal(t, 2, len(headers))
+	for _, header := range headers {
+		assert.Equal(t, "hrn:here:authorization::myrealm:project/my-project", header.Get(routingv8.ProjectHRNHeader))
+		assert.Equal(t, "myrealm", header.Get(routingv8.RealmHeader))
+	}
+
+	headers = nil
+	traffic := trafficv7.NewClient(httpClientFunc(func(req *http.Request) (*http.Response, error) {
+		headers = append(headers, req.Header)
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(`{}`))}, nil
+	}))
+	traffic.ProjectHRN = "hrn:here:authorization::myrealm:project/traffic"
+	traffic.Realm = "myrealm"
+	_, err = traffic.Traffic.Flow(ctx, &trafficv7.TrafficRequest{
+		Area: trafficv7.Area{Circle: &trafficv7.Circle{Center: trafficv7.GeoWaypoint{Lat: 57.7, Long: 11.9}, Radius: 100}},
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(headers))
+	assert.Equal(t, "hrn:here:authorization::myrealm:project/traffic", headers[0].Get(routingv8.ProjectHRNHeader))
+	assert.Equal(t, "myrealm", headers[0].Get(routingv8.RealmHeader))
+}
+
+func TestClient_UserAgent(t *testing.T) {
+	t.Parallel()
+	expected := "einride/here-go/" + routingv8.Version
+	for name, userAgent := range map[string]string{
+		"routingv8":        routingv8.NewClient(nil).UserAgent,
+		"geocodingv7":      geocodingv7.NewClient(nil).UserAgent,
+		"parkingv2":        parkingv2.NewClient(nil).UserAgent,
+		"isolinev8":        isolinev8.NewClient(nil).UserAgent,
+		"trafficv7":        trafficv7.NewClient(nil).UserAgent,
+		"transitv8":        transitv8.NewClient(nil).UserAgent,
+		"intermodalv8":     intermodalv8.NewClient(nil).UserAgent,
+		"waypointsequence": waypointsequence.NewClient(nil).UserAgent,
+		"geofencing":       geofencing.NewClient(nil).UserAgent,
+		"routematching":    routematching.NewClient(nil).UserAgent,
+		"mapimage":         mapimage.NewClient(nil).UserAgent,
+		"maptile":          maptile.NewClient(nil).UserAgent,
+	} {
+		assert.Equal(t, expected, userAgent, name)
+	}
+}
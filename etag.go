@@ -0,0 +1,128 @@
+package here
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httputil"
+	"sync"
+)
+
+// ResponseCache stores responses with an ETag for conditional requests. Implementations must be safe for
+// concurrent use.
+type ResponseCache interface {
+	// Get returns the response stored for key, serialized with httputil.DumpResponse.
+	Get(key string) ([]byte, bool)
+	// Set stores the serialized response for key.
+	Set(key string, response []byte)
+}
+
+// MemoryResponseCache is a ResponseCache in memory.
+type MemoryResponseCache struct {
+	mu        sync.Mutex
+	responses map[string][]byte
+}
+
+var _ ResponseCache = &MemoryResponseCache{}
+
+// NewMemoryResponseCache returns an empty MemoryResponseCache.
+func NewMemoryResponseCache() *MemoryResponseCache {
+	return &MemoryResponseCache{responses: make(map[string][]byte)}
+}
+
+func (c *MemoryResponseCache) Get(key string) ([]byte, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	response, ok := c.responses[key]
+	return response, ok
+}
+
+func (c *MemoryResponseCache) Set(key string, response []byte) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.responses[key] = response
+}
+
+// ConditionalRoundTripper sends conditional GET requests for responses stored in its cache, and serves the cached
+// response when the server responds with 304 Not Modified. Responses are cached when the server sends an ETag,
+// which HERE does for cacheable endpoints such as map tiles, copyrights and some search responses.
+type ConditionalRoundTripper struct {
+	cache ResponseCache
+	next  http.RoundTripper
+
+	// KeyScrubber is applied to the cache keys, which are the URLs of the requests without credentials. The
+	// scrubber has to keep distinct keys distinct, e.g. ScrubHash.
+	KeyScrubber Scrubber
+}
+
+var _ http.RoundTripper = &ConditionalRoundTripper{}
+
+// NewConditionalRoundTripper returns a round tripper caching responses in cache.
+// If next is nil http.DefaultTransport is used.
+func NewConditionalRoundTripper(cache ResponseCache, next http.RoundTripper) *ConditionalRoundTripper {
+	return &ConditionalRoundTripper{cache: cache, next: next}
+}
+
+func (c *ConditionalRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	next := c.next
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	if req.Method != http.MethodGet || req.Header.Get("If-None-Match") != "" {
+		return next.RoundTrip(req)
+	}
+	key := cacheKey(req)
+	if c.KeyScrubber != nil {
+		key = c.KeyScrubber(key)
+	}
+	cached, etag := c.cached(req, key)
+	if etag != "" {
+		req = req.Clone(req.Context())
+		req.Header.Set("If-None-Match", etag)
+	}
+	resp, err := next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case resp.StatusCode == http.StatusNotModified && cached != nil:
+		_ = resp.Body.Close()
+		return cached, nil
+	case resp.StatusCode == http.StatusOK && resp.Header.Get("ETag") != "":
+		dump, err := httputil.DumpResponse(resp, true)
+		if err != nil {
+			return nil, err
+		}
+		c.cache.Set(key, dump)
+	}
+	return resp, nil
+}
+
+// cached returns the cached response for the request and its ETag, or nil if there is none.
+func (c *ConditionalRoundTripper) cached(req *http.Request, key string) (*http.Response, string) {
+	dump, ok := c.cache.Get(key)
+	if !ok {
+		return nil, ""
+	}
+	resp, err := http.ReadResponse(bufio.NewReader(bytes.NewReader(dump)), req)
+	if err != nil {
+		return nil, ""
+	}
+	// Read the body, so that the response can be returned after the conditional request.
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, ""
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	return resp, resp.Header.Get("ETag")
+}
+
+// cacheKey returns the URL of the request without credentials.
+func cacheKey(req *http.Request) string {
+	u := *req.URL
+	query := u.Query()
+	query.Del("apiKey")
+	u.RawQuery = query.Encode()
+	return u.String()
+}
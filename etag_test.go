@@ -0,0 +1,56 @@
+package here_test
+
+import (
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"go.einride.tech/here"
+	"gotest.tools/v3/assert"
+)
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestConditionalRoundTripper(t *testing.T) {
+	t.Parallel()
+	var ifNoneMatch []string
+	cache := here.NewMemoryResponseCache()
+	client := &http.Client{Transport: here.NewConditionalRoundTripper(cache, roundTripperFunc(
+		func(req *http.Request) (*http.Response, error) {
+			ifNoneMatch = append(ifNoneMatch, req.Header.Get("If-None-Match"))
+			if req.Header.Get("If-None-Match") == `"v1"` {
+				return &http.Response{
+					StatusCode: http.StatusNotModified,
+					Header:     http.Header{"Etag": []string{`"v1"`}},
+					Body:       http.NoBody,
+				}, nil
+			}
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				ProtoMajor: 1,
+				ProtoMinor: 1,
+				Header:     http.Header{"Etag": []string{`"v1"`}, "Content-Type": []string{"image/png"}},
+				Body:       io.NopCloser(strings.NewReader("tile")),
+			}, nil
+		},
+	))}
+	get := func(url string) string {
+		resp, err := client.Get(url)
+		assert.NilError(t, err)
+		defer resp.Body.Close()
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, "image/png", resp.Header.Get("Content-Type"))
+		body, err := io.ReadAll(resp.Body)
+		assert.NilError(t, err)
+		return string(body)
+	}
+	assert.Equal(t, "tile", get("https://maps.hereapi.com/v3/base/mc/12/2183/1239/png?apiKey=a"))
+	assert.Equal(t, "tile", get("https://maps.hereapi.com/v3/base/mc/12/2183/1239/png?apiKey=b"))
+	assert.Equal(t, "tile", get("https://maps.hereapi.com/v3/base/mc/12/2183/1240/png?apiKey=a"))
+	assert.DeepEqual(t, []string{"", `"v1"`, ""}, ifNoneMatch)
+}
@@ -0,0 +1,234 @@
+// Package flexpolyline implements the HERE Flexible Polyline format.
+// See https://github.com/heremaps/flexible-polyline for the format specification.
+package flexpolyline
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// FormatVersion is the supported version of the encoding format.
+const FormatVersion = 1
+
+const encodingTable = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789-_"
+
+var decodingTable = func() [256]int8 {
+	var table [256]int8
+	for i := range table {
+		table[i] = -1
+	}
+	for i := 0; i < len(encodingTable); i++ {
+		table[encodingTable[i]] = int8(i)
+	}
+	return table
+}()
+
+// ThirdDimension is the meaning of the optional third dimension of a polyline.
+type ThirdDimension int
+
+const (
+	ThirdDimensionAbsent ThirdDimension = iota
+	ThirdDimensionLevel
+	ThirdDimensionAltitude
+	ThirdDimensionElevation
+	thirdDimensionReserved1
+	thirdDimensionReserved2
+	ThirdDimensionCustom1
+	ThirdDimensionCustom2
+)
+
+func (t ThirdDimension) String() string {
+	switch t {
+	case ThirdDimensionAbsent:
+		return "absent"
+	case ThirdDimensionLevel:
+		return "level"
+	case ThirdDimensionAltitude:
+		return "altitude"
+	case ThirdDimensionElevation:
+		return "elevation"
+	case ThirdDimensionCustom1:
+		return "custom1"
+	case ThirdDimensionCustom2:
+		return "custom2"
+	default:
+		return "invalid"
+	}
+}
+
+// Point is a coordinate of a polyline.
+type Point struct {
+	Lat float64
+	Lng float64
+	// Third is the value of the third dimension. Zero if the polyline has no third dimension.
+	Third float64
+}
+
+// Polyline is a decoded flexible polyline.
+type Polyline struct {
+	// Precision is the number of decimal digits of the latitude and longitude values.
+	Precision int
+	// ThirdDimension is the meaning of the Third value of the points.
+	ThirdDimension ThirdDimension
+	// ThirdDimensionPrecision is the number of decimal digits of the third dimension values.
+	ThirdDimensionPrecision int
+	// Points of the polyline.
+	Points []Point
+}
+
+// Decode decodes an encoded flexible polyline.
+func Decode(encoded string) (_ *Polyline, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("decode flexible polyline: %v", err)
+		}
+	}()
+	d := decoder{encoded: encoded}
+	version, err := d.unsigned()
+	if err != nil {
+		return nil, err
+	}
+	if version != FormatVersion {
+		return nil, fmt.Errorf("unsupported version %d", version)
+	}
+	header, err := d.unsigned()
+	if err != nil {
+		return nil, err
+	}
+	p := &Polyline{
+		Precision:               int(header & 15),
+		ThirdDimension:          ThirdDimension((header >> 4) & 7),
+		ThirdDimensionPrecision: int((header >> 7) & 15),
+	}
+	if p.ThirdDimension == thirdDimensionReserved1 || p.ThirdDimension == thirdDimensionReserved2 {
+		return nil, fmt.Errorf("reserved third dimension %d", p.ThirdDimension)
+	}
+	multiplier := math.Pow10(p.Precision)
+	thirdMultiplier := math.Pow10(p.ThirdDimensionPrecision)
+	var lat, lng, third int64
+	for !d.done() {
+		deltaLat, err := d.signed()
+		if err != nil {
+			return nil, err
+		}
+		deltaLng, err := d.signed()
+		if err != nil {
+			return nil, err
+		}
+		lat += deltaLat
+		lng += deltaLng
+		point := Point{Lat: float64(lat) / multiplier, Lng: float64(lng) / multiplier}
+		if p.ThirdDimension != ThirdDimensionAbsent {
+			deltaThird, err := d.signed()
+			if err != nil {
+				return nil, err
+			}
+			third += deltaThird
+			point.Third = float64(third) / thirdMultiplier
+		}
+		p.Points = append(p.Points, point)
+	}
+	return p, nil
+}
+
+// Encode encodes a polyline as a flexible polyline. Coordinates are rounded to the precision of the polyline.
+func Encode(p *Polyline) (_ string, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("encode flexible polyline: %v", err)
+		}
+	}()
+	if p.Precision < 0 || p.Precision > 15 {
+		return "", fmt.Errorf("invalid precision %d", p.Precision)
+	}
+	if p.ThirdDimensionPrecision < 0 || p.ThirdDimensionPrecision > 15 {
+		return "", fmt.Errorf("invalid third dimension precision %d", p.ThirdDimensionPrecision)
+	}
+	if p.ThirdDimension.String() == "invalid" {
+		return "", fmt.Errorf("invalid third dimension %d", p.ThirdDimension)
+	}
+	var e encoder
+	e.unsigned(FormatVersion)
+	e.unsigned(int64(p.Precision) | int64(p.ThirdDimension)<<4 | int64(p.ThirdDimensionPrecision)<<7)
+	multiplier := math.Pow10(p.Precision)
+	thirdMultiplier := math.Pow10(p.ThirdDimensionPrecision)
+	var lastLat, lastLng, lastThird int64
+	for _, point := range p.Points {
+		lat := int64(math.Round(point.Lat * multiplier))
+		lng := int64(math.Round(point.Lng * multiplier))
+		e.signed(lat - lastLat)
+		e.signed(lng - lastLng)
+		lastLat, lastLng = lat, lng
+		if p.ThirdDimension != ThirdDimensionAbsent {
+			third := int64(math.Round(point.Third * thirdMultiplier))
+			e.signed(third - lastThird)
+			lastThird = third
+		}
+	}
+	return e.encoded.String(), nil
+}
+
+type encoder struct {
+	encoded strings.Builder
+}
+
+func (e *encoder) unsigned(value int64) {
+	for value > 0x1f {
+		e.encoded.WriteByte(encodingTable[(value&0x1f)|0x20])
+		value >>= 5
+	}
+	e.encoded.WriteByte(encodingTable[value])
+}
+
+func (e *encoder) signed(value int64) {
+	if value < 0 {
+		e.unsigned(^(value << 1))
+		return
+	}
+	e.unsigned(value << 1)
+}
+
+type decoder struct {
+	encoded string
+	pos     int
+}
+
+func (d *decoder) done() bool {
+	return d.pos >= len(d.encoded)
+}
+
+func (d *decoder) unsigned() (int64, error) {
+	var result int64
+	var shift uint
+	for {
+		if d.done() {
+			return 0, fmt.Errorf("unexpected end of input")
+		}
+		c := d.encoded[d.pos]
+		value := decodingTable[c]
+		if value < 0 {
+			return 0, fmt.Errorf("invalid character %q at position %d", c, d.pos)
+		}
+		d.pos++
+		if shift > 58 {
+			return 0, fmt.Errorf("value overflow at position %d", d.pos)
+		}
+		result |= int64(value&0x1f) << shift
+		if value&0x20 == 0 {
+			return result, nil
+		}
+		shift += 5
+	}
+}
+
+func (d *decoder) signed() (int64, error) {
+	value, err := d.unsigned()
+	if err != nil {
+		return 0, err
+	}
+	if value&1 != 0 {
+		return ^(value >> 1), nil
+	}
+	return value >> 1, nil
+}
@@ -0,0 +1,107 @@
+package flexpolyline_test
+
+import (
+	"testing"
+
+	"go.einride.tech/here/flexpolyline"
+	"gotest.tools/v3/assert"
+)
+
+func TestDecode(t *testing.T) {
+	t.Parallel()
+	for _, tt := range []struct {
+		name     string
+		encoded  string
+		expected *flexpolyline.Polyline
+	}{
+		{
+			name:    "2d",
+			encoded: "BFoz5xJ67i1B1B7PzIhaxL7Y",
+			expected: &flexpolyline.Polyline{
+				Precision: 5,
+				Points: []flexpolyline.Point{
+					{Lat: 50.10228, Lng: 8.69821},
+					{Lat: 50.10201, Lng: 8.69567},
+					{Lat: 50.10063, Lng: 8.69150},
+					{Lat: 50.09878, Lng: 8.68752},
+				},
+			},
+		},
+		{
+			name:    "3d",
+			encoded: "BlBoz5xJ67i1BU1B7PUzIhaUxL7YU",
+			expected: &flexpolyline.Polyline{
+				Precision:      5,
+				ThirdDimension: flexpolyline.ThirdDimensionAltitude,
+				Points: []flexpolyline.Point{
+					{Lat: 50.10228, Lng: 8.69821, Third: 10},
+					{Lat: 50.10201, Lng: 8.69567, Third: 20},
+					{Lat: 50.10063, Lng: 8.69150, Third: 30},
+					{Lat: 50.09878, Lng: 8.68752, Third: 40},
+				},
+			},
+		},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			actual, err := flexpolyline.Decode(tt.encoded)
+			assert.NilError(t, err)
+			assert.DeepEqual(t, tt.expected, actual)
+			encoded, err := flexpolyline.Encode(tt.expected)
+			assert.NilError(t, err)
+			assert.Equal(t, tt.encoded, encoded)
+		})
+	}
+}
+
+func TestEncode(t *testing.T) {
+	t.Parallel()
+	encoded, err := flexpolyline.Encode(&flexpolyline.Polyline{
+		Precision:               6,
+		ThirdDimension:          flexpolyline.ThirdDimensionElevation,
+		ThirdDimensionPrecision: 1,
+		Points: []flexpolyline.Point{
+			{Lat: 57.707752, Lng: 11.949767, Third: 12.3},
+			{Lat: -33.868820, Lng: -151.209296, Third: -4.5},
+		},
+	})
+	assert.NilError(t, err)
+	actual, err := flexpolyline.Decode(encoded)
+	assert.NilError(t, err)
+	assert.Equal(t, 6, actual.Precision)
+	assert.Equal(t, flexpolyline.ThirdDimensionElevation, actual.ThirdDimension)
+	assert.Equal(t, 1, actual.ThirdDimensionPrecision)
+	assert.Equal(t, 2, len(actual.Points))
+	assert.Equal(t, 57.707752, actual.Points[0].Lat)
+	assert.Equal(t, -151.209296, actual.Points[1].Lng)
+	assert.Equal(t, -4.5, actual.Points[1].Third)
+}
+
+func TestEncode_Error(t *testing.T) {
+	t.Parallel()
+	for _, p := range []*flexpolyline.Polyline{
+		{Precision: 16},
+		{Precision: -1},
+		{ThirdDimensionPrecision: 16},
+		{ThirdDimension: 4},
+		{ThirdDimension: 8},
+	} {
+		_, err := flexpolyline.Encode(p)
+		assert.Assert(t, err != nil, "%+v", p)
+	}
+}
+
+func TestDecode_Error(t *testing.T) {
+	t.Parallel()
+	for _, encoded := range []string{
+		"",
+		"A",
+		"CFoz5xJ67i1B",
+		"BFoz5xJ67i1B1",
+		"BFoz5xJ!7i1B",
+	} {
+		_, err := flexpolyline.Decode(encoded)
+		assert.Assert(t, err != nil, encoded)
+	}
+}
@@ -0,0 +1,25 @@
+//go:build go1.18
+// +build go1.18
+
+package flexpolyline_test
+
+import (
+	"testing"
+
+	"go.einride.tech/here/flexpolyline"
+)
+
+func FuzzDecode(f *testing.F) {
+	for _, seed := range []string{"BFoz5xJ67i1B1B7PzIhaxL7Y", "BlBoz5xJ67i1BU1B7PUzIhaUxL7YU", "", "B", "B_______________"} {
+		f.Add(seed)
+	}
+	f.Fuzz(func(t *testing.T, encoded string) {
+		polyline, err := flexpolyline.Decode(encoded)
+		if err != nil {
+			return
+		}
+		if _, err := flexpolyline.Encode(polyline); err != nil {
+			t.Errorf("encode decoded polyline %q: %v", encoded, err)
+		}
+	})
+}
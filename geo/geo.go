@@ -0,0 +1,91 @@
+// Package geo provides great-circle calculations on a spherical earth model.
+package geo
+
+import "math"
+
+// EarthRadius is the mean radius of the earth in meters.
+const EarthRadius = 6371008.8
+
+// Point is a WGS84 coordinate in degrees.
+type Point struct {
+	Lat float64
+	Lng float64
+}
+
+func radians(degrees float64) float64 {
+	return degrees * math.Pi / 180
+}
+
+func degrees(radians float64) float64 {
+	return radians * 180 / math.Pi
+}
+
+// Distance returns the great-circle distance in meters between two points, using the haversine formula.
+func Distance(a, b Point) float64 {
+	return EarthRadius * angularDistance(a, b)
+}
+
+func angularDistance(a, b Point) float64 {
+	lat1, lat2 := radians(a.Lat), radians(b.Lat)
+	dLat := lat2 - lat1
+	dLng := radians(b.Lng - a.Lng)
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLng/2)*math.Sin(dLng/2)
+	return 2 * math.Asin(math.Min(1, math.Sqrt(h)))
+}
+
+// Bearing returns the initial bearing in degrees, clockwise from north in [0, 360), of the great circle from a to b.
+func Bearing(a, b Point) float64 {
+	lat1, lat2 := radians(a.Lat), radians(b.Lat)
+	dLng := radians(b.Lng - a.Lng)
+	y := math.Sin(dLng) * math.Cos(lat2)
+	x := math.Cos(lat1)*math.Sin(lat2) - math.Sin(lat1)*math.Cos(lat2)*math.Cos(dLng)
+	return math.Mod(degrees(math.Atan2(y, x))+360, 360)
+}
+
+// Destination returns the point reached by travelling the distance in meters from p along the great circle with
+// the initial bearing in degrees.
+func Destination(p Point, bearing, distance float64) Point {
+	lat1, lng1 := radians(p.Lat), radians(p.Lng)
+	theta := radians(bearing)
+	delta := distance / EarthRadius
+	lat2 := math.Asin(math.Sin(lat1)*math.Cos(delta) + math.Cos(lat1)*math.Sin(delta)*math.Cos(theta))
+	lng2 := lng1 + math.Atan2(
+		math.Sin(theta)*math.Sin(delta)*math.Cos(lat1),
+		math.Cos(delta)-math.Sin(lat1)*math.Sin(lat2),
+	)
+	return Point{Lat: degrees(lat2), Lng: math.Mod(degrees(lng2)+540, 360) - 180}
+}
+
+// CrossTrackDistance returns the distance in meters from p to the great circle through start and end. The distance
+// is negative if p is left of the path.
+func CrossTrackDistance(p, start, end Point) float64 {
+	delta13 := angularDistance(start, p)
+	theta13 := radians(Bearing(start, p))
+	theta12 := radians(Bearing(start, end))
+	return math.Asin(math.Sin(delta13)*math.Sin(theta13-theta12)) * EarthRadius
+}
+
+// AlongTrackDistance returns the distance in meters from start to the point on the great circle through start and
+// end closest to p. The distance is negative if the closest point is behind start.
+func AlongTrackDistance(p, start, end Point) float64 {
+	delta13 := angularDistance(start, p)
+	deltaXT := CrossTrackDistance(p, start, end) / EarthRadius
+	along := math.Acos(math.Max(-1, math.Min(1, math.Cos(delta13)/math.Cos(deltaXT)))) * EarthRadius
+	if math.Cos(radians(Bearing(start, p))-radians(Bearing(start, end))) < 0 {
+		return -along
+	}
+	return along
+}
+
+// DistanceToSegment returns the distance in meters from p to the closest point of the segment between start and
+// end.
+func DistanceToSegment(p, start, end Point) float64 {
+	along := AlongTrackDistance(p, start, end)
+	if along <= 0 {
+		return Distance(p, start)
+	}
+	if along >= Distance(start, end) {
+		return Distance(p, end)
+	}
+	return math.Abs(CrossTrackDistance(p, start, end))
+}
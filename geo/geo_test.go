@@ -0,0 +1,51 @@
+package geo_test
+
+import (
+	"math"
+	"testing"
+
+	"go.einride.tech/here/geo"
+	"gotest.tools/v3/assert"
+)
+
+func assertNear(t *testing.T, expected, actual, tolerance float64) {
+	t.Helper()
+	assert.Assert(t, math.Abs(expected-actual) <= tolerance, "expected %v, got %v", expected, actual)
+}
+
+func TestDistance(t *testing.T) {
+	t.Parallel()
+	gothenburg := geo.Point{Lat: 57.707752, Lng: 11.949767}
+	stockholm := geo.Point{Lat: 59.337492, Lng: 18.063672}
+	assertNear(t, 398360, geo.Distance(gothenburg, stockholm), 1)
+	assert.Equal(t, 0.0, geo.Distance(gothenburg, gothenburg))
+}
+
+func TestBearing(t *testing.T) {
+	t.Parallel()
+	origin := geo.Point{}
+	assertNear(t, 0, geo.Bearing(origin, geo.Point{Lat: 1}), 1e-9)
+	assertNear(t, 90, geo.Bearing(origin, geo.Point{Lng: 1}), 1e-9)
+	assertNear(t, 180, geo.Bearing(origin, geo.Point{Lat: -1}), 1e-9)
+	assertNear(t, 270, geo.Bearing(origin, geo.Point{Lng: -1}), 1e-9)
+}
+
+func TestDestination(t *testing.T) {
+	t.Parallel()
+	start := geo.Point{Lat: 57.707752, Lng: 11.949767}
+	end := geo.Destination(start, 45, 10000)
+	assertNear(t, 10000, geo.Distance(start, end), 1e-6)
+	assertNear(t, 45, geo.Bearing(start, end), 1e-6)
+}
+
+func TestCrossTrackDistance(t *testing.T) {
+	t.Parallel()
+	start, end := geo.Point{}, geo.Point{Lng: 1}
+	north := geo.Point{Lat: 0.01, Lng: 0.5}
+	assertNear(t, -geo.Distance(geo.Point{Lng: 0.5}, north), geo.CrossTrackDistance(north, start, end), 1)
+	assertNear(t, geo.Distance(start, geo.Point{Lng: 0.5}), geo.AlongTrackDistance(north, start, end), 1)
+	behind := geo.Point{Lng: -0.5}
+	assertNear(t, -geo.Distance(start, behind), geo.AlongTrackDistance(behind, start, end), 1)
+	assertNear(t, geo.Distance(start, behind), geo.DistanceToSegment(behind, start, end), 1e-6)
+	assertNear(t, geo.Distance(geo.Point{Lng: 0.5}, north), geo.DistanceToSegment(north, start, end), 1)
+}
@@ -0,0 +1,68 @@
+package geocodingv7
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+type AutosuggestRequest struct {
+	// Q is the incomplete text entered by a user, e.g. "Einride Stockh".
+	Q string
+	// At is the position to rank the suggestions around.
+	At GeoWaypoint
+	// Limit is the maximum number of items in the response.
+	Limit int
+	// Lang is the preferred language of the response, as a BCP 47 language code.
+	Lang string
+}
+
+func (r *AutosuggestRequest) QueryString() (string, error) {
+	if r.Q == "" {
+		return "", fmt.Errorf("invalid request: q required")
+	}
+	values := make(url.Values)
+	values.Add("q", r.Q)
+	values.Add("at", r.At.String())
+	if r.Limit > 0 {
+		values.Add("limit", strconv.Itoa(r.Limit))
+	}
+	if r.Lang != "" {
+		values.Add("lang", r.Lang)
+	}
+	return values.Encode(), nil
+}
+
+// AutosuggestResponse contains suggested places, addresses and follow-up queries for the requested text.
+type AutosuggestResponse struct {
+	Items []Item `json:"items"`
+}
+
+// Autosuggest returns suggestions for completing the text entered by a user.
+// See https://developer.here.com/documentation/geocoding-search-api/dev_guide/topics/endpoint-autosuggest-brief.html
+// for details about other parameters.
+func (s *SearchService) Autosuggest(
+	ctx context.Context,
+	req *AutosuggestRequest,
+) (_ *AutosuggestResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("autosuggest %q: %w", req.Q, err)
+		}
+	}()
+	query, err := req.QueryString()
+	if err != nil {
+		return nil, err
+	}
+	r, err := s.Client.NewRequest(ctx, endpointURL(s.URL, "autosuggest"), http.MethodGet, query)
+	if err != nil {
+		return nil, err
+	}
+	var resp AutosuggestResponse
+	if err := s.Client.Do(r, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
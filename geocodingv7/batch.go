@@ -0,0 +1,101 @@
+package geocodingv7
+
+import (
+	"context"
+	"math"
+	"sync"
+)
+
+// DefaultBatchPrecision is the number of decimals positions are rounded to when deduplicating, about 11 meters.
+const DefaultBatchPrecision = 4
+
+type ReverseGeocodeBatchRequest struct {
+	// Points to reverse geocode.
+	Points []GeoWaypoint
+	// Concurrency is the maximum number of concurrent requests. Defaults to 1.
+	Concurrency int
+	// Precision is the number of decimals the points are rounded to when deduplicating nearby points.
+	// Defaults to DefaultBatchPrecision.
+	Precision int
+	// Limit is the maximum number of items per point. Defaults to 1.
+	Limit int
+	// Lang is the preferred language of the response, as a BCP 47 language code.
+	Lang string
+}
+
+// ReverseGeocodeBatchResult is the result of reverse geocoding one of the points of a batch.
+type ReverseGeocodeBatchResult struct {
+	// Items nearest to the point.
+	Items []Item
+	// Err is the error of the request of the point, if any.
+	Err error
+}
+
+// ReverseGeocodeBatch reverse geocodes all points of the request. Points which are equal after rounding to the
+// precision are only requested once. The results are aligned with the points of the request, and an error is
+// only returned if the context is done.
+func (s *SearchService) ReverseGeocodeBatch(
+	ctx context.Context,
+	req *ReverseGeocodeBatchRequest,
+) ([]ReverseGeocodeBatchResult, error) {
+	precision := req.Precision
+	if precision == 0 {
+		precision = DefaultBatchPrecision
+	}
+	scale := math.Pow10(precision)
+	type key struct{ lat, long int64 }
+	// groups maps each unique rounded point to the indices of the points of the request.
+	groups := make(map[key][]int, len(req.Points))
+	var unique []key
+	for i, p := range req.Points {
+		k := key{lat: int64(math.Round(p.Lat * scale)), long: int64(math.Round(p.Long * scale))}
+		if _, ok := groups[k]; !ok {
+			unique = append(unique, k)
+		}
+		groups[k] = append(groups[k], i)
+	}
+	concurrency := req.Concurrency
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	results := make([]ReverseGeocodeBatchResult, len(req.Points))
+	keys := make(chan key)
+	var wg sync.WaitGroup
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for k := range keys {
+				indices := groups[k]
+				resp, err := s.ReverseGeocode(ctx, &ReverseGeocodeRequest{
+					At:    req.Points[indices[0]],
+					Limit: req.Limit,
+					Lang:  req.Lang,
+				})
+				var result ReverseGeocodeBatchResult
+				if err != nil {
+					result.Err = err
+				} else {
+					result.Items = resp.Items
+				}
+				for _, i := range indices {
+					results[i] = result
+				}
+			}
+		}()
+	}
+SendLoop:
+	for _, k := range unique {
+		select {
+		case keys <- k:
+		case <-ctx.Done():
+			break SendLoop
+		}
+	}
+	close(keys)
+	wg.Wait()
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
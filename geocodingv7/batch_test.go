@@ -0,0 +1,61 @@
+package geocodingv7_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"go.einride.tech/here/geocodingv7"
+	"gotest.tools/v3/assert"
+)
+
+type httpClientFunc func(*http.Request) (*http.Response, error)
+
+func (f httpClientFunc) Do(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestSearchService_ReverseGeocodeBatch(t *testing.T) {
+	t.Parallel()
+	var mu sync.Mutex
+	var requested []string
+	client := geocodingv7.NewClient(httpClientFunc(func(req *http.Request) (*http.Response, error) {
+		at := req.URL.Query().Get("at")
+		mu.Lock()
+		requested = append(requested, req.URL.Host+req.URL.Path)
+		mu.Unlock()
+		if at == "0,0" {
+			return &http.Response{
+				StatusCode: http.StatusBadRequest,
+				Body:       io.NopCloser(strings.NewReader(`{"title":"Illegal input","status":400}`)),
+			}, nil
+		}
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(`{"items":[{"title":"` + at + `"}]}`)),
+		}, nil
+	}))
+	results, err := client.Search.ReverseGeocodeBatch(context.Background(), &geocodingv7.ReverseGeocodeBatchRequest{
+		Points: []geocodingv7.GeoWaypoint{
+			{Lat: 57.707752, Long: 11.949767},
+			{Lat: 59.337492, Long: 18.063672},
+			{Lat: 57.707761, Long: 11.949771},
+			{Lat: 0, Long: 0},
+		},
+		Concurrency: 2,
+	})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, []string{
+		"revgeocode.search.hereapi.com/v1/revgeocode",
+		"revgeocode.search.hereapi.com/v1/revgeocode",
+		"revgeocode.search.hereapi.com/v1/revgeocode",
+	}, requested)
+	assert.Equal(t, 4, len(results))
+	assert.Equal(t, "57.707752,11.949767", results[0].Items[0].Title)
+	assert.Equal(t, "59.337492,18.063672", results[1].Items[0].Title)
+	assert.Equal(t, "57.707752,11.949767", results[2].Items[0].Title)
+	assert.ErrorContains(t, results[3].Err, "Illegal input")
+}
@@ -0,0 +1,64 @@
+package geocodingv7
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// CategoryParking is the category of parking facilities, such as parking lots and garages.
+const CategoryParking = "800-8500"
+
+type BrowseRequest struct {
+	// At is the position to search around.
+	At GeoWaypoint
+	// Categories of places to return, e.g. CategoryParking.
+	Categories []string
+	// Limit is the maximum number of items in the response.
+	Limit int
+	// Lang is the preferred language of the response, as a BCP 47 language code.
+	Lang string
+}
+
+func (r *BrowseRequest) QueryString() string {
+	values := make(url.Values)
+	values.Add("at", r.At.String())
+	if len(r.Categories) > 0 {
+		values.Add("categories", strings.Join(r.Categories, ","))
+	}
+	if r.Limit > 0 {
+		values.Add("limit", strconv.Itoa(r.Limit))
+	}
+	if r.Lang != "" {
+		values.Add("lang", r.Lang)
+	}
+	return values.Encode()
+}
+
+// BrowseResponse contains the places around the requested position, ordered by distance.
+type BrowseResponse struct {
+	Items []Item `json:"items"`
+}
+
+// Browse returns the places of the requested categories around a position.
+// See https://developer.here.com/documentation/geocoding-search-api/dev_guide/topics/endpoint-browse-brief.html
+// for details about other parameters.
+func (s *SearchService) Browse(ctx context.Context, req *BrowseRequest) (_ *BrowseResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("browse %v: %w", req.At, err)
+		}
+	}()
+	r, err := s.Client.NewRequest(ctx, endpointURL(s.URL, "browse"), http.MethodGet, req.QueryString())
+	if err != nil {
+		return nil, err
+	}
+	var resp BrowseResponse
+	if err := s.Client.Do(r, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
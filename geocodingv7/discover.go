@@ -0,0 +1,71 @@
+package geocodingv7
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+type DiscoverRequest struct {
+	// Q is the free-form text to search for, e.g. "truck stop".
+	Q string
+	// At is the position to search around.
+	At GeoWaypoint
+	// InCountries limits the results to the countries, as ISO 3166-1 alpha-3 country codes.
+	InCountries []string
+	// Limit is the maximum number of items in the response.
+	Limit int
+	// Lang is the preferred language of the response, as a BCP 47 language code.
+	Lang string
+}
+
+func (r *DiscoverRequest) QueryString() (string, error) {
+	if r.Q == "" {
+		return "", fmt.Errorf("invalid request: q required")
+	}
+	values := make(url.Values)
+	values.Add("q", r.Q)
+	values.Add("at", r.At.String())
+	if len(r.InCountries) > 0 {
+		values.Add("in", "countryCode:"+strings.Join(r.InCountries, ","))
+	}
+	if r.Limit > 0 {
+		values.Add("limit", strconv.Itoa(r.Limit))
+	}
+	if r.Lang != "" {
+		values.Add("lang", r.Lang)
+	}
+	return values.Encode(), nil
+}
+
+// DiscoverResponse contains the places and addresses matching the requested text.
+type DiscoverResponse struct {
+	Items []Item `json:"items"`
+}
+
+// Discover returns the places and addresses matching a free-form text around a position.
+// See https://developer.here.com/documentation/geocoding-search-api/dev_guide/topics/endpoint-discover-brief.html
+// for details about other parameters.
+func (s *SearchService) Discover(ctx context.Context, req *DiscoverRequest) (_ *DiscoverResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("discover %q: %w", req.Q, err)
+		}
+	}()
+	query, err := req.QueryString()
+	if err != nil {
+		return nil, err
+	}
+	r, err := s.Client.NewRequest(ctx, endpointURL(s.URL, "discover"), http.MethodGet, query)
+	if err != nil {
+		return nil, err
+	}
+	var resp DiscoverResponse
+	if err := s.Client.Do(r, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
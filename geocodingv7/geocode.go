@@ -0,0 +1,96 @@
+package geocodingv7
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type GeocodeRequest struct {
+	// Q is the free-form address to geocode, e.g. "Invalidenstraße 116, Berlin". Either Q or QualifiedQuery is
+	// required.
+	Q string
+	// QualifiedQuery is the address to geocode as key-value pairs, e.g. {"city": "Berlin", "street": "Chausseestr"}.
+	QualifiedQuery map[string]string
+	// At is a position to prefer results near to.
+	At *GeoWaypoint
+	// InCountries limits the results to the countries, as ISO 3166-1 alpha-3 country codes.
+	InCountries []string
+	// Limit is the maximum number of items in the response.
+	Limit int
+	// Lang is the preferred language of the response, as a BCP 47 language code.
+	Lang string
+}
+
+func (r *GeocodeRequest) QueryString() (string, error) {
+	if r.Q == "" && len(r.QualifiedQuery) == 0 {
+		return "", fmt.Errorf("invalid request: q or qualified query required")
+	}
+	values := make(url.Values)
+	if r.Q != "" {
+		values.Add("q", r.Q)
+	}
+	if len(r.QualifiedQuery) > 0 {
+		values.Add("qq", qualifiedQuery(r.QualifiedQuery))
+	}
+	if r.At != nil {
+		values.Add("at", r.At.String())
+	}
+	if len(r.InCountries) > 0 {
+		values.Add("in", "countryCode:"+strings.Join(r.InCountries, ","))
+	}
+	if r.Limit > 0 {
+		values.Add("limit", strconv.Itoa(r.Limit))
+	}
+	if r.Lang != "" {
+		values.Add("lang", r.Lang)
+	}
+	return values.Encode(), nil
+}
+
+// qualifiedQuery returns the value of the qq parameter, with the keys sorted for a stable query.
+func qualifiedQuery(query map[string]string) string {
+	keys := make([]string, 0, len(query))
+	for key := range query {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	pairs := make([]string, 0, len(keys))
+	for _, key := range keys {
+		pairs = append(pairs, key+"="+query[key])
+	}
+	return strings.Join(pairs, ";")
+}
+
+// GeocodeResponse contains the items matching the requested address, best match first.
+type GeocodeResponse struct {
+	Items []Item `json:"items"`
+}
+
+// Geocode returns the positions of an address.
+// See https://developer.here.com/documentation/geocoding-search-api/dev_guide/topics/endpoint-geocode-brief.html
+// for details about other parameters.
+func (s *SearchService) Geocode(ctx context.Context, req *GeocodeRequest) (_ *GeocodeResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("geocode: %w", err)
+		}
+	}()
+	query, err := req.QueryString()
+	if err != nil {
+		return nil, err
+	}
+	r, err := s.Client.NewRequest(ctx, endpointURL(s.URL, "geocode"), http.MethodGet, query)
+	if err != nil {
+		return nil, err
+	}
+	var resp GeocodeResponse
+	if err := s.Client.Do(r, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
@@ -0,0 +1,51 @@
+package geocodingv7
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+type LookupRequest struct {
+	// ID of the item, from a previous geocoding or search response.
+	ID string
+	// Lang is the preferred language of the response, as a BCP 47 language code.
+	Lang string
+}
+
+func (r *LookupRequest) QueryString() (string, error) {
+	if r.ID == "" {
+		return "", fmt.Errorf("invalid request: id required")
+	}
+	values := make(url.Values)
+	values.Add("id", r.ID)
+	if r.Lang != "" {
+		values.Add("lang", r.Lang)
+	}
+	return values.Encode(), nil
+}
+
+// Lookup returns the item with an ID from a previous geocoding or search response.
+// See https://developer.here.com/documentation/geocoding-search-api/dev_guide/topics/endpoint-lookup-brief.html
+// for details about other parameters.
+func (s *SearchService) Lookup(ctx context.Context, req *LookupRequest) (_ *Item, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("lookup %q: %w", req.ID, err)
+		}
+	}()
+	query, err := req.QueryString()
+	if err != nil {
+		return nil, err
+	}
+	r, err := s.Client.NewRequest(ctx, endpointURL(s.URL, "lookup"), http.MethodGet, query)
+	if err != nil {
+		return nil, err
+	}
+	var item Item
+	if err := s.Client.Do(r, &item); err != nil {
+		return nil, err
+	}
+	return &item, nil
+}
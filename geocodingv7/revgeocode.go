@@ -0,0 +1,58 @@
+package geocodingv7
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+type ReverseGeocodeRequest struct {
+	// At is the position to find the nearest address for.
+	At GeoWaypoint
+	// Limit is the maximum number of items in the response. Defaults to 1.
+	Limit int
+	// Lang is the preferred language of the response, as a BCP 47 language code.
+	Lang string
+}
+
+func (r *ReverseGeocodeRequest) QueryString() string {
+	values := make(url.Values)
+	values.Add("at", r.At.String())
+	if r.Limit > 0 {
+		values.Add("limit", strconv.Itoa(r.Limit))
+	}
+	if r.Lang != "" {
+		values.Add("lang", r.Lang)
+	}
+	return values.Encode()
+}
+
+// ReverseGeocodeResponse contains the items nearest to the requested position.
+type ReverseGeocodeResponse struct {
+	Items []Item `json:"items"`
+}
+
+// ReverseGeocode returns the addresses nearest to a position.
+// See https://developer.here.com/documentation/geocoding-search-api/dev_guide/topics/endpoint-reverse-geocode-brief.html
+// for details about other parameters.
+func (s *SearchService) ReverseGeocode(
+	ctx context.Context,
+	req *ReverseGeocodeRequest,
+) (_ *ReverseGeocodeResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("reverse geocode %v: %w", req.At, err)
+		}
+	}()
+	r, err := s.Client.NewRequest(ctx, endpointURL(s.URL, "revgeocode"), http.MethodGet, req.QueryString())
+	if err != nil {
+		return nil, err
+	}
+	var resp ReverseGeocodeResponse
+	if err := s.Client.Do(r, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
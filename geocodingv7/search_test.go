@@ -0,0 +1,139 @@
+package geocodingv7_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"go.einride.tech/here/geocodingv7"
+	"go.einride.tech/here/routingv8"
+	"gotest.tools/v3/assert"
+)
+
+// searchClient returns a client responding with the body, and the URL of the last request.
+func searchClient(body string) (*geocodingv7.Client, *string) {
+	var requested string
+	client := geocodingv7.NewClient(httpClientFunc(func(req *http.Request) (*http.Response, error) {
+		requested = req.URL.String()
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(body))}, nil
+	}))
+	return client, &requested
+}
+
+func TestSearchService_Geocode(t *testing.T) {
+	t.Parallel()
+	client, requested := searchClient(`{"items":[{
+		"title": "Invalidenstraße 116, 10115 Berlin, Deutschland",
+		"id": "here:af:streetsection:1",
+		"resultType": "houseNumber",
+		"position": {"lat": 52.53041, "lng": 13.38527},
+		"scoring": {"queryScore": 1.0, "fieldScore": {"streets": [1.0]}}
+	}]}`)
+	resp, err := client.Search.Geocode(context.Background(), &geocodingv7.GeocodeRequest{
+		QualifiedQuery: map[string]string{"street": "Invalidenstraße", "houseNumber": "116", "city": "Berlin"},
+		InCountries:    []string{"DEU"},
+		Limit:          1,
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(resp.Items))
+	assert.Equal(t, 1.0, resp.Items[0].Scoring.QueryScore)
+	assert.Equal(
+		t,
+		"https://geocode.search.hereapi.com/v1/geocode?"+
+			"in=countryCode%3ADEU&limit=1&qq=city%3DBerlin%3BhouseNumber%3D116%3Bstreet%3DInvalidenstra%C3%9Fe",
+		*requested,
+	)
+	_, err = client.Search.Geocode(context.Background(), &geocodingv7.GeocodeRequest{})
+	assert.Error(t, err, "geocode: invalid request: q or qualified query required")
+}
+
+func TestSearchService_Autosuggest(t *testing.T) {
+	t.Parallel()
+	client, requested := searchClient(`{"items":[{
+		"title": "Restaurant",
+		"id": "here:cm:category:100-1000",
+		"resultType": "categoryQuery",
+		"href": "https://autosuggest.search.hereapi.com/v1/discover?q=Restaurant"
+	}]}`)
+	resp, err := client.Search.Autosuggest(context.Background(), &geocodingv7.AutosuggestRequest{
+		Q:  "resta",
+		At: geocodingv7.GeoWaypoint{Lat: 57.7, Long: 11.9},
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, "https://autosuggest.search.hereapi.com/v1/discover?q=Restaurant", resp.Items[0].Href)
+	assert.Equal(t, "https://autosuggest.search.hereapi.com/v1/autosuggest?at=57.7%2C11.9&q=resta", *requested)
+}
+
+func TestSearchService_Discover(t *testing.T) {
+	t.Parallel()
+	client, requested := searchClient(`{"items":[{"title":"Truck Stop","id":"here:pds:place:1","resultType":"place"}]}`)
+	resp, err := client.Search.Discover(context.Background(), &geocodingv7.DiscoverRequest{
+		Q:     "truck stop",
+		At:    geocodingv7.GeoWaypoint{Lat: 57.7, Long: 11.9},
+		Limit: 5,
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, "Truck Stop", resp.Items[0].Title)
+	assert.Equal(t, "https://discover.search.hereapi.com/v1/discover?at=57.7%2C11.9&limit=5&q=truck+stop", *requested)
+}
+
+func TestSearchService_Lookup(t *testing.T) {
+	t.Parallel()
+	client, requested := searchClient(`{"title":"Truck Stop","id":"here:pds:place:1","resultType":"place"}`)
+	item, err := client.Search.Lookup(context.Background(), &geocodingv7.LookupRequest{ID: "here:pds:place:1"})
+	assert.NilError(t, err)
+	assert.Equal(t, "Truck Stop", item.Title)
+	assert.Equal(t, "https://lookup.search.hereapi.com/v1/lookup?id=here%3Apds%3Aplace%3A1", *requested)
+	_, err = client.Search.Lookup(context.Background(), &geocodingv7.LookupRequest{})
+	assert.Error(t, err, `lookup "": invalid request: id required`)
+}
+
+func TestSearchService_Browse(t *testing.T) {
+	t.Parallel()
+	client, requested := searchClient(`{"items":[{
+		"title": "Nordstan P-hus",
+		"id": "here:pds:place:2",
+		"resultType": "place",
+		"position": {"lat": 57.7089, "lng": 11.9691},
+		"access": [{"lat": 57.7087, "lng": 11.9688}],
+		"distance": 120,
+		"categories": [{"id": "800-8500-0177", "name": "Parking Garage", "primary": true}]
+	}]}`)
+	resp, err := client.Search.Browse(context.Background(), &geocodingv7.BrowseRequest{
+		At:         geocodingv7.GeoWaypoint{Lat: 57.7, Long: 11.97},
+		Categories: []string{geocodingv7.CategoryParking, "700-7600"},
+		Limit:      3,
+		Lang:       "sv-SE",
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(resp.Items))
+	assert.Equal(t, "Nordstan P-hus", resp.Items[0].Title)
+	assert.Equal(t, 120, resp.Items[0].Distance)
+	assert.Equal(t, geocodingv7.GeoWaypoint{Lat: 57.7087, Long: 11.9688}, resp.Items[0].Access[0])
+	assert.Equal(
+		t,
+		"https://browse.search.hereapi.com/v1/browse?at=57.7%2C11.97&categories=800-8500%2C700-7600&lang=sv-SE&limit=3",
+		*requested,
+	)
+}
+
+func TestSearchService_Browse_Error(t *testing.T) {
+	t.Parallel()
+	client := geocodingv7.NewClient(httpClientFunc(func(req *http.Request) (*http.Response, error) {
+		body := `{"status":400,"title":"Illegal input for parameter 'at'"}`
+		return &http.Response{StatusCode: http.StatusBadRequest, Body: io.NopCloser(strings.NewReader(body))}, nil
+	}))
+	_, err := client.Search.Browse(context.Background(), &geocodingv7.BrowseRequest{
+		At: geocodingv7.GeoWaypoint{Lat: 91, Long: 0},
+	})
+	assert.ErrorContains(t, err, "browse 91,0: ")
+	assert.ErrorContains(t, err, "Illegal input for parameter 'at'")
+	assert.Assert(t, routingv8.IsInvalidInput(err))
+	assert.Assert(t, !routingv8.IsRetryable(err))
+	var errorResponse *geocodingv7.HereErrorResponse
+	assert.Assert(t, errors.As(err, &errorResponse))
+	assert.Equal(t, 400, errorResponse.Status)
+}
@@ -0,0 +1,75 @@
+package geocodingv7
+
+import "strconv"
+
+// GeoWaypoint is a WGS84 coordinate.
+type GeoWaypoint struct {
+	Lat  float64 `json:"lat"`
+	Long float64 `json:"lng"`
+}
+
+func (g GeoWaypoint) String() string {
+	return strconv.FormatFloat(g.Lat, 'f', -1, 64) + "," + strconv.FormatFloat(g.Long, 'f', -1, 64)
+}
+
+// Address of a result item.
+type Address struct {
+	// Label is the assembled address, formatted according to the country.
+	Label       string `json:"label"`
+	CountryCode string `json:"countryCode"`
+	CountryName string `json:"countryName"`
+	StateCode   string `json:"stateCode"`
+	State       string `json:"state"`
+	County      string `json:"county"`
+	City        string `json:"city"`
+	District    string `json:"district"`
+	Street      string `json:"street"`
+	PostalCode  string `json:"postalCode"`
+	HouseNumber string `json:"houseNumber"`
+}
+
+// MapView is a bounding box suitable for displaying a result item.
+type MapView struct {
+	West  float64 `json:"west"`
+	South float64 `json:"south"`
+	East  float64 `json:"east"`
+	North float64 `json:"north"`
+}
+
+// Item is a result of a geocoding or search request.
+type Item struct {
+	// Title of the item.
+	Title string `json:"title"`
+	// ID of the item, which can be used for lookups.
+	ID string `json:"id"`
+	// ResultType of the item, e.g. houseNumber, street, locality or place.
+	ResultType string `json:"resultType"`
+	// Address of the item.
+	Address Address `json:"address"`
+	// Position of the item, used for display.
+	Position GeoWaypoint `json:"position"`
+	// Access positions of the item, used for routing.
+	Access []GeoWaypoint `json:"access"`
+	// Distance in meters to the position of the request.
+	Distance int `json:"distance"`
+	// MapView of the item.
+	MapView *MapView `json:"mapView"`
+	// Categories of place items.
+	Categories []Category `json:"categories,omitempty"`
+	// Href of autosuggest items suggesting a follow-up query, such as a category or chain query.
+	Href string `json:"href,omitempty"`
+	// Scoring of geocoding items, how well the item matches the query.
+	Scoring *Scoring `json:"scoring,omitempty"`
+}
+
+// Scoring of how well an item matches the query, between 0 and 1.
+type Scoring struct {
+	QueryScore float64 `json:"queryScore"`
+}
+
+// Category of a place.
+type Category struct {
+	ID      string `json:"id"`
+	Name    string `json:"name"`
+	Primary bool   `json:"primary"`
+}
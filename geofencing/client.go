@@ -0,0 +1,152 @@
+package geofencing
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"go.einride.tech/here/internal/hereapi"
+	"go.einride.tech/here/internal/useragent"
+	"go.einride.tech/here/routingv8"
+)
+
+const (
+	userAgent = useragent.UserAgent
+)
+
+type HTTPClient interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// GeofencingService handles communication with the fence management and search methods of the HERE API.
+type GeofencingService service
+
+type Client struct {
+	// HTTP client used to communicate with the API.
+	client HTTPClient
+
+	UserAgent string
+
+	// ProjectHRN scopes all requests to a HERE platform project, see routingv8.Client.ProjectHRN.
+	ProjectHRN string
+
+	// Realm of the HERE platform organization, required by some enterprise deployments.
+	Realm string
+
+	// RetryPolicy for failed requests. Requests are not retried if nil.
+	RetryPolicy *routingv8.RetryPolicy
+
+	// Clock used for retries. Defaults to routingv8.SystemClock.
+	Clock routingv8.Clock
+
+	// DebugScrubber is applied to debug dumps before they are written, e.g. to redact coordinates from logs. See
+	// routingv8.WithDebugDump.
+	DebugScrubber func(dump string) string
+
+	// Geofencing service.
+	Geofencing *GeofencingService
+}
+
+type service struct {
+	// URL for service API requests
+	URL    *url.URL
+	Client *Client
+}
+
+// HereErrorResponse is returned when an error is returned from the Geofencing API.
+type HereErrorResponse struct {
+	// Issues causing the error.
+	Issues []Issue `json:"issues"`
+	// ResponseCode is the HTTP status of the error, e.g. "400 Bad Request".
+	ResponseCode string `json:"response_code"`
+	// ErrorID of the request, to refer to when contacting HERE support.
+	ErrorID string `json:"error_id"`
+}
+
+func (r *HereErrorResponse) Error() string {
+	messages := make([]string, 0, len(r.Issues))
+	for _, issue := range r.Issues {
+		messages = append(messages, issue.Message)
+	}
+	return fmt.Sprintf(
+		"Response code: %v, Issues: %v, Error ID: %v",
+		r.ResponseCode,
+		strings.Join(messages, "; "),
+		r.ErrorID,
+	)
+}
+
+// Issue is a problem with a request.
+type Issue struct {
+	Message string `json:"message"`
+}
+
+// NewClient returns a new HERE API Client. If a nil httpClient is
+// provided, a new http.Client will be used. To use API methods which require
+// authentication, provide an http.Client that will perform the authentication
+// for you (such as that provided by the golang.org/x/oauth2 library).
+func NewClient(httpClient HTTPClient) *Client {
+	if httpClient == nil {
+		httpClient = &http.Client{}
+	}
+	c := &Client{client: httpClient, UserAgent: userAgent}
+	geofencingURL, _ := url.Parse("https://fleet.ls.hereapi.com/2/")
+	c.Geofencing = &GeofencingService{URL: geofencingURL, Client: c}
+	return c
+}
+
+// NewRequest creates an API request. A raw query string can be specified by rawQuery, and a request body of the
+// given content type by body.
+func (c *Client) NewRequest(
+	ctx context.Context,
+	u *url.URL,
+	method string,
+	rawQuery string,
+	contentType string,
+	body io.Reader,
+) (*http.Request, error) {
+	if len(rawQuery) > 0 {
+		u.RawQuery = rawQuery
+	}
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), body)
+	if err != nil {
+		return nil, err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", contentType)
+	}
+	if c.UserAgent != "" {
+		req.Header.Set("User-Agent", c.UserAgent)
+	}
+	return req, nil
+}
+
+// Do sends an API request and returns the API response. The API response is JSON decoded and stored in the value
+// pointed to by v, or returned as an error if an API error has occurred. If v implements the io.Writer interface,
+// the raw response will be written to v, without attempting to decode it.
+// Failed requests are retried according to the RetryPolicy of the Client.
+func (c *Client) Do(req *http.Request, v interface{}) error {
+	client := hereapi.Client{
+		HTTPClient:    c.client,
+		ProjectHRN:    c.ProjectHRN,
+		Realm:         c.Realm,
+		RetryPolicy:   c.RetryPolicy,
+		Clock:         c.Clock,
+		DebugScrubber: c.DebugScrubber,
+		DecodeError:   decodeErrorResponse,
+	}
+	return client.Do(req, v)
+}
+
+// decodeErrorResponse decodes the body of an error response into a *HereErrorResponse.
+func decodeErrorResponse(statusCode int, body io.Reader) error {
+	var response HereErrorResponse
+	if err := json.NewDecoder(body).Decode(&response); err != nil {
+		response = HereErrorResponse{ResponseCode: fmt.Sprintf("%d %s", statusCode, http.StatusText(statusCode))}
+	}
+	return &response
+}
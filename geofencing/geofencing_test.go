@@ -0,0 +1,161 @@
+package geofencing_test
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"go.einride.tech/here/geofencing"
+	"go.einride.tech/here/routingv8"
+	"gotest.tools/v3/assert"
+)
+
+type httpClientFunc func(*http.Request) (*http.Response, error)
+
+func (f httpClientFunc) Do(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestGeofencingService_UploadLayer(t *testing.T) {
+	t.Parallel()
+	var uploaded []byte
+	var query string
+	client := geofencing.NewClient(httpClientFunc(func(req *http.Request) (*http.Response, error) {
+		query = req.URL.Path + "?" + req.URL.RawQuery
+		file, _, err := req.FormFile("zipfile")
+		assert.NilError(t, err)
+		archive, err := io.ReadAll(file)
+		assert.NilError(t, err)
+		zr, err := zip.NewReader(bytes.NewReader(archive), int64(len(archive)))
+		assert.NilError(t, err)
+		assert.Equal(t, "depots.wkt", zr.File[0].Name)
+		r, err := zr.File[0].Open()
+		assert.NilError(t, err)
+		uploaded, err = io.ReadAll(r)
+		assert.NilError(t, err)
+		return &http.Response{
+			StatusCode: http.StatusCreated,
+			Body:       io.NopCloser(strings.NewReader(`{"response_code":"201 Created","storedTilesCount":4}`)),
+		}, nil
+	}))
+	content, err := geofencing.WKTLayer(geofencing.Fence{
+		Name: "Depot",
+		WKT: geofencing.PolygonWKT(
+			geofencing.GeoWaypoint{Lat: 57.7, Long: 11.9},
+			geofencing.GeoWaypoint{Lat: 57.7, Long: 12.0},
+			geofencing.GeoWaypoint{Lat: 57.8, Long: 12.0},
+		),
+	})
+	assert.NilError(t, err)
+	resp, err := client.Geofencing.UploadLayer(context.Background(), &geofencing.UploadLayerRequest{
+		LayerID: "depots",
+		Content: content,
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 4, resp.StoredTilesCount)
+	assert.Equal(t, "/2/layers/upload.json?layer_id=depots", query)
+	assert.Equal(t, "NAME\tWKT\nDepot\tPOLYGON((11.9 57.7, 12 57.7, 12 57.8, 11.9 57.7))\n", string(uploaded))
+}
+
+func TestGeofencingService_Proximity(t *testing.T) {
+	t.Parallel()
+	var query string
+	client := geofencing.NewClient(httpClientFunc(func(req *http.Request) (*http.Response, error) {
+		query = req.URL.Path + "?" + req.URL.RawQuery
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(`{
+			"geometries": [
+				{
+					"layerId": "depots",
+					"attributes": {"GEOMETRY_ID": "1", "NAME": "Depot"},
+					"distance": -99999999,
+					"nearestLat": 57.75,
+					"nearestLon": 11.95,
+					"geometry": "POLYGON((11.9 57.7, 12 57.7, 12 57.8, 11.9 57.7))"
+				},
+				{"layerId": "depots", "attributes": {"GEOMETRY_ID": "2"}, "distance": 120.5}
+			],
+			"response_code": "200 OK"
+		}`))}, nil
+	}))
+	resp, err := client.Geofencing.Proximity(context.Background(), &geofencing.ProximityRequest{
+		LayerIDs: []string{"depots"},
+		Position: geofencing.GeoWaypoint{Lat: 57.75, Long: 11.95},
+		Radius:   500,
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, "/2/search/proximity.json?layer_ids=depots&proximity=57.75%2C11.95%2C500", query)
+	assert.Equal(t, 2, len(resp.Geometries))
+	assert.Assert(t, resp.Geometries[0].Inside())
+	assert.Equal(t, "Depot", resp.Geometries[0].Attributes["NAME"])
+	assert.Assert(t, !resp.Geometries[1].Inside())
+}
+
+func TestGeofencingService_DeleteLayers(t *testing.T) {
+	t.Parallel()
+	client := geofencing.NewClient(httpClientFunc(func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, "layer_ids=a%2Cb", req.URL.RawQuery)
+		return &http.Response{
+			StatusCode: http.StatusBadRequest,
+			Body: io.NopCloser(strings.NewReader(
+				`{"issues":[{"message":"Layer b not found"}],"response_code":"400 Bad Request","error_id":"e1"}`,
+			)),
+		}, nil
+	}))
+	err := client.Geofencing.DeleteLayers(context.Background(), "a", "b")
+	assert.Error(
+		t, err, "delete layers a,b: Response code: 400 Bad Request, Issues: Layer b not found, Error ID: e1",
+	)
+	assert.Assert(t, routingv8.IsInvalidInput(err))
+	var response *geofencing.HereErrorResponse
+	assert.Assert(t, errors.As(err, &response))
+	assert.Equal(t, "e1", response.ErrorID)
+}
+
+func TestProximityRequest_QueryString(t *testing.T) {
+	t.Parallel()
+	for _, tt := range []struct {
+		name     string
+		request  geofencing.ProximityRequest
+		expected string
+		err      string
+	}{
+		{
+			name: "key attributes",
+			request: geofencing.ProximityRequest{
+				LayerIDs:      []string{"a", "b"},
+				KeyAttributes: []string{"NAME", "ID"},
+			},
+			expected: "key_attributes=NAME%2CID&layer_ids=a%2Cb&proximity=0%2C0",
+		},
+		{
+			name:    "no layers",
+			request: geofencing.ProximityRequest{},
+			err:     "invalid layer IDs: empty",
+		},
+		{
+			name: "key attributes mismatch",
+			request: geofencing.ProximityRequest{
+				LayerIDs:      []string{"a", "b"},
+				KeyAttributes: []string{"NAME"},
+			},
+			err: "invalid key attributes: 1 key attributes for 2 layers",
+		},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := tt.request.QueryString()
+			if tt.err != "" {
+				assert.Error(t, err, tt.err)
+				return
+			}
+			assert.NilError(t, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
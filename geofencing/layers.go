@@ -0,0 +1,156 @@
+package geofencing
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"fmt"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+type UploadLayerRequest struct {
+	// LayerID of the layer, replacing the layer if it exists.
+	LayerID string
+	// Format of the content. Defaults to LayerFormatWKT.
+	Format LayerFormat
+	// Content of the layer file, e.g. from WKTLayer.
+	Content []byte
+}
+
+// body returns the multipart body of the upload, with the layer file compressed in a zip file.
+func (r *UploadLayerRequest) body() (string, []byte, error) {
+	if r.LayerID == "" {
+		return "", nil, fmt.Errorf("invalid layer ID: empty")
+	}
+	format := LayerFormatWKT
+	if r.Format != LayerFormatUnspecified {
+		format = r.Format
+	}
+	if format.String() == invalid {
+		return "", nil, fmt.Errorf("invalid format: %d", int(r.Format))
+	}
+	var archive bytes.Buffer
+	zw := zip.NewWriter(&archive)
+	w, err := zw.Create(r.LayerID + "." + format.String())
+	if err != nil {
+		return "", nil, err
+	}
+	if _, err := w.Write(r.Content); err != nil {
+		return "", nil, err
+	}
+	if err := zw.Close(); err != nil {
+		return "", nil, err
+	}
+	var body bytes.Buffer
+	mw := multipart.NewWriter(&body)
+	part, err := mw.CreateFormFile("zipfile", r.LayerID+".zip")
+	if err != nil {
+		return "", nil, err
+	}
+	if _, err := part.Write(archive.Bytes()); err != nil {
+		return "", nil, err
+	}
+	if err := mw.Close(); err != nil {
+		return "", nil, err
+	}
+	return mw.FormDataContentType(), body.Bytes(), nil
+}
+
+// UploadLayerResponse is the result of a layer upload.
+type UploadLayerResponse struct {
+	ResponseCode string `json:"response_code"`
+	// StoredTilesCount is the number of map tiles the layer was stored in.
+	StoredTilesCount int `json:"storedTilesCount"`
+}
+
+// UploadLayer uploads a layer of fences, replacing the layer if it exists.
+// See https://developer.here.com/documentation/geofencing/dev_guide/topics/upload-layer.html
+// for details about other parameters.
+func (s *GeofencingService) UploadLayer(
+	ctx context.Context,
+	req *UploadLayerRequest,
+) (_ *UploadLayerResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("upload layer %s: %w", req.LayerID, err)
+		}
+	}()
+	contentType, body, err := req.body()
+	if err != nil {
+		return nil, err
+	}
+	u, err := s.URL.Parse("layers/upload.json")
+	if err != nil {
+		return nil, err
+	}
+	query := url.Values{"layer_id": {req.LayerID}}.Encode()
+	r, err := s.Client.NewRequest(ctx, u, http.MethodPost, query, contentType, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	var resp UploadLayerResponse
+	if err := s.Client.Do(r, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// Layer is an uploaded fence layer.
+type Layer struct {
+	LayerID string `json:"layerId"`
+	// GeometriesCount is the number of fences of the layer.
+	GeometriesCount int `json:"geometriesCount"`
+}
+
+// ListLayersResponse contains the uploaded layers.
+type ListLayersResponse struct {
+	Layers       []Layer `json:"layers"`
+	ResponseCode string  `json:"response_code"`
+}
+
+// ListLayers returns the uploaded layers.
+func (s *GeofencingService) ListLayers(ctx context.Context) (_ *ListLayersResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("list layers: %w", err)
+		}
+	}()
+	u, err := s.URL.Parse("layers/list.json")
+	if err != nil {
+		return nil, err
+	}
+	r, err := s.Client.NewRequest(ctx, u, http.MethodGet, "", "", nil)
+	if err != nil {
+		return nil, err
+	}
+	var resp ListLayersResponse
+	if err := s.Client.Do(r, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// DeleteLayers deletes the layers.
+func (s *GeofencingService) DeleteLayers(ctx context.Context, layerIDs ...string) (err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("delete layers %s: %w", strings.Join(layerIDs, ","), err)
+		}
+	}()
+	if len(layerIDs) == 0 {
+		return fmt.Errorf("invalid layer IDs: empty")
+	}
+	u, err := s.URL.Parse("layers/delete.json")
+	if err != nil {
+		return err
+	}
+	query := url.Values{"layer_ids": {strings.Join(layerIDs, ",")}}.Encode()
+	r, err := s.Client.NewRequest(ctx, u, http.MethodGet, query, "", nil)
+	if err != nil {
+		return err
+	}
+	return s.Client.Do(r, nil)
+}
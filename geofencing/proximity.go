@@ -0,0 +1,106 @@
+package geofencing
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// DistanceInside is the distance of a geometry containing the asset position.
+const DistanceInside = -99999999
+
+type ProximityRequest struct {
+	// LayerIDs of the layers to search.
+	LayerIDs []string
+	// Position of the asset.
+	Position GeoWaypoint
+	// Radius around the position to search for geometries, in meters. Only geometries containing the position are
+	// returned when zero.
+	Radius int
+	// KeyAttributes are the attributes identifying the geometries of the layers, in the order of the layers.
+	// Defaults to GEOMETRY_ID.
+	KeyAttributes []string
+}
+
+func (r *ProximityRequest) QueryString() (string, error) {
+	if len(r.LayerIDs) == 0 {
+		return "", fmt.Errorf("invalid layer IDs: empty")
+	}
+	if r.Radius < 0 {
+		return "", fmt.Errorf("invalid radius: %d", r.Radius)
+	}
+	if len(r.KeyAttributes) > 0 && len(r.KeyAttributes) != len(r.LayerIDs) {
+		return "", fmt.Errorf(
+			"invalid key attributes: %d key attributes for %d layers", len(r.KeyAttributes), len(r.LayerIDs),
+		)
+	}
+	values := make(url.Values)
+	values.Add("layer_ids", strings.Join(r.LayerIDs, ","))
+	proximity := r.Position.String()
+	if r.Radius > 0 {
+		proximity += "," + strconv.Itoa(r.Radius)
+	}
+	values.Add("proximity", proximity)
+	if len(r.KeyAttributes) > 0 {
+		values.Add("key_attributes", strings.Join(r.KeyAttributes, ","))
+	}
+	return values.Encode(), nil
+}
+
+// ProximityResponse contains the geometries near the asset position.
+type ProximityResponse struct {
+	Geometries   []Geometry `json:"geometries"`
+	ResponseCode string     `json:"response_code"`
+}
+
+// Geometry is a fence of a layer near the asset position.
+type Geometry struct {
+	LayerID string `json:"layerId"`
+	// Attributes of the geometry, e.g. GEOMETRY_ID and NAME.
+	Attributes map[string]string `json:"attributes"`
+	// Distance from the position to the geometry in meters, DistanceInside if the geometry contains the position.
+	Distance float64 `json:"distance"`
+	// NearestLat is the latitude of the point of the geometry nearest to the position.
+	NearestLat float64 `json:"nearestLat"`
+	// NearestLong is the longitude of the point of the geometry nearest to the position.
+	NearestLong float64 `json:"nearestLon"`
+	// WKT of the geometry.
+	WKT string `json:"geometry"`
+}
+
+// Inside returns true if the geometry contains the position.
+func (g *Geometry) Inside() bool {
+	return g.Distance == DistanceInside
+}
+
+// Proximity returns the geometries of the layers containing the asset position or within a radius of it, e.g. to
+// detect a vehicle entering a fence.
+// See https://developer.here.com/documentation/geofencing/dev_guide/topics/search-proximity.html
+// for details about other parameters.
+func (s *GeofencingService) Proximity(ctx context.Context, req *ProximityRequest) (_ *ProximityResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("proximity: %w", err)
+		}
+	}()
+	query, err := req.QueryString()
+	if err != nil {
+		return nil, err
+	}
+	u, err := s.URL.Parse("search/proximity.json")
+	if err != nil {
+		return nil, err
+	}
+	r, err := s.Client.NewRequest(ctx, u, http.MethodGet, query, "", nil)
+	if err != nil {
+		return nil, err
+	}
+	var resp ProximityResponse
+	if err := s.Client.Do(r, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
@@ -0,0 +1,88 @@
+package geofencing
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	invalid     = "invalid"
+	unspecified = "unspecified"
+)
+
+type GeoWaypoint struct {
+	Lat  float64 `json:"lat"`
+	Long float64 `json:"lng"`
+}
+
+func (w GeoWaypoint) String() string {
+	return strconv.FormatFloat(w.Lat, 'f', -1, 64) + "," + strconv.FormatFloat(w.Long, 'f', -1, 64)
+}
+
+// PolygonWKT returns the WKT of a polygon with the given outer ring, e.g. "POLYGON((11.9 57.7, ...))". The ring is
+// closed if its last point does not repeat the first.
+func PolygonWKT(ring ...GeoWaypoint) string {
+	if len(ring) > 0 && ring[len(ring)-1] != ring[0] {
+		ring = append(ring[:len(ring):len(ring)], ring[0])
+	}
+	points := make([]string, 0, len(ring))
+	for _, point := range ring {
+		points = append(
+			points, strconv.FormatFloat(point.Long, 'f', -1, 64)+" "+strconv.FormatFloat(point.Lat, 'f', -1, 64),
+		)
+	}
+	return "POLYGON((" + strings.Join(points, ", ") + "))"
+}
+
+// Fence is a geometry of a fence layer.
+type Fence struct {
+	// Name of the fence, returned as the NAME attribute of the geometry in searches.
+	Name string
+	// WKT is the geometry of the fence as Well-Known Text, see PolygonWKT.
+	WKT string
+}
+
+// WKTLayer returns the content of a WKT layer file with the fences, a tab separated file with a NAME and a WKT
+// column.
+func WKTLayer(fences ...Fence) ([]byte, error) {
+	var b strings.Builder
+	b.WriteString("NAME\tWKT\n")
+	for i, fence := range fences {
+		if strings.ContainsAny(fence.Name, "\t\n") || strings.ContainsAny(fence.WKT, "\t\n") {
+			return nil, fmt.Errorf("invalid fence %d: contains tab or newline", i)
+		}
+		if fence.WKT == "" {
+			return nil, fmt.Errorf("invalid fence %d: missing WKT", i)
+		}
+		b.WriteString(fence.Name)
+		b.WriteString("\t")
+		b.WriteString(fence.WKT)
+		b.WriteString("\n")
+	}
+	return []byte(b.String()), nil
+}
+
+// LayerFormat is the file format of an uploaded fence layer.
+type LayerFormat int
+
+const (
+	LayerFormatUnspecified LayerFormat = iota
+	// LayerFormatWKT is a tab separated file with a WKT column, see WKTLayer.
+	LayerFormatWKT
+	// LayerFormatGeoJSON is a GeoJSON feature collection.
+	LayerFormatGeoJSON
+)
+
+func (f LayerFormat) String() string {
+	switch f {
+	case LayerFormatUnspecified:
+		return unspecified
+	case LayerFormatWKT:
+		return "wkt"
+	case LayerFormatGeoJSON:
+		return "geojson"
+	default:
+		return invalid
+	}
+}
@@ -0,0 +1,13 @@
+package intermodalv8
+
+import (
+	"net/http"
+
+	"go.einride.tech/here/internal/hereapi"
+)
+
+// NewAPIKeyHTTPClient returns an HTTP Client which uses the given API Key.
+// If next is nil http.DefaultTransport is used.
+func NewAPIKeyHTTPClient(key string, next http.RoundTripper) *http.Client {
+	return hereapi.NewAPIKeyHTTPClient(key, next)
+}
@@ -0,0 +1,139 @@
+package intermodalv8
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+type RoutesRequest struct {
+	Origin      GeoWaypoint
+	Destination GeoWaypoint
+	// DepartureTime from the origin. Defaults to now.
+	DepartureTime time.Time
+	// Alternatives is the number of alternative routes to return, in addition to the best route.
+	Alternatives int
+	// Vehicle enables driving your own car, e.g. EnableRouteHead for park and ride routes.
+	Vehicle Enable
+	// Transit enables public transit.
+	Transit Enable
+	// TransitModes of public transit to use, e.g. "subway". All modes are used if empty.
+	TransitModes []string
+	// Taxi enables taxi rides.
+	Taxi Enable
+	// Return attributes of the routes, e.g. "polyline" or "travelSummary".
+	Return []string
+	// Lang is the preferred language of the response, as a BCP 47 language code.
+	Lang string
+}
+
+func (r *RoutesRequest) QueryString() (string, error) {
+	values := make(url.Values)
+	values.Add("origin", r.Origin.String())
+	values.Add("destination", r.Destination.String())
+	if !r.DepartureTime.IsZero() {
+		values.Add("departureTime", r.DepartureTime.Format(time.RFC3339))
+	}
+	if r.Alternatives > 0 {
+		values.Add("alternatives", strconv.Itoa(r.Alternatives))
+	}
+	for _, mode := range []struct {
+		name   string
+		enable Enable
+	}{
+		{name: "vehicle", enable: r.Vehicle},
+		{name: "transit", enable: r.Transit},
+		{name: "taxi", enable: r.Taxi},
+	} {
+		switch enable := mode.enable.String(); enable {
+		case unspecified:
+		case invalid:
+			return "", fmt.Errorf("invalid %s enable: %d", mode.name, int(mode.enable))
+		default:
+			values.Add(mode.name+"[enable]", enable)
+		}
+	}
+	if len(r.TransitModes) > 0 {
+		if r.Transit == EnableNone {
+			return "", fmt.Errorf("invalid transit modes: transit disabled")
+		}
+		values.Add("transit[modes]", strings.Join(r.TransitModes, ","))
+	}
+	if len(r.Return) > 0 {
+		values.Add("return", strings.Join(r.Return, ","))
+	}
+	if r.Lang != "" {
+		values.Add("lang", r.Lang)
+	}
+	return values.Encode(), nil
+}
+
+// RoutesResponse contains the intermodal routes between origin and destination.
+type RoutesResponse struct {
+	Routes []Route `json:"routes"`
+}
+
+// Route is a journey combining driving, public transit, taxi rides and walking.
+type Route struct {
+	ID       string    `json:"id"`
+	Sections []Section `json:"sections"`
+}
+
+// Section is a part of a route using one means of transport.
+type Section struct {
+	ID string `json:"id"`
+	// Type of the section, e.g. SectionTypeVehicle.
+	Type          string         `json:"type"`
+	Departure     SectionPlace   `json:"departure"`
+	Arrival       SectionPlace   `json:"arrival"`
+	TravelSummary *TravelSummary `json:"travelSummary,omitempty"`
+	Transport     Transport      `json:"transport"`
+	// Agency operating the transit line or the taxi.
+	Agency *Agency `json:"agency,omitempty"`
+	// Polyline of the section, encoded as a flexible polyline.
+	Polyline string `json:"polyline,omitempty"`
+}
+
+// ParkingLot returns the parking lot where the car is parked on a park and ride route, false if the car is not
+// parked at a parking lot.
+func (r *Route) ParkingLot() (Place, bool) {
+	for _, section := range r.Sections {
+		if section.Type == SectionTypeVehicle && section.Arrival.Place.Type == PlaceTypeParkingLot {
+			return section.Arrival.Place, true
+		}
+	}
+	return Place{}, false
+}
+
+// Routes returns intermodal routes between origin and destination, combining driving, park and ride, public
+// transit and taxi rides.
+// See https://developer.here.com/documentation/intermodal-routing/dev_guide/index.html
+// for details about other parameters.
+func (s *IntermodalService) Routes(ctx context.Context, req *RoutesRequest) (_ *RoutesResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("intermodal routes: %w", err)
+		}
+	}()
+	query, err := req.QueryString()
+	if err != nil {
+		return nil, err
+	}
+	u, err := s.URL.Parse("routes")
+	if err != nil {
+		return nil, err
+	}
+	r, err := s.Client.NewRequest(ctx, u, http.MethodGet, query)
+	if err != nil {
+		return nil, err
+	}
+	var resp RoutesResponse
+	if err := s.Client.Do(r, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
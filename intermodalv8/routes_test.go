@@ -0,0 +1,100 @@
+package intermodalv8_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"go.einride.tech/here/intermodalv8"
+	"gotest.tools/v3/assert"
+)
+
+type httpClientFunc func(*http.Request) (*http.Response, error)
+
+func (f httpClientFunc) Do(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+const parkAndRideResponse = `{
+	"routes": [
+		{
+			"id": "R1",
+			"sections": [
+				{
+					"id": "R1-S1",
+					"type": "vehicle",
+					"departure": {"place": {"type": "place", "location": {"lat": 52.4, "lng": 13.1}}},
+					"arrival": {
+						"place": {
+							"name": "P+R Wannsee",
+							"type": "parkingLot",
+							"id": "p1",
+							"location": {"lat": 52.42, "lng": 13.18}
+						}
+					},
+					"transport": {"mode": "car"}
+				},
+				{
+					"id": "R1-S2",
+					"type": "pedestrian",
+					"departure": {"place": {"type": "parkingLot", "location": {"lat": 52.42, "lng": 13.18}}},
+					"arrival": {"place": {"name": "Wannsee", "type": "station", "location": {"lat": 52.421, "lng": 13.179}}},
+					"transport": {"mode": "pedestrian"}
+				},
+				{
+					"id": "R1-S3",
+					"type": "transit",
+					"departure": {"place": {"name": "Wannsee", "type": "station", "location": {"lat": 52.421, "lng": 13.179}}},
+					"arrival": {"place": {"name": "Friedrichstraße", "type": "station", "location": {"lat": 52.52, "lng": 13.387}}},
+					"transport": {"mode": "cityTrain", "name": "S1", "headsign": "Oranienburg"},
+					"agency": {"id": "a1", "name": "S-Bahn Berlin GmbH"}
+				}
+			]
+		}
+	]
+}`
+
+func TestIntermodalService_Routes(t *testing.T) {
+	t.Parallel()
+	var requested *url.URL
+	client := intermodalv8.NewClient(httpClientFunc(func(req *http.Request) (*http.Response, error) {
+		requested = req.URL
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(parkAndRideResponse))}, nil
+	}))
+	resp, err := client.Intermodal.Routes(context.Background(), &intermodalv8.RoutesRequest{
+		Origin:       intermodalv8.GeoWaypoint{Lat: 52.4, Long: 13.1},
+		Destination:  intermodalv8.GeoWaypoint{Lat: 52.52, Long: 13.387},
+		Vehicle:      intermodalv8.EnableRouteHead,
+		TransitModes: []string{"cityTrain", "subway"},
+		Taxi:         intermodalv8.EnableNone,
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, "/v8/routes", requested.Path)
+	assert.DeepEqual(t, url.Values{
+		"origin":          {"52.4,13.1"},
+		"destination":     {"52.52,13.387"},
+		"vehicle[enable]": {"routeHead"},
+		"taxi[enable]":    {""},
+		"transit[modes]":  {"cityTrain,subway"},
+	}, requested.Query())
+	route := resp.Routes[0]
+	parkingLot, ok := route.ParkingLot()
+	assert.Assert(t, ok)
+	assert.Equal(t, "P+R Wannsee", parkingLot.Name)
+	assert.Equal(t, intermodalv8.SectionTypeTransit, route.Sections[2].Type)
+	assert.Equal(t, "S-Bahn Berlin GmbH", route.Sections[2].Agency.Name)
+}
+
+func TestRoutesRequest_QueryString(t *testing.T) {
+	t.Parallel()
+	_, err := (&intermodalv8.RoutesRequest{Vehicle: 42}).QueryString()
+	assert.Error(t, err, "invalid vehicle enable: 42")
+	_, err = (&intermodalv8.RoutesRequest{
+		Transit:      intermodalv8.EnableNone,
+		TransitModes: []string{"bus"},
+	}).QueryString()
+	assert.Error(t, err, "invalid transit modes: transit disabled")
+}
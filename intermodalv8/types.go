@@ -0,0 +1,112 @@
+package intermodalv8
+
+import (
+	"strconv"
+	"time"
+)
+
+const (
+	invalid     = "invalid"
+	unspecified = "unspecified"
+)
+
+type GeoWaypoint struct {
+	Lat  float64 `json:"lat"`
+	Long float64 `json:"lng"`
+}
+
+func (w GeoWaypoint) String() string {
+	return strconv.FormatFloat(w.Lat, 'f', -1, 64) + "," + strconv.FormatFloat(w.Long, 'f', -1, 64)
+}
+
+// Enable is the part of a route a means of transport can be used for.
+type Enable int
+
+const (
+	EnableUnspecified Enable = iota
+	// EnableNone disables the means of transport.
+	EnableNone
+	// EnableRouteHead enables the means of transport at the start of the route, e.g. driving to a park and ride.
+	EnableRouteHead
+	// EnableRouteTail enables the means of transport at the end of the route.
+	EnableRouteTail
+	// EnableEntireRoute enables the means of transport for the entire route.
+	EnableEntireRoute
+)
+
+func (e Enable) String() string {
+	switch e {
+	case EnableUnspecified:
+		return unspecified
+	case EnableNone:
+		return ""
+	case EnableRouteHead:
+		return "routeHead"
+	case EnableRouteTail:
+		return "routeTail"
+	case EnableEntireRoute:
+		return "entireRoute"
+	default:
+		return invalid
+	}
+}
+
+// Section types.
+const (
+	SectionTypePedestrian = "pedestrian"
+	SectionTypeVehicle    = "vehicle"
+	SectionTypeTransit    = "transit"
+	SectionTypeTaxi       = "taxi"
+)
+
+// PlaceTypeParkingLot is the type of the parking lot places of park and ride routes.
+const PlaceTypeParkingLot = "parkingLot"
+
+// Place is a location of a departure or arrival, such as a station or a parking lot.
+type Place struct {
+	// Name of the place.
+	Name string `json:"name,omitempty"`
+	// Type of the place, e.g. "place", "station", "accessPoint" or PlaceTypeParkingLot.
+	Type string `json:"type"`
+	// Location of the place.
+	Location GeoWaypoint `json:"location"`
+	// ID of the station or parking lot.
+	ID string `json:"id,omitempty"`
+	// Platform of the departure or arrival at a station, if known.
+	Platform string `json:"platform,omitempty"`
+}
+
+// SectionPlace is the departure or arrival of a section.
+type SectionPlace struct {
+	Time  time.Time `json:"time"`
+	Place Place     `json:"place"`
+}
+
+// Transport describes the means of transport of a section.
+type Transport struct {
+	// Mode of transport, e.g. "car", "taxi", "pedestrian" or a transit mode such as "bus".
+	Mode string `json:"mode"`
+	// Name of the transit line.
+	Name string `json:"name,omitempty"`
+	// Category of the transit line, e.g. "Bus" or "Regional train".
+	Category string `json:"category,omitempty"`
+	// Headsign is the destination displayed on the transit vehicle.
+	Headsign string `json:"headsign,omitempty"`
+	// ShortName of the transit line, e.g. "S1".
+	ShortName string `json:"shortName,omitempty"`
+}
+
+// Agency operating a transit line or a taxi.
+type Agency struct {
+	ID      string `json:"id"`
+	Name    string `json:"name"`
+	Website string `json:"website,omitempty"`
+}
+
+// TravelSummary of a section.
+type TravelSummary struct {
+	// Duration in seconds.
+	Duration int `json:"duration"`
+	// Length in meters.
+	Length int `json:"length"`
+}
@@ -0,0 +1,94 @@
+package hereapi
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+)
+
+const (
+	// ProjectHRNHeader is the header carrying the HERE platform project HRN of a request.
+	ProjectHRNHeader = "X-HERE-Project-HRN"
+	// RealmHeader is the header carrying the HERE platform realm of a request.
+	RealmHeader = "X-HERE-Realm"
+)
+
+// HTTPClient sends HTTP requests, e.g. an *http.Client.
+type HTTPClient interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Client sends the requests of a service client. Failed requests are retried according to the RetryPolicy, and
+// requests are dumped to the writer of WithDebugDump.
+type Client struct {
+	// HTTPClient used to communicate with the API.
+	HTTPClient HTTPClient
+	// ProjectHRN is sent in the ProjectHRNHeader of all requests, if set.
+	ProjectHRN string
+	// Realm is sent in the RealmHeader of all requests, if set.
+	Realm string
+	// RetryPolicy for failed requests. Requests are not retried if nil.
+	RetryPolicy *RetryPolicy
+	// Clock used for retries. Defaults to SystemClock.
+	Clock Clock
+	// DebugScrubber is applied to debug dumps before they are written.
+	DebugScrubber func(dump string) string
+	// DecodeError decodes the error responses of the service. Defaults to DecodeErrorResponse.
+	DecodeError func(statusCode int, body io.Reader) error
+}
+
+// Do sends an API request and returns the API response. The API response is JSON decoded and stored in the value
+// pointed to by v, or returned as an error if an API error has occurred. If v implements the io.Writer interface,
+// the raw response will be written to v, without attempting to decode it.
+func (c *Client) Do(req *http.Request, v interface{}) error {
+	SetPlatformHeaders(req, c.ProjectHRN, c.Realm)
+	clock := c.Clock
+	if clock == nil {
+		clock = SystemClock{}
+	}
+	return c.RetryPolicy.Do(req, clock, func() (int, error) {
+		return c.do(req, v)
+	})
+}
+
+// do sends a single attempt of an API request and returns the HTTP status code of the response, or zero if no
+// response was received.
+func (c *Client) do(req *http.Request, v interface{}) (_ int, err error) {
+	dump := DebugWriter(req.Context(), c.DebugScrubber)
+	if dump != nil {
+		DumpRequest(dump, req)
+	}
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return 0, &TransportError{Err: err}
+	}
+	if dump != nil {
+		DumpResponse(dump, resp)
+	}
+	defer func() {
+		if rerr := resp.Body.Close(); err == nil {
+			err = rerr
+		}
+	}()
+	if err := CheckResponse(resp, c.DecodeError); err != nil {
+		return resp.StatusCode, err
+	}
+	if v != nil {
+		if w, ok := v.(io.Writer); ok {
+			_, err = io.Copy(w, resp.Body)
+			return resp.StatusCode, err
+		}
+		return resp.StatusCode, json.NewDecoder(resp.Body).Decode(v)
+	}
+	return resp.StatusCode, nil
+}
+
+// SetPlatformHeaders sets the HERE platform project HRN and realm headers of a request, unless they are empty.
+func SetPlatformHeaders(req *http.Request, projectHRN, realm string) {
+	if projectHRN != "" {
+		req.Header.Set(ProjectHRNHeader, projectHRN)
+	}
+	if realm != "" {
+		req.Header.Set(RealmHeader, realm)
+	}
+}
@@ -0,0 +1,280 @@
+package hereapi_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"go.einride.tech/here/internal/hereapi"
+	"gotest.tools/v3/assert"
+)
+
+type httpClientFunc func(*http.Request) (*http.Response, error)
+
+func (f httpClientFunc) Do(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// firingClock is a Clock with timers firing immediately, recording the durations waited for.
+type firingClock struct {
+	waits []time.Duration
+}
+
+func (c *firingClock) Now() time.Time {
+	return time.Time{}
+}
+
+func (c *firingClock) NewTimer(d time.Duration) hereapi.Timer {
+	c.waits = append(c.waits, d)
+	ch := make(chan time.Time, 1)
+	ch <- time.Time{}
+	return firedTimer(ch)
+}
+
+type firedTimer chan time.Time
+
+func (t firedTimer) C() <-chan time.Time { return t }
+func (t firedTimer) Stop() bool          { return false }
+
+func response(statusCode int, body string) *http.Response {
+	return &http.Response{
+		StatusCode: statusCode,
+		ProtoMajor: 1,
+		ProtoMinor: 1,
+		Header:     http.Header{},
+		Body:       io.NopCloser(strings.NewReader(body)),
+	}
+}
+
+func TestClient_Do(t *testing.T) {
+	t.Parallel()
+	client := hereapi.Client{
+		HTTPClient: httpClientFunc(func(req *http.Request) (*http.Response, error) {
+			return response(http.StatusOK, `{"id":"r1"}`), nil
+		}),
+	}
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, "https://example.com/v8/routes", nil)
+	assert.NilError(t, err)
+	var v struct {
+		ID string `json:"id"`
+	}
+	assert.NilError(t, client.Do(req, &v))
+	assert.Equal(t, "r1", v.ID)
+	var raw bytes.Buffer
+	assert.NilError(t, client.Do(req, &raw))
+	assert.Equal(t, `{"id":"r1"}`, raw.String())
+}
+
+func TestClient_Do_Retry(t *testing.T) {
+	t.Parallel()
+	statuses := []int{http.StatusServiceUnavailable, http.StatusTooManyRequests, http.StatusOK}
+	var bodies []string
+	clock := &firingClock{}
+	client := hereapi.Client{
+		HTTPClient: httpClientFunc(func(req *http.Request) (*http.Response, error) {
+			body, err := io.ReadAll(req.Body)
+			assert.NilError(t, err)
+			bodies = append(bodies, string(body))
+			status := statuses[0]
+			statuses = statuses[1:]
+			resp := response(status, `{}`)
+			if status == http.StatusTooManyRequests {
+				resp.Header.Set("Retry-After", "3")
+			}
+			return resp, nil
+		}),
+		RetryPolicy: &hereapi.RetryPolicy{
+			MaxAttempts: 3,
+			Strategy: backoffFunc(func(attempt int, suggested time.Duration) time.Duration {
+				if suggested > 0 {
+					return suggested
+				}
+				return time.Second
+			}),
+			RetryNonIdempotent: true,
+		},
+		Clock: clock,
+	}
+	req, err := http.NewRequestWithContext(
+		context.Background(), http.MethodPost, "https://example.com/v1/match", strings.NewReader("trace"),
+	)
+	assert.NilError(t, err)
+	assert.NilError(t, client.Do(req, nil))
+	assert.DeepEqual(t, []string{"trace", "trace", "trace"}, bodies)
+	assert.DeepEqual(t, []time.Duration{time.Second, 3 * time.Second}, clock.waits)
+}
+
+type backoffFunc func(attempt int, suggested time.Duration) time.Duration
+
+func (f backoffFunc) Delay(attempt int, suggested time.Duration) time.Duration {
+	return f(attempt, suggested)
+}
+
+func TestClient_Do_RetryIdempotent(t *testing.T) {
+	t.Parallel()
+	var calls int
+	client := hereapi.Client{
+		HTTPClient: httpClientFunc(func(req *http.Request) (*http.Response, error) {
+			calls++
+			return response(http.StatusServiceUnavailable, `{}`), nil
+		}),
+		RetryPolicy: &hereapi.RetryPolicy{MaxAttempts: 3},
+		Clock:       &firingClock{},
+	}
+	req, err := http.NewRequestWithContext(
+		context.Background(), http.MethodPost, "https://example.com/v8/matrix", strings.NewReader("{}"),
+	)
+	assert.NilError(t, err)
+	err = client.Do(req, nil)
+	var retryErr *hereapi.RetryError
+	assert.Assert(t, !errors.As(err, &retryErr), err)
+	assert.Equal(t, 1, calls)
+	req, err = http.NewRequestWithContext(context.Background(), http.MethodGet, "https://example.com/v8/matrix", nil)
+	assert.NilError(t, err)
+	err = client.Do(req, nil)
+	assert.Assert(t, errors.As(err, &retryErr), err)
+	assert.Equal(t, 4, calls)
+}
+
+// waitingClock is a Clock with timers never firing.
+type waitingClock struct{}
+
+func (waitingClock) Now() time.Time {
+	return time.Time{}
+}
+
+func (waitingClock) NewTimer(time.Duration) hereapi.Timer {
+	return firedTimer(nil)
+}
+
+func TestClient_Do_RetryCancelled(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithCancel(context.Background())
+	client := hereapi.Client{
+		HTTPClient: httpClientFunc(func(req *http.Request) (*http.Response, error) {
+			cancel()
+			return response(http.StatusServiceUnavailable, `{}`), nil
+		}),
+		RetryPolicy: &hereapi.RetryPolicy{MaxAttempts: 3, Backoff: time.Hour},
+		Clock:       waitingClock{},
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://example.com/v8/routes", nil)
+	assert.NilError(t, err)
+	err = client.Do(req, nil)
+	assert.Assert(t, errors.Is(err, context.Canceled), err)
+	var retryErr *hereapi.RetryError
+	assert.Assert(t, errors.As(err, &retryErr), err)
+	assert.Equal(t, 1, len(retryErr.Attempts))
+	assert.Equal(t, http.StatusServiceUnavailable, retryErr.Attempts[0].StatusCode)
+	assert.ErrorContains(t, err, "failed after 1 attempts; attempt 1: status 503")
+	assert.Assert(t, !hereapi.IsRetryable(err))
+}
+
+func TestClient_Do_Errors(t *testing.T) {
+	t.Parallel()
+	for _, tt := range []struct {
+		name         string
+		resp         *http.Response
+		err          error
+		expected     string
+		retryable    bool
+		invalidInput bool
+		auth         bool
+	}{
+		{
+			name:         "error response",
+			resp:         response(http.StatusBadRequest, `{"title":"Malformed request","status":400,"code":"E1"}`),
+			expected:     "Title: Malformed request, Status: 400, Code: E1, Cause: , Action: ",
+			invalidInput: true,
+		},
+		{
+			name:     "not JSON",
+			resp:     response(http.StatusUnauthorized, `<html>`),
+			expected: "Title: Unauthorized, Status: 401, Code: , Cause: , Action: ",
+			auth:     true,
+		},
+		{
+			name:      "server error",
+			resp:      response(http.StatusBadGateway, ``),
+			expected:  "Title: Bad Gateway, Status: 502",
+			retryable: true,
+		},
+		{
+			name:      "transport error",
+			err:       errors.New("connection refused"),
+			expected:  "connection refused",
+			retryable: true,
+		},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			client := hereapi.Client{
+				HTTPClient: httpClientFunc(func(req *http.Request) (*http.Response, error) {
+					return tt.resp, tt.err
+				}),
+			}
+			req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, "https://example.com", nil)
+			assert.NilError(t, err)
+			err = client.Do(req, nil)
+			assert.ErrorContains(t, err, tt.expected)
+			assert.Equal(t, tt.retryable, hereapi.IsRetryable(err))
+			assert.Equal(t, tt.invalidInput, hereapi.IsInvalidInput(err))
+			assert.Equal(t, tt.auth, hereapi.IsAuth(err))
+		})
+	}
+}
+
+func TestClient_Do_DecodeError(t *testing.T) {
+	t.Parallel()
+	type errorResponse struct{ error }
+	client := hereapi.Client{
+		HTTPClient: httpClientFunc(func(req *http.Request) (*http.Response, error) {
+			return response(http.StatusNotFound, `layer not found`), nil
+		}),
+		DecodeError: func(statusCode int, body io.Reader) error {
+			b, _ := io.ReadAll(body)
+			return &errorResponse{error: errors.New(string(b))}
+		},
+	}
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, "https://example.com", nil)
+	assert.NilError(t, err)
+	err = client.Do(req, nil)
+	assert.Error(t, err, "layer not found")
+	var responseErr *errorResponse
+	assert.Assert(t, errors.As(err, &responseErr))
+}
+
+func TestClient_Do_DebugDump(t *testing.T) {
+	t.Parallel()
+	client := hereapi.Client{
+		HTTPClient: hereapi.NewAPIKeyHTTPClient("secret", roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			assert.Equal(t, "secret", req.URL.Query().Get("apiKey"))
+			return response(http.StatusOK, `{"lat":57.7}`), nil
+		})),
+		DebugScrubber: func(dump string) string {
+			return strings.ReplaceAll(dump, "57.7", "LAT")
+		},
+	}
+	var dump bytes.Buffer
+	ctx := hereapi.WithDebugDump(context.Background(), &dump)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://example.com/v1?at=57.7", nil)
+	assert.NilError(t, err)
+	req.Header.Set("Authorization", "Bearer secret")
+	assert.NilError(t, client.Do(req, nil))
+	assert.Assert(t, !strings.Contains(dump.String(), "secret"), dump.String())
+	assert.Assert(t, !strings.Contains(dump.String(), "57.7"), dump.String())
+	assert.Assert(t, strings.Contains(dump.String(), "Authorization: REDACTED"))
+	assert.Assert(t, strings.Contains(dump.String(), `{"lat":LAT}`))
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
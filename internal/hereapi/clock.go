@@ -0,0 +1,62 @@
+package hereapi
+
+import (
+	"context"
+	"time"
+)
+
+// Clock provides the current time and timers. Tests can inject a fake Clock in the Client to control time
+// deterministically instead of sleeping.
+type Clock interface {
+	// Now returns the current time.
+	Now() time.Time
+	// NewTimer returns a timer which sends the current time on its channel after the duration has elapsed.
+	NewTimer(d time.Duration) Timer
+}
+
+// Timer is a single event timer of a Clock.
+type Timer interface {
+	// C returns the channel on which the time is sent.
+	C() <-chan time.Time
+	// Stop prevents the timer from firing, and releases it. Stop returns false if the timer already fired.
+	Stop() bool
+}
+
+// SystemClock is the Clock of the operating system.
+type SystemClock struct{}
+
+var _ Clock = SystemClock{}
+
+// Now returns time.Now().
+func (SystemClock) Now() time.Time {
+	return time.Now()
+}
+
+// NewTimer returns a timer of time.NewTimer(d).
+func (SystemClock) NewTimer(d time.Duration) Timer {
+	return systemTimer{timer: time.NewTimer(d)}
+}
+
+type systemTimer struct {
+	timer *time.Timer
+}
+
+func (t systemTimer) C() <-chan time.Time {
+	return t.timer.C
+}
+
+func (t systemTimer) Stop() bool {
+	return t.timer.Stop()
+}
+
+// Sleep waits for the duration to elapse on the clock, or until the context is done.
+func Sleep(ctx context.Context, clock Clock, d time.Duration) error {
+	timer := clock.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C():
+		return nil
+	}
+}
@@ -0,0 +1,75 @@
+package hereapi
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httputil"
+)
+
+const redacted = "REDACTED"
+
+type debugContextKey struct{}
+
+// WithDebugDump returns a context which dumps the requests sent with it, and their responses, to w.
+// Credentials are redacted from the dump. Dumping is enabled per call to avoid logging all traffic of a client.
+func WithDebugDump(ctx context.Context, w io.Writer) context.Context {
+	return context.WithValue(ctx, debugContextKey{}, w)
+}
+
+// DebugWriter returns the writer of the debug dumps of the context, scrubbed by scrub if not nil. DebugWriter
+// returns nil if dumping is not enabled for the context.
+func DebugWriter(ctx context.Context, scrub func(dump string) string) io.Writer {
+	w, _ := ctx.Value(debugContextKey{}).(io.Writer)
+	if w != nil && scrub != nil {
+		return &scrubWriter{w: w, scrub: scrub}
+	}
+	return w
+}
+
+// DumpRequest writes the sanitized request to w. The body of the request is restored after it has been read.
+func DumpRequest(w io.Writer, req *http.Request) {
+	sanitized := req.Clone(req.Context())
+	for _, header := range []string{"Authorization", "Proxy-Authorization"} {
+		if sanitized.Header.Get(header) != "" {
+			sanitized.Header.Set(header, redacted)
+		}
+	}
+	if query := sanitized.URL.Query(); query.Get("apiKey") != "" {
+		query.Set("apiKey", redacted)
+		sanitized.URL.RawQuery = query.Encode()
+	}
+	dump, err := httputil.DumpRequestOut(sanitized, true)
+	req.Body = sanitized.Body
+	if err != nil {
+		_, _ = fmt.Fprintf(w, "dump request: %v\n", err)
+		return
+	}
+	_, _ = w.Write(dump)
+	_, _ = io.WriteString(w, "\n")
+}
+
+// DumpResponse writes the response to w. The body of the response is restored after it has been read.
+func DumpResponse(w io.Writer, resp *http.Response) {
+	dump, err := httputil.DumpResponse(resp, true)
+	if err != nil {
+		_, _ = fmt.Fprintf(w, "dump response: %v\n", err)
+		return
+	}
+	_, _ = w.Write(dump)
+	_, _ = io.WriteString(w, "\n")
+}
+
+// scrubWriter scrubs each write, which are complete dumps, before writing it to w.
+type scrubWriter struct {
+	w     io.Writer
+	scrub func(string) string
+}
+
+func (s *scrubWriter) Write(p []byte) (int, error) {
+	if _, err := io.WriteString(s.w, s.scrub(string(p))); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
@@ -0,0 +1,151 @@
+package hereapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// TransportError is returned when a request failed without receiving a response.
+type TransportError struct {
+	Err error
+}
+
+func (e *TransportError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *TransportError) Unwrap() error {
+	return e.Err
+}
+
+// A ResponseError reports the error caused by an API request.
+type ResponseError struct {
+	// StatusCode of the HTTP response that caused this error
+	StatusCode int
+	// RetryAfter is the delay suggested by the Retry-After header, zero if not present
+	RetryAfter time.Duration
+	// Response is the decoded error response of the service, e.g. an *ErrorResponse
+	Response error
+}
+
+func (r *ResponseError) Error() string {
+	return r.Response.Error()
+}
+
+// Unwrap returns the error response of the service.
+func (r *ResponseError) Unwrap() error {
+	return r.Response
+}
+
+// ErrorResponse is the error response of most HERE APIs.
+type ErrorResponse struct {
+	// Title of the error
+	Title string `json:"title"`
+	// Http status code
+	Status int `json:"status"`
+	// Here Maps API error code
+	Code string `json:"code"`
+	// Cause of the error
+	Cause string `json:"cause"`
+	// Action Suggested to fix error
+	Action string `json:"action"`
+	// CorrelationID of the request
+	CorrelationID string `json:"correlationId"`
+}
+
+func (r *ErrorResponse) Error() string {
+	return fmt.Sprintf(
+		"Title: %v, Status: %d, Code: %v, Cause: %v, Action: %v",
+		r.Title,
+		r.Status,
+		r.Code,
+		r.Cause,
+		r.Action,
+	)
+}
+
+// DecodeErrorResponse decodes the body of an error response into an *ErrorResponse.
+func DecodeErrorResponse(statusCode int, body io.Reader) error {
+	var response ErrorResponse
+	if err := json.NewDecoder(body).Decode(&response); err != nil {
+		// Errors from gateways and load balancers are not necessarily JSON.
+		response = ErrorResponse{Title: http.StatusText(statusCode), Status: statusCode}
+	}
+	return &response
+}
+
+// CheckResponse checks the API response for errors, and returns them as a *ResponseError if present. A response
+// is considered an error if it has a status code outside the 200 range. The body of the response is decoded by
+// decode, DecodeErrorResponse if nil.
+func CheckResponse(r *http.Response, decode func(statusCode int, body io.Reader) error) error {
+	if c := r.StatusCode; c >= 200 && c <= 299 {
+		return nil
+	}
+	if decode == nil {
+		decode = DecodeErrorResponse
+	}
+	return &ResponseError{
+		StatusCode: r.StatusCode,
+		RetryAfter: RetryAfter(r.Header),
+		Response:   decode(r.StatusCode, r.Body),
+	}
+}
+
+// RetryAfter parses the Retry-After header, in either of its delay-seconds or HTTP-date forms.
+func RetryAfter(h http.Header) time.Duration {
+	value := h.Get("Retry-After")
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds > 0 {
+		return time.Duration(seconds) * time.Second
+	}
+	if t, err := http.ParseTime(value); err == nil {
+		if d := time.Until(t); d > 0 {
+			return d
+		}
+	}
+	return 0
+}
+
+// IsRetryable reports whether err is a temporary failure, which may succeed if the request is retried. Network
+// errors, rate limited requests and server errors are retryable.
+func IsRetryable(err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	var transportErr *TransportError
+	if errors.As(err, &transportErr) {
+		return true
+	}
+	var responseErr *ResponseError
+	if errors.As(err, &responseErr) {
+		return responseErr.StatusCode == http.StatusTooManyRequests || responseErr.StatusCode >= 500
+	}
+	return false
+}
+
+// IsInvalidInput reports whether err is a response of the API rejecting the request as invalid.
+func IsInvalidInput(err error) bool {
+	var responseErr *ResponseError
+	if errors.As(err, &responseErr) {
+		return responseErr.StatusCode == http.StatusBadRequest ||
+			responseErr.StatusCode == http.StatusUnprocessableEntity
+	}
+	return false
+}
+
+// IsAuth reports whether err is a response of the API rejecting the credentials of the request.
+func IsAuth(err error) bool {
+	var responseErr *ResponseError
+	if errors.As(err, &responseErr) {
+		return responseErr.StatusCode == http.StatusUnauthorized || responseErr.StatusCode == http.StatusForbidden
+	}
+	return false
+}
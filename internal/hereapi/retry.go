@@ -0,0 +1,175 @@
+package hereapi
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Backoff decides how long to wait before polling an asynchronous calculation again or retrying a request.
+type Backoff interface {
+	// Delay returns the delay before the given attempt, starting at 1 for the first poll or retry.
+	// Suggested is the interval suggested by the server, zero if the server did not suggest one.
+	Delay(attempt int, suggested time.Duration) time.Duration
+}
+
+// ExponentialBackoff multiplies the delay by Multiplier for every attempt, up to Max.
+type ExponentialBackoff struct {
+	// Initial delay before the first attempt.
+	Initial time.Duration
+	// Max delay. Zero means no limit.
+	Max time.Duration
+	// Multiplier of the delay for every attempt. Defaults to 2.
+	Multiplier float64
+}
+
+var _ Backoff = ExponentialBackoff{}
+
+// Delay returns Initial * Multiplier^(attempt-1), limited to Max.
+func (e ExponentialBackoff) Delay(attempt int, _ time.Duration) time.Duration {
+	multiplier := e.Multiplier
+	if multiplier == 0 {
+		multiplier = 2
+	}
+	if attempt < 1 {
+		attempt = 1
+	}
+	delay := float64(e.Initial) * math.Pow(multiplier, float64(attempt-1))
+	if e.Max > 0 && delay > float64(e.Max) {
+		return e.Max
+	}
+	if delay > math.MaxInt64 {
+		return time.Duration(math.MaxInt64)
+	}
+	return time.Duration(delay)
+}
+
+// RetryPolicy configures how failed requests are retried. Errors classified by IsRetryable are retried, other
+// errors are returned immediately. Only requests with idempotent methods are retried, unless RetryNonIdempotent is
+// set.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts, including the first one.
+	MaxAttempts int
+	// Backoff is the delay before the first retry, doubled for every following retry.
+	Backoff time.Duration
+	// Strategy overrides Backoff with a custom strategy. The delay suggested by the server in the Retry-After
+	// header is passed to the strategy.
+	Strategy Backoff
+	// RetryNonIdempotent enables retries of requests with methods which are not idempotent, such as POST. A POST
+	// request whose response was lost may still have been processed, e.g. a billable matrix calculation, which is
+	// then submitted and billed again when retried.
+	RetryNonIdempotent bool
+}
+
+// retries reports whether requests with the method are retried.
+func (p *RetryPolicy) retries(method string) bool {
+	switch method {
+	case "", http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return p.RetryNonIdempotent
+}
+
+func (p *RetryPolicy) strategy() Backoff {
+	if p.Strategy != nil {
+		return p.Strategy
+	}
+	return ExponentialBackoff{Initial: p.Backoff}
+}
+
+// Attempt describes a single failed attempt of a request.
+type Attempt struct {
+	// StatusCode of the response, zero if no response was received.
+	StatusCode int
+	// Latency of the attempt.
+	Latency time.Duration
+	// Err is the error of the attempt.
+	Err error
+}
+
+func (a Attempt) String() string {
+	if a.StatusCode == 0 {
+		return fmt.Sprintf("%v after %v", a.Err, a.Latency)
+	}
+	return fmt.Sprintf("status %d after %v: %v", a.StatusCode, a.Latency, a.Err)
+}
+
+// RetryError is returned when a request failed after more than one attempt, or when the retries were cancelled.
+type RetryError struct {
+	// Attempts in the order they were made.
+	Attempts []Attempt
+	// Err is the error of the context if it was done while waiting for a retry, nil otherwise.
+	Err error
+}
+
+func (r *RetryError) Error() string {
+	var b strings.Builder
+	_, _ = fmt.Fprintf(&b, "failed after %d attempts", len(r.Attempts))
+	for i, attempt := range r.Attempts {
+		_, _ = fmt.Fprintf(&b, "; attempt %d: %v", i+1, attempt)
+	}
+	if r.Err != nil {
+		_, _ = fmt.Fprintf(&b, "; %v", r.Err)
+	}
+	return b.String()
+}
+
+// Unwrap returns the error of the context if it was done while waiting for a retry, or else the error of the last
+// attempt.
+func (r *RetryError) Unwrap() error {
+	if r.Err != nil {
+		return r.Err
+	}
+	if len(r.Attempts) == 0 {
+		return nil
+	}
+	return r.Attempts[len(r.Attempts)-1].Err
+}
+
+// Do calls attempt until it succeeds, fails with a permanent error, the attempts are exhausted or the context of the
+// request is done while waiting for a retry. Attempt returns the HTTP status code of the response, zero if no
+// response was received. The policy is ignored if p is nil.
+func (p *RetryPolicy) Do(req *http.Request, clock Clock, attempt func() (int, error)) error {
+	if p == nil || p.MaxAttempts <= 1 || !p.retries(req.Method) {
+		_, err := attempt()
+		return err
+	}
+	ctx := req.Context()
+	attempts := make([]Attempt, 0, p.MaxAttempts)
+	strategy := p.strategy()
+	for i := 0; i < p.MaxAttempts; i++ {
+		if i > 0 {
+			var suggested time.Duration
+			var responseErr *ResponseError
+			if errors.As(attempts[i-1].Err, &responseErr) {
+				suggested = responseErr.RetryAfter
+			}
+			if err := Sleep(ctx, clock, strategy.Delay(i, suggested)); err != nil {
+				return &RetryError{Attempts: attempts, Err: err}
+			}
+			if req.GetBody != nil {
+				body, err := req.GetBody()
+				if err != nil {
+					return err
+				}
+				req.Body = body
+			}
+		}
+		start := clock.Now()
+		statusCode, err := attempt()
+		if err == nil {
+			return nil
+		}
+		attempts = append(attempts, Attempt{StatusCode: statusCode, Latency: clock.Now().Sub(start), Err: err})
+		if !IsRetryable(err) {
+			break
+		}
+	}
+	if len(attempts) == 1 {
+		return attempts[0].Err
+	}
+	return &RetryError{Attempts: attempts}
+}
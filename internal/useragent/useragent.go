@@ -0,0 +1,8 @@
+// Package useragent provides the User-Agent header sent by the clients of all services of the library.
+package useragent
+
+// Version of the library.
+const Version = "0.9.0"
+
+// UserAgent sent by the clients of all services, with the version of the library.
+const UserAgent = "einride/here-go/" + Version
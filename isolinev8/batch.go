@@ -0,0 +1,77 @@
+package isolinev8
+
+import (
+	"context"
+	"sort"
+	"sync"
+)
+
+// IsolinesBatchResult is the result of calculating the isolines of one of the origins of a batch.
+type IsolinesBatchResult struct {
+	// Isolines of the origin, one per range value.
+	Isolines []Isoline
+	// Err is the error of the request of the origin, if any.
+	Err error
+}
+
+// IsolinesBatch calculates the isolines of the ranges from many origins, e.g. for coverage maps of a network of
+// facilities, with at most concurrency requests at a time. The origins and the results are keyed by an ID chosen by
+// the caller. The other parameters of the requests are taken from the template, whose origin, destination and
+// range are ignored. An error is only returned if the context is done.
+func (s *IsolineService) IsolinesBatch(
+	ctx context.Context,
+	origins map[string]GeoWaypoint,
+	ranges Range,
+	concurrency int,
+	template IsolinesRequest,
+) (map[string]IsolinesBatchResult, error) {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	ids := make([]string, 0, len(origins))
+	for id := range origins {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	var mu sync.Mutex
+	results := make(map[string]IsolinesBatchResult, len(origins))
+	queue := make(chan string)
+	var wg sync.WaitGroup
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for id := range queue {
+				origin := origins[id]
+				req := template
+				req.Origin = &origin
+				req.Destination = nil
+				req.Range = ranges
+				resp, err := s.Isolines(ctx, &req)
+				var result IsolinesBatchResult
+				if err != nil {
+					result.Err = err
+				} else {
+					result.Isolines = resp.Isolines
+				}
+				mu.Lock()
+				results[id] = result
+				mu.Unlock()
+			}
+		}()
+	}
+SendLoop:
+	for _, id := range ids {
+		select {
+		case queue <- id:
+		case <-ctx.Done():
+			break SendLoop
+		}
+	}
+	close(queue)
+	wg.Wait()
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
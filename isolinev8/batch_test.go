@@ -0,0 +1,76 @@
+package isolinev8_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"go.einride.tech/here/isolinev8"
+	"gotest.tools/v3/assert"
+)
+
+func TestIsolineService_IsolinesBatch(t *testing.T) {
+	t.Parallel()
+	var mu sync.Mutex
+	var queries []string
+	client := isolinev8.NewClient(httpClientFunc(func(req *http.Request) (*http.Response, error) {
+		query := req.URL.Query()
+		mu.Lock()
+		queries = append(queries, query.Encode())
+		mu.Unlock()
+		if query.Get("origin") == "0,0" {
+			return &http.Response{
+				StatusCode: http.StatusBadRequest,
+				Body:       io.NopCloser(strings.NewReader(`{"title":"Unreachable","status":400}`)),
+			}, nil
+		}
+		body := fmt.Sprintf(`{"isolines":[{"range":{"type":"time","value":%s},"polygons":[]}]}`, query.Get("range[values]"))
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(body))}, nil
+	}))
+	results, err := client.Isoline.IsolinesBatch(
+		context.Background(),
+		map[string]isolinev8.GeoWaypoint{
+			"gothenburg": {Lat: 57.707752, Long: 11.949767},
+			"stockholm":  {Lat: 59.337492, Long: 18.063672},
+			"nowhere":    {Lat: 0, Long: 0},
+		},
+		isolinev8.Range{Type: isolinev8.RangeTypeTime, Values: []int{900}},
+		2,
+		isolinev8.IsolinesRequest{
+			TransportMode: isolinev8.TransportModeTruck,
+			Destination:   &isolinev8.GeoWaypoint{Lat: 1, Long: 1},
+		},
+	)
+	assert.NilError(t, err)
+	assert.Equal(t, 3, len(results))
+	assert.Equal(t, 3, len(queries))
+	for _, query := range queries {
+		assert.Assert(t, !strings.Contains(query, "destination"), query)
+		assert.Assert(t, strings.Contains(query, "transportMode=truck"), query)
+	}
+	assert.NilError(t, results["gothenburg"].Err)
+	assert.Equal(t, 900, results["gothenburg"].Isolines[0].Range.Value)
+	assert.NilError(t, results["stockholm"].Err)
+	assert.ErrorContains(t, results["nowhere"].Err, "Unreachable")
+}
+
+func TestIsolineService_IsolinesBatch_Cancelled(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	client := isolinev8.NewClient(httpClientFunc(func(req *http.Request) (*http.Response, error) {
+		return nil, req.Context().Err()
+	}))
+	_, err := client.Isoline.IsolinesBatch(
+		ctx,
+		map[string]isolinev8.GeoWaypoint{"gothenburg": {Lat: 57.707752, Long: 11.949767}},
+		isolinev8.Range{Type: isolinev8.RangeTypeTime, Values: []int{900}},
+		1,
+		isolinev8.IsolinesRequest{TransportMode: isolinev8.TransportModeCar},
+	)
+	assert.ErrorIs(t, err, context.Canceled)
+}
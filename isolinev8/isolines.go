@@ -0,0 +1,184 @@
+package isolinev8
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.einride.tech/here/flexpolyline"
+)
+
+type IsolinesRequest struct {
+	// Origin to calculate the isolines from, the area reachable from the origin. Can not be combined with
+	// Destination.
+	Origin *GeoWaypoint
+	// Destination to calculate the isolines to, the area from which the destination can be reached. Can not be
+	// combined with Origin.
+	Destination   *GeoWaypoint
+	TransportMode TransportMode
+	// RoutingMode optimizes the isolines for the fastest or the shortest routes. Defaults to RoutingModeFast.
+	RoutingMode RoutingMode
+	// Range of the isolines.
+	Range Range
+	// MaxPoints limits the number of points of each polygon. No limit if zero.
+	MaxPoints int
+	// DepartureTime from the origin. Defaults to now.
+	DepartureTime time.Time
+	// ArrivalTime at the destination.
+	ArrivalTime time.Time
+	// EV consumption parameters, required for RangeTypeConsumption.
+	EV *EV
+}
+
+func (r *IsolinesRequest) QueryString() (string, error) {
+	values := make(url.Values)
+	switch {
+	case r.Origin != nil && r.Destination != nil:
+		return "", fmt.Errorf("invalid request: both origin and destination set")
+	case r.Origin != nil:
+		values.Add("origin", r.Origin.String())
+	case r.Destination != nil:
+		values.Add("destination", r.Destination.String())
+	default:
+		return "", fmt.Errorf("invalid request: origin or destination required")
+	}
+	tm := r.TransportMode.String()
+	if tm == invalid || tm == unspecified {
+		return "", fmt.Errorf("invalid transportmode")
+	}
+	values.Add("transportMode", tm)
+	switch rm := r.RoutingMode.String(); rm {
+	case unspecified:
+	case invalid:
+		return "", fmt.Errorf("invalid routingmode")
+	default:
+		values.Add("routingMode", rm)
+	}
+	rt := r.Range.Type.String()
+	if rt == invalid || rt == unspecified {
+		return "", fmt.Errorf("invalid range type")
+	}
+	if len(r.Range.Values) == 0 {
+		return "", fmt.Errorf("invalid range: no values")
+	}
+	rangeValues := make([]string, 0, len(r.Range.Values))
+	for _, value := range r.Range.Values {
+		if value <= 0 {
+			return "", fmt.Errorf("invalid range value: %d", value)
+		}
+		rangeValues = append(rangeValues, strconv.Itoa(value))
+	}
+	values.Add("range[type]", rt)
+	values.Add("range[values]", strings.Join(rangeValues, ","))
+	if r.MaxPoints > 0 {
+		values.Add("shape[maxPoints]", strconv.Itoa(r.MaxPoints))
+	}
+	if !r.DepartureTime.IsZero() && !r.ArrivalTime.IsZero() {
+		return "", fmt.Errorf("invalid time: both departure and arrival time set")
+	}
+	if !r.DepartureTime.IsZero() {
+		values.Add("departureTime", r.DepartureTime.Format(time.RFC3339))
+	}
+	if !r.ArrivalTime.IsZero() {
+		values.Add("arrivalTime", r.ArrivalTime.Format(time.RFC3339))
+	}
+	if r.Range.Type == RangeTypeConsumption && (r.EV == nil || len(r.EV.FreeFlowSpeedTable) == 0) {
+		return "", fmt.Errorf("invalid EV: free flow speed table required for consumption range")
+	}
+	if r.EV != nil {
+		values.Add("ev[freeFlowSpeedTable]", speedTable(r.EV.FreeFlowSpeedTable))
+		if len(r.EV.TrafficSpeedTable) > 0 {
+			values.Add("ev[trafficSpeedTable]", speedTable(r.EV.TrafficSpeedTable))
+		}
+		if r.EV.AuxiliaryConsumption > 0 {
+			values.Add("ev[auxiliaryConsumption]", formatFloat(r.EV.AuxiliaryConsumption))
+		}
+		if r.EV.Ascent > 0 {
+			values.Add("ev[ascent]", formatFloat(r.EV.Ascent))
+		}
+		if r.EV.Descent > 0 {
+			values.Add("ev[descent]", formatFloat(r.EV.Descent))
+		}
+	}
+	return values.Encode(), nil
+}
+
+// IsolinesResponse contains one isoline per requested range value.
+type IsolinesResponse struct {
+	Isolines []Isoline `json:"isolines"`
+}
+
+// Isoline is the area reachable within a range value.
+type Isoline struct {
+	Range    IsolineRange `json:"range"`
+	Polygons []Polygon    `json:"polygons"`
+}
+
+// IsolineRange is the range value of an isoline.
+type IsolineRange struct {
+	// Type of the range, e.g. "time".
+	Type string `json:"type"`
+	// Value of the range in seconds, meters or Wh.
+	Value int `json:"value"`
+}
+
+// Polygon is an area of an isoline, with its rings encoded as flexible polylines.
+type Polygon struct {
+	// Outer ring of the polygon.
+	Outer string `json:"outer"`
+	// Inner rings are holes in the polygon.
+	Inner []string `json:"inner,omitempty"`
+}
+
+// Ring is a closed sequence of coordinates.
+type Ring []GeoWaypoint
+
+// Rings decodes the rings of the polygon, starting with the outer ring followed by the inner rings.
+func (p *Polygon) Rings() ([]Ring, error) {
+	rings := make([]Ring, 0, 1+len(p.Inner))
+	for _, encoded := range append([]string{p.Outer}, p.Inner...) {
+		polyline, err := flexpolyline.Decode(encoded)
+		if err != nil {
+			return nil, fmt.Errorf("polygon rings: %v", err)
+		}
+		ring := make(Ring, 0, len(polyline.Points))
+		for _, point := range polyline.Points {
+			ring = append(ring, GeoWaypoint{Lat: point.Lat, Long: point.Lng})
+		}
+		rings = append(rings, ring)
+	}
+	return rings, nil
+}
+
+// Isolines returns the areas reachable from an origin, or from which a destination can be reached, within the
+// requested ranges.
+// See https://developer.here.com/documentation/isoline-routing-api/dev_guide/index.html
+// for details about other parameters.
+func (s *IsolineService) Isolines(ctx context.Context, req *IsolinesRequest) (_ *IsolinesResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("isolines: %w", err)
+		}
+	}()
+	query, err := req.QueryString()
+	if err != nil {
+		return nil, err
+	}
+	u, err := s.URL.Parse("isolines")
+	if err != nil {
+		return nil, err
+	}
+	r, err := s.Client.NewRequest(ctx, u, http.MethodGet, query)
+	if err != nil {
+		return nil, err
+	}
+	var resp IsolinesResponse
+	if err := s.Client.Do(r, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
@@ -0,0 +1,108 @@
+package isolinev8_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"go.einride.tech/here/isolinev8"
+	"gotest.tools/v3/assert"
+)
+
+type httpClientFunc func(*http.Request) (*http.Response, error)
+
+func (f httpClientFunc) Do(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestIsolineService_Isolines(t *testing.T) {
+	t.Parallel()
+	var requested *url.URL
+	client := isolinev8.NewClient(httpClientFunc(func(req *http.Request) (*http.Response, error) {
+		requested = req.URL
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(`{
+			"isolines": [
+				{
+					"range": {"type": "time", "value": 600},
+					"polygons": [{"outer": "BFoz5xJ67i1B1B7PzIhaxL7Y", "inner": ["BFoz5xJ67i1B1B7P"]}]
+				}
+			]
+		}`))}, nil
+	}))
+	resp, err := client.Isoline.Isolines(context.Background(), &isolinev8.IsolinesRequest{
+		Destination:   &isolinev8.GeoWaypoint{Lat: 50.10228, Long: 8.69821},
+		TransportMode: isolinev8.TransportModeTruck,
+		RoutingMode:   isolinev8.RoutingModeShort,
+		Range:         isolinev8.Range{Type: isolinev8.RangeTypeTime, Values: []int{300, 600}},
+		MaxPoints:     100,
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, "isoline.router.hereapi.com", requested.Host)
+	assert.Equal(t, "/v8/isolines", requested.Path)
+	query := requested.Query()
+	assert.Equal(t, "50.10228,8.69821", query.Get("destination"))
+	assert.Equal(t, "", query.Get("origin"))
+	assert.Equal(t, "truck", query.Get("transportMode"))
+	assert.Equal(t, "short", query.Get("routingMode"))
+	assert.Equal(t, "time", query.Get("range[type]"))
+	assert.Equal(t, "300,600", query.Get("range[values]"))
+	assert.Equal(t, "100", query.Get("shape[maxPoints]"))
+	assert.Equal(t, 1, len(resp.Isolines))
+	assert.Equal(t, 600, resp.Isolines[0].Range.Value)
+	rings, err := resp.Isolines[0].Polygons[0].Rings()
+	assert.NilError(t, err)
+	assert.Equal(t, 2, len(rings))
+	assert.Equal(t, 4, len(rings[0]))
+	assert.Equal(t, isolinev8.GeoWaypoint{Lat: 50.09878, Long: 8.68752}, rings[0][3])
+	assert.Equal(t, 2, len(rings[1]))
+}
+
+func TestIsolinesRequest_QueryString(t *testing.T) {
+	t.Parallel()
+	origin := &isolinev8.GeoWaypoint{Lat: 57.707752, Long: 11.949767}
+	query, err := (&isolinev8.IsolinesRequest{
+		Origin:        origin,
+		TransportMode: isolinev8.TransportModeCar,
+		Range:         isolinev8.Range{Type: isolinev8.RangeTypeConsumption, Values: []int{5000}},
+		EV: &isolinev8.EV{
+			FreeFlowSpeedTable: []isolinev8.SpeedConsumption{{Speed: 0, Consumption: 0.239}, {Speed: 27, Consumption: 0.239}},
+			Ascent:             9,
+		},
+	}).QueryString()
+	assert.NilError(t, err)
+	values, err := url.ParseQuery(query)
+	assert.NilError(t, err)
+	assert.Equal(t, "0,0.239,27,0.239", values.Get("ev[freeFlowSpeedTable]"))
+	assert.Equal(t, "9", values.Get("ev[ascent]"))
+	for _, tt := range []struct {
+		req *isolinev8.IsolinesRequest
+		err string
+	}{
+		{
+			req: &isolinev8.IsolinesRequest{TransportMode: isolinev8.TransportModeCar},
+			err: "invalid request: origin or destination required",
+		},
+		{
+			req: &isolinev8.IsolinesRequest{Origin: origin, Destination: origin},
+			err: "invalid request: both origin and destination set",
+		},
+		{
+			req: &isolinev8.IsolinesRequest{Origin: origin, TransportMode: isolinev8.TransportModeCar},
+			err: "invalid range type",
+		},
+		{
+			req: &isolinev8.IsolinesRequest{
+				Origin:        origin,
+				TransportMode: isolinev8.TransportModeCar,
+				Range:         isolinev8.Range{Type: isolinev8.RangeTypeConsumption, Values: []int{5000}},
+			},
+			err: "invalid EV: free flow speed table required for consumption range",
+		},
+	} {
+		_, err := tt.req.QueryString()
+		assert.Error(t, err, tt.err)
+	}
+}
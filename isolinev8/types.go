@@ -0,0 +1,135 @@
+package isolinev8
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const (
+	invalid     = "invalid"
+	unspecified = "unspecified"
+)
+
+type GeoWaypoint struct {
+	Lat  float64 `json:"lat"`
+	Long float64 `json:"lng"`
+	Elv  float64 `json:"elv,omitempty"`
+}
+
+func (w GeoWaypoint) String() string {
+	return fmt.Sprintf("%v,%v", w.Lat, w.Long)
+}
+
+type TransportMode int
+
+const (
+	TransportModeUnspecified TransportMode = iota
+	TransportModeCar
+	TransportModeTruck
+	TransportModePedestrian
+)
+
+func (t TransportMode) String() string {
+	switch t {
+	case TransportModeUnspecified:
+		return unspecified
+	case TransportModeCar:
+		return "car"
+	case TransportModeTruck:
+		return "truck"
+	case TransportModePedestrian:
+		return "pedestrian"
+	default:
+		return invalid
+	}
+}
+
+type RoutingMode int
+
+const (
+	RoutingModeUnspecified RoutingMode = iota
+	RoutingModeFast
+	RoutingModeShort
+)
+
+func (r RoutingMode) String() string {
+	switch r {
+	case RoutingModeUnspecified:
+		return unspecified
+	case RoutingModeFast:
+		return "fast"
+	case RoutingModeShort:
+		return "short"
+	default:
+		return invalid
+	}
+}
+
+// RangeType is the unit of the range of isolines.
+type RangeType int
+
+const (
+	RangeTypeUnspecified RangeType = iota
+	// RangeTypeTime is a range of travel time in seconds.
+	RangeTypeTime
+	// RangeTypeDistance is a range of distance in meters.
+	RangeTypeDistance
+	// RangeTypeConsumption is a range of energy consumption in Wh. Requires EV consumption parameters.
+	RangeTypeConsumption
+)
+
+func (r RangeType) String() string {
+	switch r {
+	case RangeTypeUnspecified:
+		return unspecified
+	case RangeTypeTime:
+		return "time"
+	case RangeTypeDistance:
+		return "distance"
+	case RangeTypeConsumption:
+		return "consumption"
+	default:
+		return invalid
+	}
+}
+
+// Range of isolines, one isoline is calculated per value.
+type Range struct {
+	Type   RangeType
+	Values []int
+}
+
+// SpeedConsumption is a point of a consumption table of an electric vehicle.
+type SpeedConsumption struct {
+	// Speed in km/h.
+	Speed float64
+	// Consumption in Wh/m at the speed.
+	Consumption float64
+}
+
+// EV describes the energy consumption of an electric vehicle, for isolines with a consumption range.
+type EV struct {
+	// FreeFlowSpeedTable is the consumption by speed without traffic, sorted by speed. Required.
+	FreeFlowSpeedTable []SpeedConsumption
+	// TrafficSpeedTable is the consumption by speed in heavy traffic, sorted by speed.
+	TrafficSpeedTable []SpeedConsumption
+	// AuxiliaryConsumption in Wh/s, e.g. for climate control.
+	AuxiliaryConsumption float64
+	// Ascent is the additional consumption in Wh/m of elevation gain.
+	Ascent float64
+	// Descent is the recovery in Wh/m of elevation loss.
+	Descent float64
+}
+
+func speedTable(table []SpeedConsumption) string {
+	points := make([]string, 0, 2*len(table))
+	for _, p := range table {
+		points = append(points, formatFloat(p.Speed), formatFloat(p.Consumption))
+	}
+	return strings.Join(points, ",")
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
@@ -0,0 +1,107 @@
+package mapimage
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	"go.einride.tech/here/internal/hereapi"
+	"go.einride.tech/here/internal/useragent"
+	"go.einride.tech/here/routingv8"
+)
+
+const (
+	userAgent = useragent.UserAgent
+)
+
+type HTTPClient interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// MapImageService handles communication with the map image methods of the HERE API.
+type MapImageService service
+
+type Client struct {
+	// HTTP client used to communicate with the API.
+	client HTTPClient
+
+	UserAgent string
+
+	// ProjectHRN scopes all requests to a HERE platform project, see routingv8.Client.ProjectHRN.
+	ProjectHRN string
+
+	// Realm of the HERE platform organization, required by some enterprise deployments.
+	Realm string
+
+	// RetryPolicy for failed requests. Requests are not retried if nil.
+	RetryPolicy *routingv8.RetryPolicy
+
+	// Clock used for retries. Defaults to routingv8.SystemClock.
+	Clock routingv8.Clock
+
+	// DebugScrubber is applied to debug dumps before they are written, e.g. to redact coordinates from logs. See
+	// routingv8.WithDebugDump.
+	DebugScrubber func(dump string) string
+
+	// Map image service.
+	MapImage *MapImageService
+}
+
+type service struct {
+	// URL for service API requests
+	URL    *url.URL
+	Client *Client
+}
+
+// HereErrorResponse is returned when an error is returned from the Here Maps API.
+type HereErrorResponse = hereapi.ErrorResponse
+
+// NewClient returns a new HERE API Client. If a nil httpClient is
+// provided, a new http.Client will be used. To use API methods which require
+// authentication, provide an http.Client that will perform the authentication
+// for you (such as that provided by the golang.org/x/oauth2 library).
+func NewClient(httpClient HTTPClient) *Client {
+	if httpClient == nil {
+		httpClient = &http.Client{}
+	}
+	c := &Client{client: httpClient, UserAgent: userAgent}
+	mapImageURL, _ := url.Parse("https://image.maps.hereapi.com/mia/v3/")
+	c.MapImage = &MapImageService{URL: mapImageURL, Client: c}
+	return c
+}
+
+// NewRequest creates an API request. A raw query string can be specified by rawQuery.
+func (c *Client) NewRequest(
+	ctx context.Context,
+	u *url.URL,
+	method string,
+	rawQuery string,
+) (*http.Request, error) {
+	if len(rawQuery) > 0 {
+		u.RawQuery = rawQuery
+	}
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	if c.UserAgent != "" {
+		req.Header.Set("User-Agent", c.UserAgent)
+	}
+	return req, nil
+}
+
+// Do sends an API request and returns the API response. The API response is JSON decoded and stored in the value
+// pointed to by v, or returned as an error if an API error has occurred. If v implements the io.Writer interface,
+// the raw response will be written to v, without attempting to decode it.
+// Failed requests are retried according to the RetryPolicy of the Client.
+func (c *Client) Do(req *http.Request, v interface{}) error {
+	client := hereapi.Client{
+		HTTPClient:    c.client,
+		ProjectHRN:    c.ProjectHRN,
+		Realm:         c.Realm,
+		RetryPolicy:   c.RetryPolicy,
+		Clock:         c.Clock,
+		DebugScrubber: c.DebugScrubber,
+	}
+	return client.Do(req, v)
+}
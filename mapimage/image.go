@@ -0,0 +1,147 @@
+package mapimage
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+const (
+	// MinZoom is the zoom level of the whole world.
+	MinZoom = 0
+	// MaxZoom is the most detailed zoom level.
+	MaxZoom = 20
+	// MaxSize is the maximum width and height of an image in pixels.
+	MaxSize = 2048
+)
+
+type ImageRequest struct {
+	// Center of the image. Requires Zoom, can not be combined with BoundingBox.
+	Center *GeoWaypoint
+	// Zoom level of the image, between MinZoom and MaxZoom.
+	Zoom int
+	// BoundingBox to show in the image. The image shows the bounding box of the markers and lines, with a margin of
+	// Padding, when neither Center nor BoundingBox is set.
+	BoundingBox *BoundingBox
+	// Padding in pixels around the bounding box of the markers and lines, when the image is fit to the overlays.
+	Padding int
+	// Width of the image in pixels, up to MaxSize.
+	Width int
+	// Height of the image in pixels, up to MaxSize.
+	Height int
+	// Format of the image. Defaults to FormatPNG.
+	Format Format
+	// Style of the map, e.g. "explore.day". Optional.
+	Style string
+	// Markers to overlay on the map.
+	Markers []Marker
+	// Lines to overlay on the map, e.g. from RouteLines.
+	Lines []Line
+}
+
+// path returns the path of the image, relative to the service URL.
+func (r *ImageRequest) path() (string, error) {
+	if r.Width <= 0 || r.Width > MaxSize || r.Height <= 0 || r.Height > MaxSize {
+		return "", fmt.Errorf("invalid size: %dx%d, require 1 to %d pixels", r.Width, r.Height, MaxSize)
+	}
+	format := FormatPNG
+	if r.Format != FormatUnspecified {
+		format = r.Format
+	}
+	if format.String() == invalid {
+		return "", fmt.Errorf("invalid format: %d", int(r.Format))
+	}
+	var area string
+	switch {
+	case r.Center != nil && r.BoundingBox != nil:
+		return "", fmt.Errorf("invalid area: center can not be combined with bounding box")
+	case r.Center != nil:
+		if r.Zoom < MinZoom || r.Zoom > MaxZoom {
+			return "", fmt.Errorf("invalid zoom: %d", r.Zoom)
+		}
+		area = "center:" + r.Center.String() + ";zoom=" + strconv.Itoa(r.Zoom)
+	case r.BoundingBox != nil:
+		area = "bbox:" + r.BoundingBox.String()
+	default:
+		box, ok := r.overlayBounds()
+		if !ok {
+			return "", fmt.Errorf("invalid area: center, bounding box or overlays required")
+		}
+		area = "bbox:" + box.String()
+		if r.Padding > 0 {
+			area += ";padding=" + strconv.Itoa(r.Padding)
+		}
+	}
+	return "base/mc/" + area + "/" + strconv.Itoa(r.Width) + "x" + strconv.Itoa(r.Height) + "/" + format.String(), nil
+}
+
+// overlayBounds returns the bounding box of the markers and lines, false if there are none.
+func (r *ImageRequest) overlayBounds() (BoundingBox, bool) {
+	var box BoundingBox
+	empty := true
+	for _, marker := range r.Markers {
+		box, empty = box.extend(marker.Position, empty), false
+	}
+	for _, line := range r.Lines {
+		for _, p := range line.Points {
+			box, empty = box.extend(p, empty), false
+		}
+	}
+	return box, !empty
+}
+
+func (r *ImageRequest) QueryString() (string, error) {
+	values := make(url.Values)
+	for _, marker := range r.Markers {
+		values.Add("overlay", marker.overlay())
+	}
+	for i, line := range r.Lines {
+		if len(line.Points) < 2 {
+			return "", fmt.Errorf("invalid line %d: %d points, require at least 2", i, len(line.Points))
+		}
+		overlay, err := line.overlay()
+		if err != nil {
+			return "", fmt.Errorf("invalid line %d: %v", i, err)
+		}
+		values.Add("overlay", overlay)
+	}
+	if r.Style != "" {
+		values.Add("style", r.Style)
+	}
+	return values.Encode(), nil
+}
+
+// Image returns a static map image, e.g. a thumbnail of routes with markers at their waypoints.
+// See https://developer.here.com/documentation/map-image/dev_guide/index.html
+// for details about other parameters.
+func (s *MapImageService) Image(ctx context.Context, req *ImageRequest) (_ []byte, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("map image: %w", err)
+		}
+	}()
+	path, err := req.path()
+	if err != nil {
+		return nil, err
+	}
+	query, err := req.QueryString()
+	if err != nil {
+		return nil, err
+	}
+	u, err := s.URL.Parse(path)
+	if err != nil {
+		return nil, err
+	}
+	r, err := s.Client.NewRequest(ctx, u, http.MethodGet, query)
+	if err != nil {
+		return nil, err
+	}
+	var image bytes.Buffer
+	if err := s.Client.Do(r, &image); err != nil {
+		return nil, err
+	}
+	return image.Bytes(), nil
+}
@@ -0,0 +1,155 @@
+package mapimage_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"go.einride.tech/here/flexpolyline"
+	"go.einride.tech/here/mapimage"
+	"go.einride.tech/here/routingv8"
+	"gotest.tools/v3/assert"
+)
+
+type httpClientFunc func(*http.Request) (*http.Response, error)
+
+func (f httpClientFunc) Do(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestMapImageService_Image(t *testing.T) {
+	t.Parallel()
+	var requested *url.URL
+	client := mapimage.NewClient(httpClientFunc(func(req *http.Request) (*http.Response, error) {
+		requested = req.URL
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader("\x89PNG"))}, nil
+	}))
+	image, err := client.MapImage.Image(context.Background(), &mapimage.ImageRequest{
+		Center:  &mapimage.GeoWaypoint{Lat: 57.7, Long: 11.9},
+		Zoom:    12,
+		Width:   300,
+		Height:  200,
+		Format:  mapimage.FormatPNG8,
+		Style:   "explore.day",
+		Markers: []mapimage.Marker{{Position: mapimage.GeoWaypoint{Lat: 57.7, Long: 11.9}, Label: "A", Color: "#ff0000"}},
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, "\x89PNG", string(image))
+	assert.Equal(t, "/mia/v3/base/mc/center:57.7,11.9;zoom=12/300x200/png8", requested.Path)
+	assert.DeepEqual(t, []string{"point:57.7,11.9;label=A;color=#ff0000"}, requested.Query()["overlay"])
+	assert.Equal(t, "explore.day", requested.Query().Get("style"))
+}
+
+func TestMapImageService_Image_RouteSnapshot(t *testing.T) {
+	t.Parallel()
+	polyline, err := flexpolyline.Encode(&flexpolyline.Polyline{
+		Precision: 5,
+		Points:    []flexpolyline.Point{{Lat: 57.7, Lng: 11.9}, {Lat: 57.8, Lng: 12.1}, {Lat: 58.0, Lng: 12.0}},
+	})
+	assert.NilError(t, err)
+	routes := &routingv8.RoutesResponse{Routes: []routingv8.Route{{Sections: []routingv8.Section{{Polyline: polyline}}}}}
+	lines, err := mapimage.RouteLines(routes, 4, "#0000ff")
+	assert.NilError(t, err)
+	assert.Equal(t, 3, len(lines[0].Points))
+	var requested *url.URL
+	client := mapimage.NewClient(httpClientFunc(func(req *http.Request) (*http.Response, error) {
+		requested = req.URL
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(""))}, nil
+	}))
+	_, err = client.MapImage.Image(context.Background(), &mapimage.ImageRequest{
+		Width:   256,
+		Height:  256,
+		Padding: 10,
+		Lines:   lines,
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, "/mia/v3/base/mc/bbox:11.9,57.7,12.1,58;padding=10/256x256/png", requested.Path)
+	assert.Equal(t, "line:"+polyline+";width=4;color=#0000ff", requested.Query().Get("overlay"))
+}
+
+func TestMapImageService_Image_Invalid(t *testing.T) {
+	t.Parallel()
+	client := mapimage.NewClient(httpClientFunc(func(req *http.Request) (*http.Response, error) {
+		t.Fatal("unexpected request")
+		return nil, nil
+	}))
+	for _, tt := range []struct {
+		name    string
+		request mapimage.ImageRequest
+		err     string
+	}{
+		{
+			name:    "size",
+			request: mapimage.ImageRequest{Width: 4096, Height: 100},
+			err:     "map image: invalid size: 4096x100, require 1 to 2048 pixels",
+		},
+		{
+			name:    "no area",
+			request: mapimage.ImageRequest{Width: 100, Height: 100},
+			err:     "map image: invalid area: center, bounding box or overlays required",
+		},
+		{
+			name: "zoom",
+			request: mapimage.ImageRequest{
+				Width: 100, Height: 100, Center: &mapimage.GeoWaypoint{}, Zoom: 21,
+			},
+			err: "map image: invalid zoom: 21",
+		},
+		{
+			name: "line",
+			request: mapimage.ImageRequest{
+				Width: 100, Height: 100, BoundingBox: &mapimage.BoundingBox{}, Lines: []mapimage.Line{{}},
+			},
+			err: "map image: invalid line 0: 0 points, require at least 2",
+		},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			_, err := client.MapImage.Image(context.Background(), &tt.request)
+			assert.Error(t, err, tt.err)
+		})
+	}
+}
+
+func TestMapImageService_Image_LongRoute(t *testing.T) {
+	t.Parallel()
+	// A route of 5000 points from Gothenburg to Stockholm, wiggling along the way, with a detour to the north.
+	points := make([]flexpolyline.Point, 0, 5000)
+	for i := 0; i < 5000; i++ {
+		f := float64(i) / 4999
+		p := flexpolyline.Point{Lat: 57.7 + 1.6*f + 0.001*float64(i%2), Lng: 11.9 + 6.1*f}
+		if i == 2500 {
+			p.Lat += 1
+		}
+		points = append(points, p)
+	}
+	polyline, err := flexpolyline.Encode(&flexpolyline.Polyline{Precision: 5, Points: points})
+	assert.NilError(t, err)
+	routes := &routingv8.RoutesResponse{Routes: []routingv8.Route{{Sections: []routingv8.Section{{Polyline: polyline}}}}}
+	lines, err := mapimage.RouteLines(routes, 4, "#0000ff")
+	assert.NilError(t, err)
+	var requested *url.URL
+	client := mapimage.NewClient(httpClientFunc(func(req *http.Request) (*http.Response, error) {
+		requested = req.URL
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(""))}, nil
+	}))
+	_, err = client.MapImage.Image(context.Background(), &mapimage.ImageRequest{Width: 512, Height: 512, Lines: lines})
+	assert.NilError(t, err)
+	assert.Assert(t, len(requested.String()) < 4096, len(requested.String()))
+	overlay := requested.Query().Get("overlay")
+	encoded := strings.TrimSuffix(strings.TrimPrefix(overlay, "line:"), ";width=4;color=#0000ff")
+	simplified, err := flexpolyline.Decode(encoded)
+	assert.NilError(t, err)
+	assert.Assert(t, len(simplified.Points) <= mapimage.MaxLinePoints, len(simplified.Points))
+	assert.DeepEqual(t, points[0], simplified.Points[0])
+	assert.DeepEqual(t, points[len(points)-1], simplified.Points[len(simplified.Points)-1])
+	var detour bool
+	for _, p := range simplified.Points {
+		detour = detour || p.Lat > 59.4
+	}
+	assert.Assert(t, detour, "the point deviating the most is kept")
+}
@@ -0,0 +1,78 @@
+package mapimage
+
+import (
+	"container/heap"
+	"sort"
+
+	"go.einride.tech/here/geo"
+)
+
+// simplify returns at most limit points of the line, keeping its first and last points, chosen with the
+// Douglas-Peucker algorithm: the segment with the point furthest from it is split at that point until the budget is
+// reached or all remaining points are on the simplified line.
+func simplify(points []GeoWaypoint, limit int) []GeoWaypoint {
+	if limit < 2 {
+		limit = 2
+	}
+	if len(points) <= limit {
+		return points
+	}
+	keep := map[int]bool{0: true, len(points) - 1: true}
+	splits := &segmentHeap{}
+	if s, ok := furthest(points, 0, len(points)-1); ok {
+		heap.Push(splits, s)
+	}
+	for len(keep) < limit && splits.Len() > 0 {
+		s := heap.Pop(splits).(segment)
+		keep[s.split] = true
+		for _, next := range [][2]int{{s.start, s.split}, {s.split, s.end}} {
+			if s, ok := furthest(points, next[0], next[1]); ok {
+				heap.Push(splits, s)
+			}
+		}
+	}
+	indices := make([]int, 0, len(keep))
+	for i := range keep {
+		indices = append(indices, i)
+	}
+	sort.Ints(indices)
+	simplified := make([]GeoWaypoint, 0, len(indices))
+	for _, i := range indices {
+		simplified = append(simplified, points[i])
+	}
+	return simplified
+}
+
+// segment of a line between start and end, with the point at split furthest from it.
+type segment struct {
+	start, end, split int
+	distance          float64
+}
+
+// furthest returns the segment between start and end split at the point furthest from it, false if there are no
+// points between start and end or all are on the segment.
+func furthest(points []GeoWaypoint, start, end int) (segment, bool) {
+	s := segment{start: start, end: end}
+	a, b := points[start].point(), points[end].point()
+	for i := start + 1; i < end; i++ {
+		if d := geo.DistanceToSegment(points[i].point(), a, b); d > s.distance {
+			s.split, s.distance = i, d
+		}
+	}
+	return s, s.distance > 0
+}
+
+// segmentHeap is a max-heap of segments by the distance of their split point.
+type segmentHeap []segment
+
+func (h segmentHeap) Len() int            { return len(h) }
+func (h segmentHeap) Less(i, j int) bool  { return h[i].distance > h[j].distance }
+func (h segmentHeap) Swap(i, j int)       { h[i], h[j] = h[j], h[i] }
+func (h *segmentHeap) Push(x interface{}) { *h = append(*h, x.(segment)) }
+
+func (h *segmentHeap) Pop() interface{} {
+	old := *h
+	s := old[len(old)-1]
+	*h = old[:len(old)-1]
+	return s
+}
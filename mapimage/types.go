@@ -0,0 +1,169 @@
+package mapimage
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"go.einride.tech/here/flexpolyline"
+	"go.einride.tech/here/geo"
+	"go.einride.tech/here/routingv8"
+)
+
+const (
+	invalid     = "invalid"
+	unspecified = "unspecified"
+)
+
+type GeoWaypoint struct {
+	Lat  float64 `json:"lat"`
+	Long float64 `json:"lng"`
+}
+
+func (w GeoWaypoint) String() string {
+	return strconv.FormatFloat(w.Lat, 'f', -1, 64) + "," + strconv.FormatFloat(w.Long, 'f', -1, 64)
+}
+
+func (w GeoWaypoint) point() geo.Point {
+	return geo.Point{Lat: w.Lat, Lng: w.Long}
+}
+
+// BoundingBox is an area of the map.
+type BoundingBox struct {
+	North float64
+	South float64
+	East  float64
+	West  float64
+}
+
+func (b BoundingBox) String() string {
+	return strings.Join([]string{
+		strconv.FormatFloat(b.West, 'f', -1, 64),
+		strconv.FormatFloat(b.South, 'f', -1, 64),
+		strconv.FormatFloat(b.East, 'f', -1, 64),
+		strconv.FormatFloat(b.North, 'f', -1, 64),
+	}, ",")
+}
+
+// extend returns the bounding box extended to contain the point. The zero bounding box is extended to the point.
+func (b BoundingBox) extend(p GeoWaypoint, empty bool) BoundingBox {
+	if empty {
+		return BoundingBox{North: p.Lat, South: p.Lat, East: p.Long, West: p.Long}
+	}
+	if p.Lat > b.North {
+		b.North = p.Lat
+	}
+	if p.Lat < b.South {
+		b.South = p.Lat
+	}
+	if p.Long > b.East {
+		b.East = p.Long
+	}
+	if p.Long < b.West {
+		b.West = p.Long
+	}
+	return b
+}
+
+// Format of the image.
+type Format int
+
+const (
+	FormatUnspecified Format = iota
+	FormatPNG
+	// FormatPNG8 is a PNG with an 8-bit palette, smaller than FormatPNG.
+	FormatPNG8
+	FormatJPEG
+)
+
+func (f Format) String() string {
+	switch f {
+	case FormatUnspecified:
+		return unspecified
+	case FormatPNG:
+		return "png"
+	case FormatPNG8:
+		return "png8"
+	case FormatJPEG:
+		return "jpeg"
+	default:
+		return invalid
+	}
+}
+
+// Marker is a point overlaid on the map.
+type Marker struct {
+	Position GeoWaypoint
+	// Label of the marker, e.g. "A". Optional.
+	Label string
+	// Color of the marker as a hex RGB color, e.g. "#ff0000". Optional.
+	Color string
+}
+
+func (m Marker) overlay() string {
+	s := "point:" + m.Position.String()
+	if m.Label != "" {
+		s += ";label=" + m.Label
+	}
+	if m.Color != "" {
+		s += ";color=" + m.Color
+	}
+	return s
+}
+
+// MaxLinePoints is the number of points lines are simplified to in requests, so that the overlays of long routes
+// fit in the URL of the request.
+const MaxLinePoints = 250
+
+// Line is a polyline overlaid on the map, e.g. a route. Lines of more than MaxLinePoints points are simplified,
+// keeping the points which deviate the most from the simplified line.
+type Line struct {
+	Points []GeoWaypoint
+	// Width of the line in pixels. Optional.
+	Width int
+	// Color of the line as a hex RGB color, e.g. "#0000ff". Optional.
+	Color string
+}
+
+func (l Line) overlay() (string, error) {
+	points := simplify(l.Points, MaxLinePoints)
+	polyline := flexpolyline.Polyline{Precision: 5, Points: make([]flexpolyline.Point, 0, len(points))}
+	for _, p := range points {
+		polyline.Points = append(polyline.Points, flexpolyline.Point{Lat: p.Lat, Lng: p.Long})
+	}
+	encoded, err := flexpolyline.Encode(&polyline)
+	if err != nil {
+		return "", err
+	}
+	s := "line:" + encoded
+	if l.Width > 0 {
+		s += ";width=" + strconv.Itoa(l.Width)
+	}
+	if l.Color != "" {
+		s += ";color=" + l.Color
+	}
+	return s, nil
+}
+
+// RouteLines returns a line for each route of the response, with the width and the colors of the routes in order,
+// cycling through the colors if there are more routes than colors. Requires routes requested with the polyline.
+func RouteLines(resp *routingv8.RoutesResponse, width int, colors ...string) ([]Line, error) {
+	lines := make([]Line, 0, len(resp.Routes))
+	for i := range resp.Routes {
+		line := Line{Width: width}
+		if len(colors) > 0 {
+			line.Color = colors[i%len(colors)]
+		}
+		for j := range resp.Routes[i].Sections {
+			points, err := resp.Routes[i].Sections[j].DecodedPolyline()
+			if err != nil {
+				return nil, fmt.Errorf("route lines: route %d: %v", i, err)
+			}
+			for _, p := range points {
+				line.Points = append(line.Points, GeoWaypoint{Lat: p.Lat, Long: p.Long})
+			}
+		}
+		lines = append(lines, line)
+	}
+	return lines, nil
+}
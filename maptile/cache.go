@@ -0,0 +1,58 @@
+package maptile
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"path/filepath"
+)
+
+// Cache stores fetched tiles by the URLs of their requests, without credentials. Implementations must be safe for
+// concurrent use.
+type Cache interface {
+	// Get returns the tile stored for key.
+	Get(key string) ([]byte, bool)
+	// Set stores the tile for key.
+	Set(key string, tile []byte)
+}
+
+// DirCache is a Cache of files in a directory, which is created on the first Set. Tiles are stored under the
+// SHA-256 hash of their keys and never expire, remove the files to refresh the tiles.
+type DirCache string
+
+var _ Cache = DirCache("")
+
+func (d DirCache) file(key string) string {
+	hash := sha256.Sum256([]byte(key))
+	return filepath.Join(string(d), hex.EncodeToString(hash[:]))
+}
+
+func (d DirCache) Get(key string) ([]byte, bool) {
+	tile, err := os.ReadFile(d.file(key))
+	if err != nil {
+		return nil, false
+	}
+	return tile, true
+}
+
+// Set stores the tile in a temporary file renamed into place, so that concurrent readers never see a partial tile.
+// Failures to store a tile are ignored, the tile is fetched again on the next Get.
+func (d DirCache) Set(key string, tile []byte) {
+	if err := os.MkdirAll(string(d), 0o755); err != nil {
+		return
+	}
+	f, err := os.CreateTemp(string(d), "tile-*")
+	if err != nil {
+		return
+	}
+	_, err = f.Write(tile)
+	if cerr := f.Close(); err == nil {
+		err = cerr
+	}
+	if err == nil {
+		err = os.Rename(f.Name(), d.file(key))
+	}
+	if err != nil {
+		_ = os.Remove(f.Name())
+	}
+}
@@ -0,0 +1,156 @@
+package maptile
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+type RasterRequest struct {
+	Tile Tile
+	// Resource of the tile. Defaults to ResourceBase.
+	Resource Resource
+	// Format of the tile. Defaults to FormatPNG.
+	Format Format
+	// Style of the map, e.g. "explore.day". Optional.
+	Style string
+	// PPI is the pixel density of the tile, one of 100, 200 and 400. Optional.
+	PPI int
+	// Size of the tile in pixels, 256 or 512. Optional.
+	Size int
+	// Language of the labels as a BCP 47 language code, e.g. "sv". Optional.
+	Language string
+}
+
+// path returns the path of the tile, relative to the service URL.
+func (r *RasterRequest) path() (string, error) {
+	if err := validateTile(r.Tile); err != nil {
+		return "", err
+	}
+	resource := ResourceBase
+	if r.Resource != ResourceUnspecified {
+		resource = r.Resource
+	}
+	if resource.String() == invalid {
+		return "", fmt.Errorf("invalid resource: %d", int(r.Resource))
+	}
+	format := FormatPNG
+	if r.Format != FormatUnspecified {
+		format = r.Format
+	}
+	if format.String() == invalid {
+		return "", fmt.Errorf("invalid format: %d", int(r.Format))
+	}
+	return resource.String() + "/mc/" + r.Tile.String() + "/" + format.String(), nil
+}
+
+func (r *RasterRequest) QueryString() (string, error) {
+	values := make(url.Values)
+	if r.Style != "" {
+		values.Add("style", r.Style)
+	}
+	switch r.PPI {
+	case 0:
+	case 100, 200, 400:
+		values.Add("ppi", strconv.Itoa(r.PPI))
+	default:
+		return "", fmt.Errorf("invalid ppi: %d, require 100, 200 or 400", r.PPI)
+	}
+	switch r.Size {
+	case 0:
+	case 256, 512:
+		values.Add("size", strconv.Itoa(r.Size))
+	default:
+		return "", fmt.Errorf("invalid size: %d, require 256 or 512", r.Size)
+	}
+	if r.Language != "" {
+		values.Add("lang", r.Language)
+	}
+	return values.Encode(), nil
+}
+
+// Tile returns a raster map tile, served from the cache of the client when present.
+// See https://developer.here.com/documentation/raster-tile-api/dev_guide/index.html
+// for details about other parameters.
+func (s *RasterService) Tile(ctx context.Context, req *RasterRequest) (_ []byte, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("raster tile: %w", err)
+		}
+	}()
+	path, err := req.path()
+	if err != nil {
+		return nil, err
+	}
+	query, err := req.QueryString()
+	if err != nil {
+		return nil, err
+	}
+	return s.Client.tile(ctx, s.URL, path, query)
+}
+
+type VectorRequest struct {
+	Tile Tile
+	// Layer of the tile. Defaults to LayerBase.
+	Layer Layer
+}
+
+// path returns the path of the tile, relative to the service URL.
+func (r *VectorRequest) path() (string, error) {
+	if err := validateTile(r.Tile); err != nil {
+		return "", err
+	}
+	layer := LayerBase
+	if r.Layer != LayerUnspecified {
+		layer = r.Layer
+	}
+	if layer.String() == invalid {
+		return "", fmt.Errorf("invalid layer: %d", int(r.Layer))
+	}
+	return "vectortiles/" + layer.String() + "/mc/" + r.Tile.String() + "/omv", nil
+}
+
+// Tile returns a vector map tile in the Mapbox Vector Tile format, served from the cache of the client when present.
+// See https://developer.here.com/documentation/vector-tiles-api/dev_guide/index.html
+// for details about the layers.
+func (s *VectorService) Tile(ctx context.Context, req *VectorRequest) (_ []byte, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("vector tile: %w", err)
+		}
+	}()
+	path, err := req.path()
+	if err != nil {
+		return nil, err
+	}
+	return s.Client.tile(ctx, s.URL, path, "")
+}
+
+// tile fetches the tile at the path relative to the service URL, through the cache when present.
+func (c *Client) tile(ctx context.Context, serviceURL *url.URL, path, query string) ([]byte, error) {
+	u, err := serviceURL.Parse(path)
+	if err != nil {
+		return nil, err
+	}
+	r, err := c.NewRequest(ctx, u, http.MethodGet, query)
+	if err != nil {
+		return nil, err
+	}
+	key := r.URL.String()
+	if c.Cache != nil {
+		if tile, ok := c.Cache.Get(key); ok {
+			return tile, nil
+		}
+	}
+	var tile bytes.Buffer
+	if err := c.Do(r, &tile); err != nil {
+		return nil, err
+	}
+	if c.Cache != nil {
+		c.Cache.Set(key, tile.Bytes())
+	}
+	return tile.Bytes(), nil
+}
@@ -0,0 +1,148 @@
+package maptile_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"go.einride.tech/here/maptile"
+	"gotest.tools/v3/assert"
+)
+
+type httpClientFunc func(*http.Request) (*http.Response, error)
+
+func (f httpClientFunc) Do(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestTileAt(t *testing.T) {
+	t.Parallel()
+	for _, tt := range []struct {
+		name     string
+		position maptile.GeoWaypoint
+		zoom     int
+		expected maptile.Tile
+	}{
+		{name: "world", position: maptile.GeoWaypoint{Lat: 57.7, Long: 11.9}, zoom: 0, expected: maptile.Tile{}},
+		{
+			name:     "gothenburg",
+			position: maptile.GeoWaypoint{Lat: 57.70887, Long: 11.97456},
+			zoom:     12,
+			expected: maptile.Tile{Zoom: 12, X: 2184, Y: 1239},
+		},
+		{
+			name:     "south-east corner",
+			position: maptile.GeoWaypoint{Lat: -90, Long: 180},
+			zoom:     2,
+			expected: maptile.Tile{Zoom: 2, X: 3, Y: 3},
+		},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.expected, maptile.TileAt(tt.position, tt.zoom))
+		})
+	}
+}
+
+func TestRasterService_Tile(t *testing.T) {
+	t.Parallel()
+	var requested *url.URL
+	client := maptile.NewClient(httpClientFunc(func(req *http.Request) (*http.Response, error) {
+		requested = req.URL
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader("\x89PNG"))}, nil
+	}))
+	tile, err := client.Raster.Tile(context.Background(), &maptile.RasterRequest{
+		Tile:     maptile.Tile{Zoom: 12, X: 2184, Y: 1239},
+		Format:   maptile.FormatPNG8,
+		Style:    "explore.night",
+		PPI:      400,
+		Size:     512,
+		Language: "sv",
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, "\x89PNG", string(tile))
+	assert.Equal(t, "/v3/base/mc/12/2184/1239/png8", requested.Path)
+	assert.Equal(t, "lang=sv&ppi=400&size=512&style=explore.night", requested.RawQuery)
+}
+
+func TestVectorService_Tile(t *testing.T) {
+	t.Parallel()
+	var requested *url.URL
+	client := maptile.NewClient(httpClientFunc(func(req *http.Request) (*http.Response, error) {
+		requested = req.URL
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader("mvt"))}, nil
+	}))
+	tile, err := client.Vector.Tile(context.Background(), &maptile.VectorRequest{
+		Tile:  maptile.Tile{Zoom: 3, X: 4, Y: 2},
+		Layer: maptile.LayerCore,
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, "mvt", string(tile))
+	assert.Equal(t, "https://vector.hereapi.com/v2/vectortiles/core/mc/3/4/2/omv", requested.String())
+}
+
+func TestClient_Cache(t *testing.T) {
+	t.Parallel()
+	var requests int
+	client := maptile.NewClient(httpClientFunc(func(req *http.Request) (*http.Response, error) {
+		requests++
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(req.URL.Path))}, nil
+	}))
+	client.Cache = maptile.DirCache(t.TempDir() + "/tiles")
+	ctx := context.Background()
+	for i := 0; i < 2; i++ {
+		tile, err := client.Raster.Tile(ctx, &maptile.RasterRequest{Tile: maptile.Tile{Zoom: 1, X: 1, Y: 0}})
+		assert.NilError(t, err)
+		assert.Equal(t, "/v3/base/mc/1/1/0/png", string(tile))
+	}
+	assert.Equal(t, 1, requests)
+	tile, err := client.Raster.Tile(ctx, &maptile.RasterRequest{Tile: maptile.Tile{Zoom: 1, X: 1, Y: 0}, PPI: 200})
+	assert.NilError(t, err)
+	assert.Equal(t, "/v3/base/mc/1/1/0/png", string(tile))
+	assert.Equal(t, 2, requests, "options are part of the cache key")
+}
+
+func TestRasterService_Tile_Invalid(t *testing.T) {
+	t.Parallel()
+	client := maptile.NewClient(httpClientFunc(func(req *http.Request) (*http.Response, error) {
+		t.Fatal("unexpected request")
+		return nil, nil
+	}))
+	for _, tt := range []struct {
+		name    string
+		request maptile.RasterRequest
+		err     string
+	}{
+		{
+			name:    "zoom",
+			request: maptile.RasterRequest{Tile: maptile.Tile{Zoom: 23}},
+			err:     "raster tile: invalid tile zoom: 23",
+		},
+		{
+			name:    "tile",
+			request: maptile.RasterRequest{Tile: maptile.Tile{Zoom: 2, X: 4}},
+			err:     "raster tile: invalid tile: 2/4/0, require x and y below 4",
+		},
+		{
+			name:    "ppi",
+			request: maptile.RasterRequest{PPI: 300},
+			err:     "raster tile: invalid ppi: 300, require 100, 200 or 400",
+		},
+		{
+			name:    "size",
+			request: maptile.RasterRequest{Size: 1024},
+			err:     "raster tile: invalid size: 1024, require 256 or 512",
+		},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			_, err := client.Raster.Tile(context.Background(), &tt.request)
+			assert.Error(t, err, tt.err)
+		})
+	}
+}
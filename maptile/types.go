@@ -0,0 +1,128 @@
+package maptile
+
+import (
+	"fmt"
+	"strconv"
+
+	"go.einride.tech/here/tile"
+)
+
+const (
+	invalid     = "invalid"
+	unspecified = "unspecified"
+)
+
+const (
+	// MinZoom is the zoom level of the single tile of the whole world.
+	MinZoom = 0
+	// MaxZoom is the most detailed zoom level.
+	MaxZoom = 22
+)
+
+type GeoWaypoint struct {
+	Lat  float64 `json:"lat"`
+	Long float64 `json:"lng"`
+}
+
+func (w GeoWaypoint) String() string {
+	return strconv.FormatFloat(w.Lat, 'f', -1, 64) + "," + strconv.FormatFloat(w.Long, 'f', -1, 64)
+}
+
+// Tile is a tile of the web mercator projection, with the origin in the north-west corner.
+type Tile = tile.Tile
+
+// TileAt returns the tile containing the position at the zoom level. Positions beyond the latitudes of the
+// projection are in the tiles at the edge.
+func TileAt(p GeoWaypoint, zoom int) Tile {
+	return tile.FromLatLng(p.Lat, p.Long, zoom)
+}
+
+// validateTile returns an error if the tile is outside the zoom levels or the grid of its zoom level.
+func validateTile(t Tile) error {
+	if t.Zoom < MinZoom || t.Zoom > MaxZoom {
+		return fmt.Errorf("invalid tile zoom: %d", t.Zoom)
+	}
+	n := 1 << uint(t.Zoom)
+	if t.X < 0 || t.X >= n || t.Y < 0 || t.Y >= n {
+		return fmt.Errorf("invalid tile: %d/%d/%d, require x and y below %d", t.Zoom, t.X, t.Y, n)
+	}
+	return nil
+}
+
+// Resource is the content of a raster tile.
+type Resource int
+
+const (
+	ResourceUnspecified Resource = iota
+	// ResourceBase is the map with labels.
+	ResourceBase
+	// ResourceBackground is the map without labels.
+	ResourceBackground
+	// ResourceLabel is the labels only, on a transparent background.
+	ResourceLabel
+)
+
+func (r Resource) String() string {
+	switch r {
+	case ResourceUnspecified:
+		return unspecified
+	case ResourceBase:
+		return "base"
+	case ResourceBackground:
+		return "background"
+	case ResourceLabel:
+		return "label"
+	default:
+		return invalid
+	}
+}
+
+// Format of a raster tile.
+type Format int
+
+const (
+	FormatUnspecified Format = iota
+	FormatPNG
+	// FormatPNG8 is a PNG with an 8-bit palette, smaller than FormatPNG.
+	FormatPNG8
+	FormatJPEG
+)
+
+func (f Format) String() string {
+	switch f {
+	case FormatUnspecified:
+		return unspecified
+	case FormatPNG:
+		return "png"
+	case FormatPNG8:
+		return "png8"
+	case FormatJPEG:
+		return "jpeg"
+	default:
+		return invalid
+	}
+}
+
+// Layer is the content of a vector tile.
+type Layer int
+
+const (
+	LayerUnspecified Layer = iota
+	// LayerBase is the map features used by the HERE styles.
+	LayerBase
+	// LayerCore is the map features with extended attributes.
+	LayerCore
+)
+
+func (l Layer) String() string {
+	switch l {
+	case LayerUnspecified:
+		return unspecified
+	case LayerBase:
+		return "base"
+	case LayerCore:
+		return "core"
+	default:
+		return invalid
+	}
+}
@@ -0,0 +1,39 @@
+package here
+
+import (
+	"context"
+	"fmt"
+
+	"go.einride.tech/here/geocodingv7"
+	"go.einride.tech/here/routingv8"
+)
+
+// ParkingFinder returns a parking finder for DriveParkWalk browsing the places of the parking category near the
+// destination. Other parameters of the browse requests, e.g. the limit and the language, are taken from the
+// template. The categories of the template replace the parking category if set.
+func (c *Client) ParkingFinder(template geocodingv7.BrowseRequest) routingv8.ParkingFinder {
+	return routingv8.ParkingFinderFunc(func(ctx context.Context, near routingv8.GeoWaypoint) ([]routingv8.Parking, error) {
+		req := template
+		req.At = geocodingv7.GeoWaypoint{Lat: near.Lat, Long: near.Long}
+		if len(req.Categories) == 0 {
+			req.Categories = []string{geocodingv7.CategoryParking}
+		}
+		resp, err := c.Search().Browse(ctx, &req)
+		if err != nil {
+			return nil, fmt.Errorf("find parking: %w", err)
+		}
+		parkings := make([]routingv8.Parking, 0, len(resp.Items))
+		for _, item := range resp.Items {
+			position := item.Position
+			if len(item.Access) > 0 {
+				position = item.Access[0]
+			}
+			parkings = append(parkings, routingv8.Parking{
+				ID:       item.ID,
+				Name:     item.Title,
+				Location: routingv8.GeoWaypoint{Lat: position.Lat, Long: position.Long},
+			})
+		}
+		return parkings, nil
+	})
+}
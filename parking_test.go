@@ -0,0 +1,86 @@
+package here_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"go.einride.tech/here"
+	"go.einride.tech/here/geocodingv7"
+	"go.einride.tech/here/routingv8"
+	"gotest.tools/v3/assert"
+)
+
+func TestClient_ParkingFinder(t *testing.T) {
+	t.Parallel()
+	var browseQuery url.Values
+	var routesQueries []url.Values
+	client := here.NewClient(httpClientFunc(func(req *http.Request) (*http.Response, error) {
+		body := `{"routes": [{"sections": [{"summary": {"duration": 600}}]}]}`
+		if req.URL.Host == "browse.search.hereapi.com" {
+			browseQuery = req.URL.Query()
+			body = `{"items": [
+				{
+					"title": "Nordstan P-hus",
+					"id": "here:pds:place:1",
+					"position": {"lat": 57.7089, "lng": 11.9691},
+					"access": [{"lat": 57.7087, "lng": 11.9688}]
+				},
+				{
+					"title": "Parkering Lilla Bommen",
+					"id": "here:pds:place:2",
+					"position": {"lat": 57.7112, "lng": 11.9652}
+				}
+			]}`
+		} else {
+			routesQueries = append(routesQueries, req.URL.Query())
+		}
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(body))}, nil
+	}))
+	finder := client.ParkingFinder(geocodingv7.BrowseRequest{Limit: 5, Lang: "sv-SE"})
+	destination := routingv8.GeoWaypoint{Lat: 57.7, Long: 11.97}
+	parkings, err := finder.FindParking(context.Background(), destination)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, []routingv8.Parking{
+		{
+			ID:       "here:pds:place:1",
+			Name:     "Nordstan P-hus",
+			Location: routingv8.GeoWaypoint{Lat: 57.7087, Long: 11.9688},
+		},
+		{
+			ID:       "here:pds:place:2",
+			Name:     "Parkering Lilla Bommen",
+			Location: routingv8.GeoWaypoint{Lat: 57.7112, Long: 11.9652},
+		},
+	}, parkings)
+	assert.Equal(t, "57.7,11.97", browseQuery.Get("at"))
+	assert.Equal(t, geocodingv7.CategoryParking, browseQuery.Get("categories"))
+	assert.Equal(t, "5", browseQuery.Get("limit"))
+	assert.Equal(t, "sv-SE", browseQuery.Get("lang"))
+
+	plan, err := client.Routing().DriveParkWalk(context.Background(), &routingv8.RoutesRequest{
+		Origin:        routingv8.GeoWaypoint{Lat: 57.65, Long: 11.9},
+		Destination:   destination,
+		TransportMode: routingv8.TransportModeCar,
+	}, finder)
+	assert.NilError(t, err)
+	assert.Equal(t, "Nordstan P-hus", plan.Parking.Name)
+	assert.Equal(t, 2, len(routesQueries))
+	assert.Equal(t, "57.7087,11.9688", routesQueries[0].Get("destination"))
+	assert.Equal(t, "57.7087,11.9688", routesQueries[1].Get("origin"))
+}
+
+func TestClient_ParkingFinder_Error(t *testing.T) {
+	t.Parallel()
+	client := here.NewClient(httpClientFunc(func(req *http.Request) (*http.Response, error) {
+		body := `{"status":401,"title":"Unauthorized"}`
+		return &http.Response{StatusCode: http.StatusUnauthorized, Body: io.NopCloser(strings.NewReader(body))}, nil
+	}))
+	_, err := client.ParkingFinder(geocodingv7.BrowseRequest{}).FindParking(
+		context.Background(), routingv8.GeoWaypoint{Lat: 57.7, Long: 11.97},
+	)
+	assert.ErrorContains(t, err, "find parking: browse 57.7,11.97: ")
+}
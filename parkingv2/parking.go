@@ -0,0 +1,79 @@
+package parkingv2
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// DefaultRadius is the search radius in meters used when a request has no radius.
+const DefaultRadius = 500
+
+type SearchRequest struct {
+	// At is the center of the search.
+	At GeoWaypoint
+	// Radius of the search in meters. Defaults to DefaultRadius.
+	Radius int
+}
+
+func (r *SearchRequest) QueryString() string {
+	radius := r.Radius
+	if radius <= 0 {
+		radius = DefaultRadius
+	}
+	values := make(url.Values)
+	values.Add("prox", r.At.String()+","+strconv.Itoa(radius))
+	return values.Encode()
+}
+
+// FacilitiesResponse contains the off-street parking facilities around the requested position.
+type FacilitiesResponse struct {
+	Facilities []Facility `json:"facilities"`
+}
+
+// SegmentsResponse contains the on-street parking segments around the requested position.
+type SegmentsResponse struct {
+	Segments []Segment `json:"segments"`
+}
+
+// Facilities returns the off-street parking facilities around a position.
+func (s *ParkingService) Facilities(ctx context.Context, req *SearchRequest) (_ *FacilitiesResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("parking facilities %v: %w", req.At, err)
+		}
+	}()
+	var resp FacilitiesResponse
+	if err := s.search(ctx, "facilities.json", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// Segments returns the on-street parking segments around a position.
+func (s *ParkingService) Segments(ctx context.Context, req *SearchRequest) (_ *SegmentsResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("parking segments %v: %w", req.At, err)
+		}
+	}()
+	var resp SegmentsResponse
+	if err := s.search(ctx, "segments.json", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (s *ParkingService) search(ctx context.Context, path string, req *SearchRequest, v interface{}) error {
+	u, err := s.URL.Parse(path)
+	if err != nil {
+		return err
+	}
+	r, err := s.Client.NewRequest(ctx, u, http.MethodGet, req.QueryString())
+	if err != nil {
+		return err
+	}
+	return s.Client.Do(r, v)
+}
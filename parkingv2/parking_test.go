@@ -0,0 +1,86 @@
+package parkingv2_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"go.einride.tech/here/parkingv2"
+	"go.einride.tech/here/routingv8"
+	"gotest.tools/v3/assert"
+)
+
+type httpClientFunc func(*http.Request) (*http.Response, error)
+
+func (f httpClientFunc) Do(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+const facilitiesJSON = `{
+  "facilities": [
+    {
+      "id": "f1",
+      "name": "Full garage",
+      "position": {"latitude": 57.7081, "longitude": 11.9691},
+      "distance": 80,
+      "availability": {"spaces": 200, "free": 0}
+    },
+    {
+      "id": "f2",
+      "name": "Nordstan",
+      "position": {"latitude": 57.7089, "longitude": 11.9698},
+      "distance": 190,
+      "availability": {"spaces": 900, "free": 42}
+    }
+  ]
+}`
+
+func TestParkingService_FacilitiesAtDestination(t *testing.T) {
+	t.Parallel()
+	var requested string
+	client := parkingv2.NewClient(httpClientFunc(func(req *http.Request) (*http.Response, error) {
+		requested = req.URL.Host + req.URL.Path + "?" + req.URL.Query().Get("prox")
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(facilitiesJSON))}, nil
+	}))
+	route := routingv8.Route{Sections: []routingv8.Section{
+		{Arrival: routingv8.RoutePlace{Place: routingv8.Place{Location: routingv8.GeoWaypoint{Lat: 57.7, Long: 11.97}}}},
+	}}
+	resp, err := client.Parking.FacilitiesAtDestination(context.Background(), &route, 0)
+	assert.NilError(t, err)
+	assert.Equal(t, "parking-v2.cc.api.here.com/parking/facilities.json?57.7,11.97,500", requested)
+	assert.Equal(t, 2, len(resp.Facilities))
+	assert.Assert(t, !resp.Facilities[0].Availability.HasFreeSpaces())
+	assert.Assert(t, resp.Facilities[1].Availability.HasFreeSpaces())
+	_, err = client.Parking.FacilitiesAtDestination(context.Background(), &routingv8.Route{}, 0)
+	assert.ErrorContains(t, err, "route has no sections")
+}
+
+func TestParkingService_Finder(t *testing.T) {
+	t.Parallel()
+	client := parkingv2.NewClient(httpClientFunc(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(facilitiesJSON))}, nil
+	}))
+	parkings, err := client.Parking.Finder(300).FindParking(
+		context.Background(), routingv8.GeoWaypoint{Lat: 57.7, Long: 11.97},
+	)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, []routingv8.Parking{
+		{ID: "f2", Name: "Nordstan", Location: routingv8.GeoWaypoint{Lat: 57.7089, Long: 11.9698}},
+		{ID: "f1", Name: "Full garage", Location: routingv8.GeoWaypoint{Lat: 57.7081, Long: 11.9691}},
+	}, parkings)
+}
+
+func TestParkingService_Segments_Error(t *testing.T) {
+	t.Parallel()
+	client := parkingv2.NewClient(httpClientFunc(func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, "/parking/segments.json", req.URL.Path)
+		return &http.Response{
+			StatusCode: http.StatusForbidden,
+			Body:       io.NopCloser(strings.NewReader(`{"title":"Not licensed","status":403}`)),
+		}, nil
+	}))
+	_, err := client.Parking.Segments(context.Background(), &parkingv2.SearchRequest{})
+	assert.ErrorContains(t, err, "Not licensed")
+}
@@ -0,0 +1,57 @@
+package parkingv2
+
+import (
+	"context"
+	"fmt"
+
+	"go.einride.tech/here/routingv8"
+)
+
+// RouteDestination returns the arrival location of the last section of a route.
+func RouteDestination(route *routingv8.Route) (GeoWaypoint, bool) {
+	if len(route.Sections) == 0 {
+		return GeoWaypoint{}, false
+	}
+	location := route.Sections[len(route.Sections)-1].Arrival.Place.Location
+	return GeoWaypoint{Lat: location.Lat, Long: location.Long}, true
+}
+
+// FacilitiesAtDestination searches for off-street parking facilities around the destination of a route.
+func (s *ParkingService) FacilitiesAtDestination(
+	ctx context.Context,
+	route *routingv8.Route,
+	radius int,
+) (*FacilitiesResponse, error) {
+	destination, ok := RouteDestination(route)
+	if !ok {
+		return nil, fmt.Errorf("parking facilities at destination: route has no sections")
+	}
+	return s.Facilities(ctx, &SearchRequest{At: destination, Radius: radius})
+}
+
+// Finder returns a routingv8.ParkingFinder of the facilities within radius, preferring facilities with free
+// spaces and then the closest ones.
+func (s *ParkingService) Finder(radius int) routingv8.ParkingFinder {
+	return routingv8.ParkingFinderFunc(
+		func(ctx context.Context, near routingv8.GeoWaypoint) ([]routingv8.Parking, error) {
+			resp, err := s.Facilities(ctx, &SearchRequest{At: GeoWaypoint{Lat: near.Lat, Long: near.Long}, Radius: radius})
+			if err != nil {
+				return nil, err
+			}
+			parkings := make([]routingv8.Parking, 0, len(resp.Facilities))
+			for _, free := range []bool{true, false} {
+				for _, facility := range resp.Facilities {
+					if facility.Availability.HasFreeSpaces() != free {
+						continue
+					}
+					parkings = append(parkings, routingv8.Parking{
+						ID:       facility.ID,
+						Name:     facility.Name,
+						Location: routingv8.GeoWaypoint{Lat: facility.Position.Lat, Long: facility.Position.Long},
+					})
+				}
+			}
+			return parkings, nil
+		},
+	)
+}
@@ -0,0 +1,47 @@
+package parkingv2
+
+import "fmt"
+
+type GeoWaypoint struct {
+	Lat  float64 `json:"latitude"`
+	Long float64 `json:"longitude"`
+}
+
+func (w GeoWaypoint) String() string {
+	return fmt.Sprintf("%v,%v", w.Lat, w.Long)
+}
+
+// Availability of parking spaces.
+type Availability struct {
+	// Spaces is the total number of spaces.
+	Spaces int `json:"spaces"`
+	// Free is the number of free spaces, nil if the live availability is unknown or not licensed.
+	Free *int `json:"free,omitempty"`
+	// Probability that a free space is found, between 0 and 1, for predicted on-street availability.
+	Probability *float64 `json:"probability,omitempty"`
+}
+
+// HasFreeSpaces reports whether the availability is known and there are free spaces.
+func (a *Availability) HasFreeSpaces() bool {
+	return a != nil && a.Free != nil && *a.Free > 0
+}
+
+// Facility is an off-street parking facility, such as a parking garage or a parking lot.
+type Facility struct {
+	ID       string      `json:"id"`
+	Name     string      `json:"name"`
+	Position GeoWaypoint `json:"position"`
+	// Distance from the search center in meters.
+	Distance     int           `json:"distance"`
+	Availability *Availability `json:"availability,omitempty"`
+}
+
+// Segment is a road segment with on-street parking.
+type Segment struct {
+	ID string `json:"id"`
+	// Shape of the segment.
+	Shape []GeoWaypoint `json:"shape"`
+	// Distance from the search center in meters.
+	Distance     int           `json:"distance"`
+	Availability *Availability `json:"availability,omitempty"`
+}
@@ -0,0 +1,401 @@
+package here
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"go.einride.tech/here/geo"
+)
+
+// CoordinatePrecision configures how coordinates are reduced before they are sent to HERE, for deployments which
+// must not share exact locations with third parties.
+type CoordinatePrecision struct {
+	// Decimals is the number of decimals coordinates are rounded to, e.g. 3 for about 110 meters. Coordinates are
+	// not rounded if zero.
+	Decimals int
+	// Jitter is the maximum distance in meters coordinates are moved in a random direction before rounding.
+	// Coordinates are not moved if zero.
+	Jitter float64
+	// Random returns random numbers in [0, 1) for the jitter. Defaults to rand.Float64.
+	Random func() float64
+}
+
+// reduce returns the coordinate moved by the jitter and rounded to the decimals.
+func (p *CoordinatePrecision) reduce(lat, lng float64) (float64, float64) {
+	if p.Jitter > 0 {
+		random := p.Random
+		if random == nil {
+			random = rand.Float64
+		}
+		// Uniformly distributed in the disc with the radius of the jitter.
+		distance := p.Jitter * math.Sqrt(random())
+		bearing := 2 * math.Pi * random()
+		dLat := distance * math.Cos(bearing) / geo.EarthRadius * 180 / math.Pi
+		dLng := distance * math.Sin(bearing) / geo.EarthRadius * 180 / math.Pi / math.Cos(lat*math.Pi/180)
+		lat = math.Max(-90, math.Min(90, lat+dLat))
+		lng += dLng
+		if lng > 180 {
+			lng -= 360
+		} else if lng < -180 {
+			lng += 360
+		}
+	}
+	if p.Decimals > 0 {
+		scale := math.Pow10(p.Decimals)
+		lat = math.Round(lat*scale) / scale
+		lng = math.Round(lng*scale) / scale
+	}
+	return lat, lng
+}
+
+// coordinateParameters are the query parameters containing coordinates, e.g. origin=52.5,13.4 or
+// in=circle:52.5,13.4;r=1000. The destination and waypoint parameters are matched by prefix, see
+// isCoordinateParameter.
+var coordinateParameters = map[string]bool{
+	"origin": true,
+	"via":    true,
+	"at":     true,
+	"in":     true,
+	"center": true,
+	"prox":   true,
+	"start":  true,
+	"end":    true,
+}
+
+var coordinatePattern = regexp.MustCompile(`-?\d{1,3}(?:\.\d+)?,-?\d{1,3}(?:\.\d+)?`)
+
+// gpxPointPattern matches the start tags of the points of GPX traces, whose lat and lon attributes are reduced.
+var gpxPointPattern = regexp.MustCompile(`<(?:trkpt|rtept|wpt)\b[^>]*>`)
+
+var gpxAttributePattern = regexp.MustCompile(`\b(lat|lon)="([^"]*)"`)
+
+// PrivacyRoundTripper reduces the precision of the coordinates of requests before sending them. Coordinates are
+// reduced in the query parameters of waypoints and areas, in the coordinate and bounding box fields of JSON request
+// bodies, such as the origins, destinations and regions of matrices, in CSV traces and in the points of GPX
+// traces. Gzip compressed bodies are decompressed, reduced and compressed again. Encoded polylines, e.g. of
+// corridors, are sent unchanged.
+//
+// Requests are not sent if their bodies can not be reduced: bodies that can not be parsed, with incomplete
+// coordinates, of other content types, e.g. uploaded geofence layers, or with other content encodings are rejected
+// with an error.
+type PrivacyRoundTripper struct {
+	precision CoordinatePrecision
+	next      http.RoundTripper
+}
+
+var _ http.RoundTripper = &PrivacyRoundTripper{}
+
+// NewPrivacyRoundTripper returns a round tripper reducing coordinates to the precision.
+// If next is nil http.DefaultTransport is used.
+func NewPrivacyRoundTripper(precision CoordinatePrecision, next http.RoundTripper) *PrivacyRoundTripper {
+	return &PrivacyRoundTripper{precision: precision, next: next}
+}
+
+func (p *PrivacyRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	next := p.next
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	req = req.Clone(req.Context())
+	query, err := url.ParseQuery(req.URL.RawQuery)
+	if err != nil {
+		return nil, fmt.Errorf("privacy: %v", err)
+	}
+	for key, values := range query {
+		if !isCoordinateParameter(key) {
+			continue
+		}
+		for i, value := range values {
+			reduce := p.reduceCoordinate
+			if strings.HasPrefix(value, "bbox:") {
+				// Bounding boxes are west,south,east,north.
+				reduce = p.reduceSwappedCoordinate
+			}
+			values[i] = coordinatePattern.ReplaceAllStringFunc(value, reduce)
+		}
+	}
+	req.URL.RawQuery = query.Encode()
+	if req.Body != nil && req.Body != http.NoBody {
+		body, err := io.ReadAll(req.Body)
+		_ = req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		if len(body) > 0 {
+			if body, err = p.reduceEncodedBody(body, req.Header); err != nil {
+				return nil, fmt.Errorf("privacy: %v", err)
+			}
+		}
+		req.Body = io.NopCloser(bytes.NewReader(body))
+		req.GetBody = func() (io.ReadCloser, error) {
+			return io.NopCloser(bytes.NewReader(body)), nil
+		}
+		req.ContentLength = int64(len(body))
+	}
+	return next.RoundTrip(req)
+}
+
+func isCoordinateParameter(key string) bool {
+	return coordinateParameters[key] || strings.HasPrefix(key, "waypoint") || strings.HasPrefix(key, "destination")
+}
+
+// reduceEncodedBody reduces the body of the content type and content encoding of the header.
+func (p *PrivacyRoundTripper) reduceEncodedBody(body []byte, header http.Header) ([]byte, error) {
+	switch encoding := header.Get("Content-Encoding"); encoding {
+	case "":
+		return p.reduceBody(body, header.Get("Content-Type"))
+	case "gzip":
+		r, err := gzip.NewReader(bytes.NewReader(body))
+		if err != nil {
+			return nil, err
+		}
+		decompressed, err := io.ReadAll(r)
+		if err != nil {
+			return nil, err
+		}
+		reduced, err := p.reduceBody(decompressed, header.Get("Content-Type"))
+		if err != nil {
+			return nil, err
+		}
+		var b bytes.Buffer
+		w := gzip.NewWriter(&b)
+		if _, err := w.Write(reduced); err != nil {
+			return nil, err
+		}
+		if err := w.Close(); err != nil {
+			return nil, err
+		}
+		return b.Bytes(), nil
+	default:
+		return nil, fmt.Errorf("unsupported content encoding %q", encoding)
+	}
+}
+
+// reduceBody reduces the coordinates of a body of the content type.
+func (p *PrivacyRoundTripper) reduceBody(body []byte, contentType string) ([]byte, error) {
+	mediaType := strings.TrimSpace(strings.SplitN(contentType, ";", 2)[0])
+	switch {
+	case mediaType == "application/json" || strings.HasSuffix(mediaType, "+json"):
+		return p.reduceJSON(body)
+	case mediaType == "text/csv":
+		return p.reduceCSV(body)
+	case mediaType == "application/gpx+xml":
+		return p.reduceGPX(body)
+	default:
+		return nil, fmt.Errorf("unsupported content type %q", contentType)
+	}
+}
+
+// reduceCoordinate reduces a coordinate of the form lat,lng.
+func (p *PrivacyRoundTripper) reduceCoordinate(coordinate string) string {
+	i := strings.IndexByte(coordinate, ',')
+	lat, err := strconv.ParseFloat(coordinate[:i], 64)
+	if err != nil {
+		return coordinate
+	}
+	lng, err := strconv.ParseFloat(coordinate[i+1:], 64)
+	if err != nil {
+		return coordinate
+	}
+	lat, lng = p.precision.reduce(lat, lng)
+	return formatFloat(lat) + "," + formatFloat(lng)
+}
+
+// reduceSwappedCoordinate reduces a coordinate of the form lng,lat.
+func (p *PrivacyRoundTripper) reduceSwappedCoordinate(coordinate string) string {
+	i := strings.IndexByte(coordinate, ',')
+	reduced := p.reduceCoordinate(coordinate[i+1:] + "," + coordinate[:i])
+	j := strings.IndexByte(reduced, ',')
+	return reduced[j+1:] + "," + reduced[:j]
+}
+
+// reduceCSV reduces the coordinates of a CSV trace, whose rows after the header start with the latitude and
+// longitude.
+func (p *PrivacyRoundTripper) reduceCSV(body []byte) ([]byte, error) {
+	lines := bytes.Split(body, []byte("\n"))
+	for i, line := range lines[1:] {
+		if len(bytes.TrimSpace(line)) == 0 {
+			continue
+		}
+		loc := coordinatePattern.FindIndex(line)
+		if loc == nil || loc[0] != 0 {
+			return nil, fmt.Errorf("invalid CSV row %d: no leading coordinate", i+1)
+		}
+		reduced := p.reduceCoordinate(string(line[:loc[1]]))
+		lines[i+1] = append([]byte(reduced), line[loc[1]:]...)
+	}
+	return bytes.Join(lines, []byte("\n")), nil
+}
+
+// reduceGPX reduces the lat and lon attributes of the points of a GPX trace.
+func (p *PrivacyRoundTripper) reduceGPX(body []byte) ([]byte, error) {
+	var err error
+	reduced := gpxPointPattern.ReplaceAllFunc(body, func(tag []byte) []byte {
+		var lat, lon float64
+		latErr, lonErr := fmt.Errorf("missing lat"), fmt.Errorf("missing lon")
+		for _, m := range gpxAttributePattern.FindAllSubmatch(tag, -1) {
+			if string(m[1]) == "lat" {
+				lat, latErr = strconv.ParseFloat(string(m[2]), 64)
+			} else {
+				lon, lonErr = strconv.ParseFloat(string(m[2]), 64)
+			}
+		}
+		if latErr != nil || lonErr != nil {
+			if err == nil {
+				err = fmt.Errorf("invalid GPX point %s", tag)
+			}
+			return tag
+		}
+		lat, lon = p.precision.reduce(lat, lon)
+		return gpxAttributePattern.ReplaceAllFunc(tag, func(attribute []byte) []byte {
+			if bytes.HasPrefix(attribute, []byte("lat")) {
+				return []byte(`lat="` + formatFloat(lat) + `"`)
+			}
+			return []byte(`lon="` + formatFloat(lon) + `"`)
+		})
+	})
+	if err != nil {
+		return nil, err
+	}
+	return reduced, nil
+}
+
+// jsonCoordinateFields are the pairs of latitude and longitude fields of the objects of JSON bodies. Bounding boxes
+// are reduced as their south-west and north-east corners.
+var jsonCoordinateFields = [][2]string{
+	{"lat", "lng"},
+	{"lat", "lon"},
+	{"latitude", "longitude"},
+	{"south", "west"},
+	{"north", "east"},
+}
+
+// reduceJSON reduces the coordinates of all objects with coordinate fields of a JSON body, see jsonCoordinateFields,
+// and of bbox arrays of the form [west, south, east, north]. Bodies with incomplete coordinates are rejected.
+func (p *PrivacyRoundTripper) reduceJSON(body []byte) ([]byte, error) {
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return nil, err
+	}
+	if err := p.reduceValue(value); err != nil {
+		return nil, err
+	}
+	return json.Marshal(value)
+}
+
+func (p *PrivacyRoundTripper) reduceValue(value interface{}) error {
+	switch value := value.(type) {
+	case map[string]interface{}:
+		reduced := make(map[string]bool, len(value))
+		for _, fields := range jsonCoordinateFields {
+			if err := p.reduceFields(value, fields[0], fields[1], reduced); err != nil {
+				return err
+			}
+		}
+		for _, fields := range jsonCoordinateFields {
+			for _, key := range fields {
+				if _, ok := value[key]; ok && !reduced[key] {
+					return fmt.Errorf("incomplete coordinate field %q", key)
+				}
+			}
+		}
+		for key, field := range value {
+			if reduced[key] {
+				continue
+			}
+			if key == "bbox" {
+				if err := p.reduceBBox(value, field); err != nil {
+					return err
+				}
+				continue
+			}
+			if err := p.reduceValue(field); err != nil {
+				return err
+			}
+		}
+	case []interface{}:
+		for _, element := range value {
+			if err := p.reduceValue(element); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// reduceFields reduces the coordinate of the latitude and longitude fields of an object, if both are present, and
+// marks them as reduced.
+func (p *PrivacyRoundTripper) reduceFields(
+	object map[string]interface{},
+	latKey, lngKey string,
+	reduced map[string]bool,
+) error {
+	latField, latOK := object[latKey]
+	lngField, lngOK := object[lngKey]
+	if !latOK || !lngOK {
+		return nil
+	}
+	if reduced[latKey] {
+		// E.g. an object with lat, lng and lon fields.
+		return fmt.Errorf("ambiguous coordinate fields %q and %q", latKey, lngKey)
+	}
+	lat, latErr := jsonFloat(latField)
+	lng, lngErr := jsonFloat(lngField)
+	if latErr != nil || lngErr != nil {
+		return fmt.Errorf("invalid coordinate fields %q and %q", latKey, lngKey)
+	}
+	lat, lng = p.precision.reduce(lat, lng)
+	object[latKey] = json.Number(formatFloat(lat))
+	object[lngKey] = json.Number(formatFloat(lng))
+	reduced[latKey], reduced[lngKey] = true, true
+	return nil
+}
+
+// reduceBBox reduces the corners of a bbox field of the form [west, south, east, north].
+func (p *PrivacyRoundTripper) reduceBBox(object map[string]interface{}, field interface{}) error {
+	elements, ok := field.([]interface{})
+	if !ok || len(elements) != 4 {
+		return fmt.Errorf("invalid bbox field")
+	}
+	var bbox [4]float64
+	for i, element := range elements {
+		f, err := jsonFloat(element)
+		if err != nil {
+			return fmt.Errorf("invalid bbox field")
+		}
+		bbox[i] = f
+	}
+	south, west := p.precision.reduce(bbox[1], bbox[0])
+	north, east := p.precision.reduce(bbox[3], bbox[2])
+	object["bbox"] = []interface{}{
+		json.Number(formatFloat(west)),
+		json.Number(formatFloat(south)),
+		json.Number(formatFloat(east)),
+		json.Number(formatFloat(north)),
+	}
+	return nil
+}
+
+func jsonFloat(value interface{}) (float64, error) {
+	n, ok := value.(json.Number)
+	if !ok {
+		return 0, fmt.Errorf("not a number: %v", value)
+	}
+	return n.Float64()
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
@@ -0,0 +1,280 @@
+package here_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"go.einride.tech/here"
+	"go.einride.tech/here/routematching"
+	"go.einride.tech/here/routingv8"
+	"gotest.tools/v3/assert"
+)
+
+func TestPrivacyRoundTripper(t *testing.T) {
+	t.Parallel()
+	var query, body string
+	var next roundTripperFunc = func(req *http.Request) (*http.Response, error) {
+		query = req.URL.RawQuery
+		if req.Body != nil {
+			b, err := io.ReadAll(req.Body)
+			if err != nil {
+				return nil, err
+			}
+			body = string(b)
+			assert.Equal(t, int64(len(b)), req.ContentLength)
+		}
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(`{}`))}, nil
+	}
+	client := &http.Client{Transport: here.NewPrivacyRoundTripper(here.CoordinatePrecision{Decimals: 3}, next)}
+
+	resp, err := client.Get(
+		"https://router.hereapi.com/v8/routes?origin=57.707752,11.949767&destination=59.337492,18.063672" +
+			"&via=58.1234,12.5678!stopDuration=300&departureTime=2023-01-01T12:00:00Z&apiKey=key",
+	)
+	assert.NilError(t, err)
+	assert.NilError(t, resp.Body.Close())
+	assert.Equal(
+		t,
+		"apiKey=key&departureTime=2023-01-01T12%3A00%3A00Z&destination=59.337%2C18.064"+
+			"&origin=57.708%2C11.95&via=58.123%2C12.568%21stopDuration%3D300",
+		query,
+	)
+
+	resp, err = client.Get("https://data.traffic.hereapi.com/v7/flow?in=bbox:11.94321,57.61234,12.15678,57.88765")
+	assert.NilError(t, err)
+	assert.NilError(t, resp.Body.Close())
+	assert.Equal(t, "in=bbox%3A11.943%2C57.612%2C12.157%2C57.888", query)
+
+	resp, err = client.Post(
+		"https://matrix.router.hereapi.com/v8/matrix",
+		"application/json",
+		strings.NewReader(`{"origins":[{"lat":57.707752,"lng":11.949767}],"profile":"carFast","count":1.23456}`),
+	)
+	assert.NilError(t, err)
+	assert.NilError(t, resp.Body.Close())
+	var got map[string]interface{}
+	assert.NilError(t, json.Unmarshal([]byte(body), &got))
+	assert.DeepEqual(t, map[string]interface{}{
+		"origins": []interface{}{map[string]interface{}{"lat": 57.708, "lng": 11.95}},
+		"profile": "carFast",
+		"count":   1.23456,
+	}, got)
+}
+
+func TestPrivacyRoundTripper_BoundingBoxes(t *testing.T) {
+	t.Parallel()
+	var body string
+	var next roundTripperFunc = func(req *http.Request) (*http.Response, error) {
+		b, err := io.ReadAll(req.Body)
+		assert.NilError(t, err)
+		body = string(b)
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(`{}`))}, nil
+	}
+	client := routingv8.NewClient(&http.Client{
+		Transport: here.NewPrivacyRoundTripper(here.CoordinatePrecision{Decimals: 2}, next),
+	})
+	_, err := client.Matrix.CalculateMatrixSync(context.Background(), &routingv8.CalculateMatrixBody{
+		Origins: []*routingv8.GeoWaypoint{{Lat: 57.707752, Long: 11.949767}},
+		RegionDefinition: routingv8.RegionDefinition{
+			Type:             routingv8.RegionTypeBoundingBox,
+			BoundingBoxNorth: 57.887654,
+			BoundingBoxEast:  12.156789,
+			BoundingBoxSouth: 57.612345,
+			BoundingBoxWest:  11.943219,
+		},
+	})
+	assert.NilError(t, err)
+	var got struct {
+		RegionDefinition map[string]interface{} `json:"regionDefinition"`
+	}
+	assert.NilError(t, json.Unmarshal([]byte(body), &got))
+	assert.DeepEqual(t, map[string]interface{}{
+		"type":  "boundingBox",
+		"north": 57.89,
+		"east":  12.16,
+		"south": 57.61,
+		"west":  11.94,
+	}, got.RegionDefinition)
+
+	plain := &http.Client{Transport: here.NewPrivacyRoundTripper(here.CoordinatePrecision{Decimals: 2}, next)}
+	resp, err := plain.Post(
+		"https://example.hereapi.com/v1/search",
+		"application/json",
+		strings.NewReader(`{"bbox":[11.943219,57.612345,12.156789,57.887654],"at":{"lat":57.707752,"lon":11.949767}}`),
+	)
+	assert.NilError(t, err)
+	assert.NilError(t, resp.Body.Close())
+	assert.Equal(t, `{"at":{"lat":57.71,"lon":11.95},"bbox":[11.94,57.61,12.16,57.89]}`, body)
+}
+
+func TestPrivacyRoundTripper_Jitter(t *testing.T) {
+	t.Parallel()
+	var query string
+	var next roundTripperFunc = func(req *http.Request) (*http.Response, error) {
+		query = req.URL.Query().Get("at")
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(`{}`))}, nil
+	}
+	// A random value of 1 moves the coordinate by the full jitter, and 0.25 turns the bearing east.
+	random := []float64{1, 0.25}
+	precision := here.CoordinatePrecision{
+		Decimals: 4,
+		Jitter:   1000,
+		Random: func() float64 {
+			r := random[0]
+			random = random[1:]
+			return r
+		},
+	}
+	client := &http.Client{Transport: here.NewPrivacyRoundTripper(precision, next)}
+	resp, err := client.Get("https://revgeocode.search.hereapi.com/v1/revgeocode?at=0,10")
+	assert.NilError(t, err)
+	assert.NilError(t, resp.Body.Close())
+	assert.Equal(t, "0,10.009", query)
+}
+
+func TestPrivacyRoundTripper_CompressedBody(t *testing.T) {
+	t.Parallel()
+	var body string
+	var next roundTripperFunc = func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, "gzip", req.Header.Get("Content-Encoding"))
+		r, err := gzip.NewReader(req.Body)
+		assert.NilError(t, err)
+		b, err := io.ReadAll(r)
+		assert.NilError(t, err)
+		body = string(b)
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(`{}`))}, nil
+	}
+	client := routingv8.NewClient(&http.Client{
+		Transport: here.NewPrivacyRoundTripper(here.CoordinatePrecision{Decimals: 2}, next),
+	})
+	client.CompressionThreshold = 1
+	_, err := client.Matrix.CalculateMatrixSync(context.Background(), &routingv8.CalculateMatrixBody{
+		Origins:          []*routingv8.GeoWaypoint{{Lat: 57.707752, Long: 11.949767}},
+		RegionDefinition: routingv8.RegionDefinition{Type: routingv8.RegionTypeWorld},
+	})
+	assert.NilError(t, err)
+	assert.Assert(t, strings.Contains(body, `{"lat":57.71,"lng":11.95}`), body)
+	assert.Assert(t, !strings.Contains(body, "57.707752"), body)
+}
+
+func TestPrivacyRoundTripper_Traces(t *testing.T) {
+	t.Parallel()
+	var body string
+	var next roundTripperFunc = func(req *http.Request) (*http.Response, error) {
+		b, err := io.ReadAll(req.Body)
+		assert.NilError(t, err)
+		body = string(b)
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(`{}`))}, nil
+	}
+	client := &http.Client{Transport: here.NewPrivacyRoundTripper(here.CoordinatePrecision{Decimals: 2}, next)}
+	points := []routematching.TracePoint{
+		{Lat: 57.707752, Long: 11.949767, Time: time.Date(2022, 6, 1, 8, 0, 0, 0, time.UTC)},
+		{Lat: 57.712345, Long: 11.956789, Time: time.Date(2022, 6, 1, 8, 1, 0, 0, time.UTC)},
+	}
+	resp, err := client.Post("https://routematching.hereapi.com/v8/match/routelinks", "text/csv",
+		bytes.NewReader(routematching.EncodeCSV(points)))
+	assert.NilError(t, err)
+	assert.NilError(t, resp.Body.Close())
+	assert.Equal(t, "LATITUDE,LONGITUDE,TIMESTAMP\n"+
+		"57.71,11.95,2022-06-01T08:00:00Z\n57.71,11.96,2022-06-01T08:01:00Z\n", body)
+	gpx, err := routematching.EncodeGPX(points)
+	assert.NilError(t, err)
+	resp, err = client.Post("https://routematching.hereapi.com/v8/match/routelinks", "application/gpx+xml",
+		bytes.NewReader(gpx))
+	assert.NilError(t, err)
+	assert.NilError(t, resp.Body.Close())
+	assert.Assert(t, strings.Contains(body, `<trkpt lat="57.71" lon="11.95">`), body)
+	assert.Assert(t, !strings.Contains(body, "57.707752"), body)
+}
+
+func TestPrivacyRoundTripper_WaypointSequence(t *testing.T) {
+	t.Parallel()
+	var query url.Values
+	var next roundTripperFunc = func(req *http.Request) (*http.Response, error) {
+		query = req.URL.Query()
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(`{}`))}, nil
+	}
+	client := &http.Client{Transport: here.NewPrivacyRoundTripper(here.CoordinatePrecision{Decimals: 2}, next)}
+	resp, err := client.Get("https://wps.hereapi.com/v8/findsequence2?start=depot%3B57.707752,11.949767" +
+		"&destination1=a%3B57.712345,11.956789%3Bst:300&end=depot%3B57.707752,11.949767")
+	assert.NilError(t, err)
+	assert.NilError(t, resp.Body.Close())
+	assert.Equal(t, "depot;57.71,11.95", query.Get("start"))
+	assert.Equal(t, "a;57.71,11.96;st:300", query.Get("destination1"))
+	assert.Equal(t, "depot;57.71,11.95", query.Get("end"))
+	_, err = client.Get("https://wps.hereapi.com/v8/findsequence2?start=depot;57.707752,11.949767")
+	assert.ErrorContains(t, err, "privacy: invalid semicolon separator in query")
+}
+
+func TestPrivacyRoundTripper_Unreducible(t *testing.T) {
+	t.Parallel()
+	var next roundTripperFunc = func(req *http.Request) (*http.Response, error) {
+		t.Fatal("unexpected request")
+		return nil, nil
+	}
+	client := &http.Client{Transport: here.NewPrivacyRoundTripper(here.CoordinatePrecision{Decimals: 2}, next)}
+	for _, tt := range []struct {
+		name        string
+		contentType string
+		body        string
+		err         string
+	}{
+		{
+			name:        "invalid JSON",
+			contentType: "application/json",
+			body:        `{"lat":57.707752,"lng":11.949767`,
+			err:         "privacy: unexpected EOF",
+		},
+		{
+			name:        "unsupported content type",
+			contentType: "application/octet-stream",
+			body:        "57.707752,11.949767",
+			err:         `privacy: unsupported content type "application/octet-stream"`,
+		},
+		{
+			name:        "invalid CSV",
+			contentType: "text/csv",
+			body:        "LATITUDE,LONGITUDE\n57.707752 11.949767\n",
+			err:         "privacy: invalid CSV row 1: no leading coordinate",
+		},
+		{
+			name:        "incomplete JSON coordinate",
+			contentType: "application/json",
+			body:        `{"origins":[{"lat":57.707752}]}`,
+			err:         `privacy: incomplete coordinate field "lat"`,
+		},
+		{
+			name:        "incomplete JSON bounding box",
+			contentType: "application/json",
+			body:        `{"regionDefinition":{"type":"boundingBox","north":57.8,"east":12.1,"south":57.6}}`,
+			err:         `privacy: incomplete coordinate field "south"`,
+		},
+		{
+			name:        "invalid JSON bbox",
+			contentType: "application/json",
+			body:        `{"bbox":[11.94321,57.61234]}`,
+			err:         "privacy: invalid bbox field",
+		},
+		{
+			name:        "invalid GPX",
+			contentType: "application/gpx+xml",
+			body:        `<gpx><trk><trkseg><trkpt lat="57.707752"></trkpt></trkseg></trk></gpx>`,
+			err:         `privacy: invalid GPX point <trkpt lat="57.707752">`,
+		},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			_, err := client.Post("https://router.hereapi.com/v8/matrix", tt.contentType, strings.NewReader(tt.body))
+			assert.ErrorContains(t, err, tt.err)
+		})
+	}
+}
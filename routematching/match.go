@@ -0,0 +1,211 @@
+package routematching
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// AttributeSpeedLimits are the speed limit attributes of the links, see Link.SpeedLimit.
+const AttributeSpeedLimits = "SPEED_LIMITS_FCn(*)"
+
+// TransportMode of the vehicle driving the trace.
+type TransportMode int
+
+const (
+	TransportModeUnspecified TransportMode = iota
+	TransportModeCar
+	TransportModeTruck
+	TransportModePedestrian
+)
+
+func (t TransportMode) String() string {
+	switch t {
+	case TransportModeUnspecified:
+		return unspecified
+	case TransportModeCar:
+		return "car"
+	case TransportModeTruck:
+		return "truck"
+	case TransportModePedestrian:
+		return "pedestrian"
+	default:
+		return invalid
+	}
+}
+
+type MatchRequest struct {
+	// Points of the trace, encoded in Format. Ignored if Trace is set.
+	Points []TracePoint
+	// Trace is an encoded GPX or CSV trace, e.g. from a telematics device, sent as is.
+	Trace []byte
+	// Format of the trace. Defaults to TraceFormatCSV.
+	Format TraceFormat
+	// TransportMode of the vehicle. Defaults to TransportModeCar.
+	TransportMode TransportMode
+	// Attributes of the links to return. Defaults to AttributeSpeedLimits.
+	Attributes []string
+}
+
+func (r *MatchRequest) QueryString() (string, error) {
+	format, transportMode := r.format(), TransportModeCar
+	if format.String() == invalid {
+		return "", fmt.Errorf("invalid format: %d", int(r.Format))
+	}
+	if r.TransportMode != TransportModeUnspecified {
+		transportMode = r.TransportMode
+	}
+	if transportMode.String() == invalid {
+		return "", fmt.Errorf("invalid transport mode: %d", int(r.TransportMode))
+	}
+	attributes := r.Attributes
+	if len(attributes) == 0 {
+		attributes = []string{AttributeSpeedLimits}
+	}
+	values := make(url.Values)
+	values.Add("routemode", transportMode.String())
+	values.Add("filetype", strings.ToUpper(format.String()))
+	values.Add("attributes", strings.Join(attributes, ","))
+	return values.Encode(), nil
+}
+
+func (r *MatchRequest) format() TraceFormat {
+	if r.Format == TraceFormatUnspecified {
+		return TraceFormatCSV
+	}
+	return r.Format
+}
+
+func (r *MatchRequest) body() ([]byte, error) {
+	if len(r.Trace) > 0 {
+		return r.Trace, nil
+	}
+	if len(r.Points) < 2 {
+		return nil, fmt.Errorf("invalid trace: %d points, require at least 2", len(r.Points))
+	}
+	if r.format() == TraceFormatGPX {
+		return EncodeGPX(r.Points)
+	}
+	return EncodeCSV(r.Points), nil
+}
+
+// MatchResponse contains the route matched to a trace.
+type MatchResponse struct {
+	// Links of the matched route, in driving order.
+	Links []Link `json:"RouteLinks"`
+	// TracePoints are the points of the trace with their matched positions, in the order of the trace.
+	TracePoints []MatchedPoint `json:"TracePoints"`
+	// Warnings about the matching, e.g. about points which could not be matched.
+	Warnings   []Warning `json:"Warnings"`
+	MapVersion string    `json:"mapVersion"`
+}
+
+// Link is a road link of a matched route.
+type Link struct {
+	// LinkID of the link, negative if the link is driven towards its reference node.
+	LinkID          int64 `json:"linkId"`
+	FunctionalClass int   `json:"functionalClass"`
+	// Confidence of the match of the link, between 0 and 1.
+	Confidence float64 `json:"confidence"`
+	// Length of the link in meters.
+	Length float64 `json:"linkLength"`
+	// MillisToReach is the time from the start of the trace to the start of the link in milliseconds.
+	MillisToReach int64 `json:"mSecToReachLinkFromStart"`
+	// Shape of the link as space separated latitudes and longitudes.
+	Shape string `json:"shape"`
+	// Attributes of the link by attribute group, e.g. SPEED_LIMITS_FCN.
+	Attributes map[string][]map[string]string `json:"attributes,omitempty"`
+}
+
+// SpeedLimit returns the speed limit of the link in the driving direction in km/h, false if the speed limit is not
+// known. Requires AttributeSpeedLimits.
+func (l *Link) SpeedLimit() (int, bool) {
+	limits := l.Attributes["SPEED_LIMITS_FCN"]
+	if len(limits) == 0 {
+		return 0, false
+	}
+	column := "FROM_REF_SPEED_LIMIT"
+	if l.LinkID < 0 {
+		column = "TO_REF_SPEED_LIMIT"
+	}
+	limit, err := strconv.Atoi(limits[0][column])
+	if err != nil || limit <= 0 {
+		return 0, false
+	}
+	return limit, true
+}
+
+// MatchedPoint is a point of the trace matched to a link.
+type MatchedPoint struct {
+	Lat         float64 `json:"lat"`
+	Long        float64 `json:"lon"`
+	MatchedLat  float64 `json:"latMatched"`
+	MatchedLong float64 `json:"lonMatched"`
+	// LinkID of the link the point was matched to.
+	LinkID int64 `json:"linkIdMatched"`
+	// LinkIndex is the index of the link in MatchResponse.Links the point was matched to.
+	LinkIndex int `json:"routeLinkSeqNrMatched"`
+	// MatchDistance is the distance from the point to the matched position in meters.
+	MatchDistance float64 `json:"matchDistance"`
+	// Confidence of the match of the point, between 0 and 1.
+	Confidence float64 `json:"confidenceValue"`
+}
+
+// Warning is a problem with a part of the trace.
+type Warning struct {
+	Category int    `json:"category"`
+	Text     string `json:"text"`
+	// LinkIndex is the index of the link the warning applies to.
+	LinkIndex int `json:"routeLinkSeqNum"`
+	// PointIndex is the index of the trace point the warning applies to.
+	PointIndex int `json:"tracePointSeqNum"`
+}
+
+// MeanConfidence returns the mean confidence of the matched links, weighted by their length.
+func (r *MatchResponse) MeanConfidence() float64 {
+	var sum, length float64
+	for _, link := range r.Links {
+		sum += link.Confidence * link.Length
+		length += link.Length
+	}
+	if length == 0 {
+		return 0
+	}
+	return sum / length
+}
+
+// Match matches a GPS trace to the road network and returns the links of the matched route.
+// See https://developer.here.com/documentation/route-matching/dev_guide/index.html
+// for details about other parameters.
+func (s *MatchingService) Match(ctx context.Context, req *MatchRequest) (_ *MatchResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("match route: %w", err)
+		}
+	}()
+	query, err := req.QueryString()
+	if err != nil {
+		return nil, err
+	}
+	body, err := req.body()
+	if err != nil {
+		return nil, err
+	}
+	u, err := s.URL.Parse("match/routelinks")
+	if err != nil {
+		return nil, err
+	}
+	r, err := s.Client.NewRequest(ctx, u, http.MethodPost, query, req.format().contentType(), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	var resp MatchResponse
+	if err := s.Client.Do(r, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
@@ -0,0 +1,115 @@
+package routematching_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"go.einride.tech/here/routematching"
+	"gotest.tools/v3/assert"
+)
+
+type httpClientFunc func(*http.Request) (*http.Response, error)
+
+func (f httpClientFunc) Do(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestMatchingService_Match(t *testing.T) {
+	t.Parallel()
+	var requested, contentType, body string
+	client := routematching.NewClient(httpClientFunc(func(req *http.Request) (*http.Response, error) {
+		requested = req.URL.Path + "?" + req.URL.RawQuery
+		contentType = req.Header.Get("Content-Type")
+		b, err := io.ReadAll(req.Body)
+		assert.NilError(t, err)
+		body = string(b)
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(`{
+			"RouteLinks": [
+				{"linkId": 1001, "functionalClass": 3, "confidence": 1.0, "linkLength": 300,
+					"mSecToReachLinkFromStart": 0, "shape": "57.7 11.9 57.701 11.901",
+					"attributes": {"SPEED_LIMITS_FCN": [{"FROM_REF_SPEED_LIMIT": "70", "TO_REF_SPEED_LIMIT": "50"}]}},
+				{"linkId": -1002, "functionalClass": 4, "confidence": 0.5, "linkLength": 100,
+					"mSecToReachLinkFromStart": 15000, "shape": "57.701 11.901 57.702 11.902",
+					"attributes": {"SPEED_LIMITS_FCN": [{"FROM_REF_SPEED_LIMIT": "70", "TO_REF_SPEED_LIMIT": "50"}]}}
+			],
+			"TracePoints": [
+				{"lat": 57.7, "lon": 11.9, "latMatched": 57.70001, "lonMatched": 11.9, "linkIdMatched": 1001,
+					"routeLinkSeqNrMatched": 0, "matchDistance": 1.1, "confidenceValue": 1.0},
+				{"lat": 57.702, "lon": 11.902, "latMatched": 57.702, "lonMatched": 11.902, "linkIdMatched": -1002,
+					"routeLinkSeqNrMatched": 1, "matchDistance": 0.2, "confidenceValue": 0.5}
+			],
+			"Warnings": [{"category": 1, "text": "Trace point off road", "routeLinkSeqNum": 1, "tracePointSeqNum": 1}],
+			"mapVersion": "2022Q2"
+		}`))}, nil
+	}))
+	start := time.Date(2022, 6, 1, 10, 0, 0, 0, time.UTC)
+	resp, err := client.Matching.Match(context.Background(), &routematching.MatchRequest{
+		Points: []routematching.TracePoint{
+			{Lat: 57.7, Long: 11.9, Time: start},
+			{Lat: 57.702, Long: 11.902, Time: start.Add(30 * time.Second)},
+		},
+		TransportMode: routematching.TransportModeTruck,
+	})
+	assert.NilError(t, err)
+	assert.Equal(
+		t,
+		"/v8/match/routelinks?attributes=SPEED_LIMITS_FCn%28%2A%29&filetype=CSV&routemode=truck",
+		requested,
+	)
+	assert.Equal(t, "text/csv", contentType)
+	assert.Equal(
+		t,
+		"LATITUDE,LONGITUDE,TIMESTAMP\n57.7,11.9,2022-06-01T10:00:00Z\n57.702,11.902,2022-06-01T10:00:30Z\n",
+		body,
+	)
+	assert.Equal(t, 2, len(resp.Links))
+	limit, ok := resp.Links[0].SpeedLimit()
+	assert.Assert(t, ok)
+	assert.Equal(t, 70, limit)
+	limit, ok = resp.Links[1].SpeedLimit()
+	assert.Assert(t, ok)
+	assert.Equal(t, 50, limit)
+	assert.Equal(t, 0.875, resp.MeanConfidence())
+	assert.Equal(t, int64(-1002), resp.TracePoints[1].LinkID)
+	assert.Equal(t, "Trace point off road", resp.Warnings[0].Text)
+}
+
+func TestMatchingService_Match_GPX(t *testing.T) {
+	t.Parallel()
+	var contentType, body string
+	client := routematching.NewClient(httpClientFunc(func(req *http.Request) (*http.Response, error) {
+		contentType = req.Header.Get("Content-Type")
+		b, err := io.ReadAll(req.Body)
+		assert.NilError(t, err)
+		body = string(b)
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(`{}`))}, nil
+	}))
+	_, err := client.Matching.Match(context.Background(), &routematching.MatchRequest{
+		Points: []routematching.TracePoint{{Lat: 57.7, Long: 11.9}, {Lat: 57.8, Long: 12}},
+		Format: routematching.TraceFormatGPX,
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, "application/gpx+xml", contentType)
+	assert.Assert(t, strings.Contains(body, `<trkpt lat="57.7" lon="11.9"></trkpt><trkpt lat="57.8" lon="12"></trkpt>`))
+}
+
+func TestMatchingService_Match_Invalid(t *testing.T) {
+	t.Parallel()
+	client := routematching.NewClient(httpClientFunc(func(req *http.Request) (*http.Response, error) {
+		t.Fatal("unexpected request")
+		return nil, nil
+	}))
+	_, err := client.Matching.Match(context.Background(), &routematching.MatchRequest{
+		Points: []routematching.TracePoint{{Lat: 57.7, Long: 11.9}},
+	})
+	assert.Error(t, err, "match route: invalid trace: 1 points, require at least 2")
+	_, err = client.Matching.Match(context.Background(), &routematching.MatchRequest{
+		Trace:  []byte("LATITUDE,LONGITUDE\n"),
+		Format: 42,
+	})
+	assert.Error(t, err, "match route: invalid format: 42")
+}
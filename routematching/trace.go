@@ -0,0 +1,113 @@
+package routematching
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+const (
+	invalid     = "invalid"
+	unspecified = "unspecified"
+)
+
+// TracePoint is a GPS position of a trace.
+type TracePoint struct {
+	Lat  float64
+	Long float64
+	// Time of the position. Optional, but improves the matching of traces with gaps.
+	Time time.Time
+}
+
+// TraceFormat is the file format of a trace.
+type TraceFormat int
+
+const (
+	TraceFormatUnspecified TraceFormat = iota
+	// TraceFormatCSV is a comma separated file with a header of the columns, e.g. LATITUDE,LONGITUDE,TIMESTAMP.
+	TraceFormatCSV
+	// TraceFormatGPX is a GPS exchange format file.
+	TraceFormatGPX
+)
+
+func (f TraceFormat) String() string {
+	switch f {
+	case TraceFormatUnspecified:
+		return unspecified
+	case TraceFormatCSV:
+		return "csv"
+	case TraceFormatGPX:
+		return "gpx"
+	default:
+		return invalid
+	}
+}
+
+func (f TraceFormat) contentType() string {
+	if f == TraceFormatGPX {
+		return "application/gpx+xml"
+	}
+	return "text/csv"
+}
+
+// EncodeCSV returns the points as a CSV trace. The TIMESTAMP column is only included if all points have a time.
+func EncodeCSV(points []TracePoint) []byte {
+	withTime := len(points) > 0
+	for _, point := range points {
+		withTime = withTime && !point.Time.IsZero()
+	}
+	var b bytes.Buffer
+	b.WriteString("LATITUDE,LONGITUDE")
+	if withTime {
+		b.WriteString(",TIMESTAMP")
+	}
+	b.WriteString("\n")
+	for _, point := range points {
+		b.WriteString(formatFloat(point.Lat))
+		b.WriteString(",")
+		b.WriteString(formatFloat(point.Long))
+		if withTime {
+			b.WriteString(",")
+			b.WriteString(point.Time.UTC().Format(time.RFC3339))
+		}
+		b.WriteString("\n")
+	}
+	return b.Bytes()
+}
+
+type gpx struct {
+	XMLName xml.Name   `xml:"gpx"`
+	Version string     `xml:"version,attr"`
+	Creator string     `xml:"creator,attr"`
+	XMLNS   string     `xml:"xmlns,attr"`
+	Points  []gpxPoint `xml:"trk>trkseg>trkpt"`
+}
+
+type gpxPoint struct {
+	Lat  float64 `xml:"lat,attr"`
+	Lon  float64 `xml:"lon,attr"`
+	Time string  `xml:"time,omitempty"`
+}
+
+// EncodeGPX returns the points as a GPX trace with a single track segment.
+func EncodeGPX(points []TracePoint) ([]byte, error) {
+	doc := gpx{Version: "1.1", Creator: userAgent, XMLNS: "http://www.topografix.com/GPX/1/1"}
+	for _, point := range points {
+		p := gpxPoint{Lat: point.Lat, Lon: point.Long}
+		if !point.Time.IsZero() {
+			p.Time = point.Time.UTC().Format(time.RFC3339)
+		}
+		doc.Points = append(doc.Points, p)
+	}
+	b, err := xml.Marshal(&doc)
+	if err != nil {
+		return nil, fmt.Errorf("encode GPX: %v", err)
+	}
+	return append([]byte(xml.Header), b...), nil
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
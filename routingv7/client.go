@@ -8,10 +8,12 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+
+	"go.einride.tech/here/internal/useragent"
 )
 
 const (
-	userAgent = "einride/here-go"
+	userAgent = useragent.UserAgent
 )
 
 // RouteService handles communication with the route-related methods of the v7 HERE API.
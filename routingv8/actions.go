@@ -0,0 +1,40 @@
+package routingv8
+
+import "fmt"
+
+// ActionPosition is an action mapped onto the polyline of its section.
+type ActionPosition struct {
+	// Action at the position.
+	Action Action
+	// Location of the polyline point at the offset of the action.
+	Location GeoWaypoint
+	// Distance in meters along the polyline from the start of the section to the action.
+	Distance float64
+}
+
+// ActionPositions maps the offsets of the actions of the section to locations and cumulative distances along the
+// section polyline. The section has to be requested with the polyline and actions return attributes.
+func (s *Section) ActionPositions() ([]ActionPosition, error) {
+	points, err := s.decodePolyline()
+	if err != nil {
+		return nil, fmt.Errorf("action positions of section %s: %v", s.ID, err)
+	}
+	cumulative := make([]float64, len(points))
+	for i := 1; i < len(points); i++ {
+		cumulative[i] = cumulative[i-1] + distance(points[i-1], points[i])
+	}
+	positions := make([]ActionPosition, 0, len(s.Actions))
+	for _, action := range s.Actions {
+		if action.Offset < 0 || action.Offset >= len(points) {
+			return nil, fmt.Errorf(
+				"action positions of section %s: offset %d out of range [0, %d)", s.ID, action.Offset, len(points),
+			)
+		}
+		positions = append(positions, ActionPosition{
+			Action:   action,
+			Location: points[action.Offset],
+			Distance: cumulative[action.Offset],
+		})
+	}
+	return positions, nil
+}
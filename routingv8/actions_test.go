@@ -0,0 +1,61 @@
+package routingv8_test
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+
+	"go.einride.tech/here/routingv8"
+	"gotest.tools/v3/assert"
+)
+
+func TestSection_ActionPositions(t *testing.T) {
+	t.Parallel()
+	section := routingv8.Section{
+		ID:       "section-1",
+		Polyline: "BFoz5xJ67i1B1B7PzIhaxL7Y",
+		Actions: []routingv8.Action{
+			{Action: "depart", Offset: 0},
+			{Action: "turn", Offset: 2},
+			{Action: "arrive", Offset: 3},
+		},
+	}
+	positions, err := section.ActionPositions()
+	assert.NilError(t, err)
+	assert.Equal(t, 3, len(positions))
+	assert.Equal(t, routingv8.GeoWaypoint{Lat: 50.10228, Long: 8.69821}, positions[0].Location)
+	assert.Equal(t, 0.0, positions[0].Distance)
+	assert.Equal(t, routingv8.GeoWaypoint{Lat: 50.10063, Long: 8.6915}, positions[1].Location)
+	assert.Equal(t, "turn", positions[1].Action.Action)
+	assert.Assert(t, math.Abs(positions[1].Distance-518.3) < 1, positions[1].Distance)
+	assert.Assert(t, positions[2].Distance > positions[1].Distance)
+
+	section.Actions = append(section.Actions, routingv8.Action{Action: "arrive", Offset: 4})
+	_, err = section.ActionPositions()
+	assert.ErrorContains(t, err, "out of range")
+}
+
+func TestAction_UnmarshalJSON(t *testing.T) {
+	t.Parallel()
+	var action routingv8.Action
+	assert.NilError(t, json.Unmarshal([]byte(`{
+		"action": "roundaboutExit",
+		"duration": 12,
+		"length": 80,
+		"instruction": "Take the 2nd exit from Järntorget onto Första Långgatan.",
+		"offset": 7,
+		"direction": "right",
+		"severity": "light",
+		"exit": 2
+	}`), &action))
+	assert.DeepEqual(t, routingv8.Action{
+		Action:      "roundaboutExit",
+		Duration:    12,
+		Length:      80,
+		Instruction: "Take the 2nd exit from Järntorget onto Första Långgatan.",
+		Offset:      7,
+		Direction:   "right",
+		Severity:    "light",
+		Exit:        2,
+	}, action)
+}
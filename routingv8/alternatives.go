@@ -0,0 +1,96 @@
+package routingv8
+
+import (
+	"fmt"
+	"math"
+)
+
+const (
+	// DefaultAlternatives is the number of alternative routes requested when RoutesRequest.Alternatives is zero.
+	DefaultAlternatives = 6
+	// NoAlternatives requests only the best route.
+	NoAlternatives = -1
+	// MaxAlternatives is the maximum number of alternative routes supported by the API.
+	MaxAlternatives = 6
+)
+
+// overlapPrecision is the number of decimals polyline points are rounded to when compared, about one meter.
+const overlapPrecision = 1e5
+
+type pointKey struct {
+	lat, long int64
+}
+
+func keyOf(p GeoWaypoint) pointKey {
+	return pointKey{lat: int64(math.Round(p.Lat * overlapPrecision)), long: int64(math.Round(p.Long * overlapPrecision))}
+}
+
+// routeGeometry returns the decoded polyline of all sections of the route.
+func routeGeometry(route *Route) ([]GeoWaypoint, error) {
+	var points []GeoWaypoint
+	for i := range route.Sections {
+		sectionPoints, err := route.Sections[i].decodePolyline()
+		if err != nil {
+			return nil, fmt.Errorf("section %s: %v", route.Sections[i].ID, err)
+		}
+		points = append(points, sectionPoints...)
+	}
+	return points, nil
+}
+
+// RouteOverlap returns the share of the geometric length of route a, between 0 and 1, that is also covered by
+// route b. Routes have to be requested with the polyline return attribute.
+func RouteOverlap(a, b *Route) (float64, error) {
+	pointsA, err := routeGeometry(a)
+	if err != nil {
+		return 0, fmt.Errorf("route overlap: %v", err)
+	}
+	pointsB, err := routeGeometry(b)
+	if err != nil {
+		return 0, fmt.Errorf("route overlap: %v", err)
+	}
+	return overlap(pointsA, pointsB), nil
+}
+
+func overlap(a, b []GeoWaypoint) float64 {
+	keys := make(map[pointKey]struct{}, len(b))
+	for _, p := range b {
+		keys[keyOf(p)] = struct{}{}
+	}
+	var total, shared float64
+	for i := 1; i < len(a); i++ {
+		d := distance(a[i-1], a[i])
+		total += d
+		_, ok1 := keys[keyOf(a[i-1])]
+		_, ok2 := keys[keyOf(a[i])]
+		if ok1 && ok2 {
+			shared += d
+		}
+	}
+	if total == 0 {
+		return 0
+	}
+	return shared / total
+}
+
+// DistinctRoutes drops routes that overlap any preceding route by more than maxOverlap, between 0 and 1.
+// The order of the routes is preserved, so the best route is always kept.
+func DistinctRoutes(routes []Route, maxOverlap float64) ([]Route, error) {
+	result := make([]Route, 0, len(routes))
+	kept := make([][]GeoWaypoint, 0, len(routes))
+RoutesLoop:
+	for i := range routes {
+		points, err := routeGeometry(&routes[i])
+		if err != nil {
+			return nil, fmt.Errorf("distinct routes: route %s: %v", routes[i].ID, err)
+		}
+		for _, keptPoints := range kept {
+			if overlap(points, keptPoints) > maxOverlap {
+				continue RoutesLoop
+			}
+		}
+		kept = append(kept, points)
+		result = append(result, routes[i])
+	}
+	return result, nil
+}
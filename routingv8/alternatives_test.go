@@ -0,0 +1,99 @@
+package routingv8_test
+
+import (
+	"math"
+	"testing"
+
+	"go.einride.tech/here/routingv8"
+	"gotest.tools/v3/assert"
+)
+
+func TestRouteOverlap(t *testing.T) {
+	t.Parallel()
+	route := func(id, polyline string) routingv8.Route {
+		return routingv8.Route{ID: id, Sections: []routingv8.Section{{Polyline: polyline}}}
+	}
+	// Four segments of equal length along the equator, from 0,0 to 0,0.04.
+	a := route("a", "BFAAAw-BAw-BAw-BAw-B")
+	for _, tt := range []struct {
+		name     string
+		b        routingv8.Route
+		expected float64
+	}{
+		{name: "identical", b: a, expected: 1},
+		{name: "disjoint", b: route("b", "BFgqjGAAw-BAw-B"), expected: 0},
+		{name: "partial", b: route("b", "BFAAAw-BAw-Bw-Bw-B"), expected: 0.5},
+		{
+			name: "split in sections",
+			b: routingv8.Route{Sections: []routingv8.Section{
+				{Polyline: "BFAAAw-B"},
+				{Polyline: "BFAw-BAw-BAw-B"},
+			}},
+			expected: 0.75,
+		},
+		{name: "empty", b: routingv8.Route{}, expected: 0},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			overlap, err := routingv8.RouteOverlap(&a, &tt.b)
+			assert.NilError(t, err)
+			assert.Assert(t, math.Abs(tt.expected-overlap) < 1e-6, overlap)
+		})
+	}
+	t.Run("invalid polyline", func(t *testing.T) {
+		t.Parallel()
+		invalid := routingv8.Route{Sections: []routingv8.Section{{ID: "s1", Polyline: "!"}}}
+		_, err := routingv8.RouteOverlap(&a, &invalid)
+		assert.ErrorContains(t, err, "route overlap: section s1: ")
+		_, err = routingv8.RouteOverlap(&invalid, &a)
+		assert.ErrorContains(t, err, "route overlap: section s1: ")
+	})
+}
+
+func TestDistinctRoutes(t *testing.T) {
+	t.Parallel()
+	route := func(id, polyline string) routingv8.Route {
+		return routingv8.Route{ID: id, Sections: []routingv8.Section{{Polyline: polyline}}}
+	}
+	best := route("best", "BFAAAw-BAw-BAw-BAw-B")
+	// Shares about half of its length with the best route.
+	half := route("half", "BFAAAw-BAw-Bw-Bw-BAw-B")
+	same := route("same", "BFAAAw-BAw-BAw-BAw-B")
+	other := route("other", "BFgqjGAAw-B")
+	routes := []routingv8.Route{best, half, same, other}
+	halfOverlap, err := routingv8.RouteOverlap(&half, &best)
+	assert.NilError(t, err)
+	assert.Assert(t, halfOverlap > 0.4 && halfOverlap < 0.5, halfOverlap)
+	ids := func(routes []routingv8.Route) []string {
+		result := make([]string, 0, len(routes))
+		for _, route := range routes {
+			result = append(result, route.ID)
+		}
+		return result
+	}
+	for _, tt := range []struct {
+		name       string
+		maxOverlap float64
+		expected   []string
+	}{
+		{name: "at boundary", maxOverlap: halfOverlap, expected: []string{"best", "half", "other"}},
+		{name: "below boundary", maxOverlap: halfOverlap - 1e-9, expected: []string{"best", "other"}},
+		{name: "all", maxOverlap: 1, expected: []string{"best", "half", "same", "other"}},
+		{name: "disjoint only", maxOverlap: 0, expected: []string{"best", "other"}},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			distinct, err := routingv8.DistinctRoutes(routes, tt.maxOverlap)
+			assert.NilError(t, err)
+			assert.DeepEqual(t, tt.expected, ids(distinct))
+		})
+	}
+	t.Run("invalid polyline", func(t *testing.T) {
+		t.Parallel()
+		invalid := routingv8.Route{ID: "r1", Sections: []routingv8.Section{{ID: "s1", Polyline: "!"}}}
+		_, err := routingv8.DistinctRoutes([]routingv8.Route{best, invalid}, 0.5)
+		assert.ErrorContains(t, err, "distinct routes: route r1: section s1: ")
+	})
+}
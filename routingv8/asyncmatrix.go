@@ -0,0 +1,157 @@
+package routingv8
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// SubmitMatrix starts an asynchronous matrix calculation. The status of the calculation can be polled with
+// MatrixStatus or WatchMatrixStatus, and the result downloaded with GetMatrix once completed.
+func (s *MatrixService) SubmitMatrix(
+	ctx context.Context,
+	body *CalculateMatrixBody,
+) (_ *MatrixStatusResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("submit matrix: %w", err)
+		}
+	}()
+	if err := body.validate(); err != nil {
+		return nil, &invalidInputError{err: err}
+	}
+	u, err := s.URL.Parse("matrix")
+	if err != nil {
+		return nil, err
+	}
+	b, err := body.marshal()
+	if err != nil {
+		return nil, err
+	}
+	r, err := s.Client.NewRequest(ctx, u, http.MethodPost, (&CalculateMatrixRequest{Async: true}).QueryString(), b)
+	if err != nil {
+		return nil, err
+	}
+	var resp MatrixStatusResponse
+	if err := s.Client.Do(r, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// GetMatrix downloads the result of a completed asynchronous matrix calculation.
+func (s *MatrixService) GetMatrix(ctx context.Context, matrixID string) (_ *CalculateMatrixResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("get matrix %s: %w", matrixID, err)
+		}
+	}()
+	u, err := s.URL.Parse("matrix/" + matrixID)
+	if err != nil {
+		return nil, err
+	}
+	return s.getMatrix(ctx, u)
+}
+
+func (s *MatrixService) getMatrix(ctx context.Context, u *url.URL) (*CalculateMatrixResponse, error) {
+	r, err := s.Client.NewRequest(ctx, u, http.MethodGet, "", nil)
+	if err != nil {
+		return nil, err
+	}
+	var resp CalculateMatrixResponse
+	if err := s.Client.Do(r, &resp); err != nil {
+		return nil, err
+	}
+	if s.Client.ValidateResponses {
+		if err := resp.Matrix.Validate(); err != nil {
+			return nil, err
+		}
+	}
+	return &resp, nil
+}
+
+// CalculateMatrixAndWait submits an asynchronous matrix calculation, polls its status with the PollBackoff of the
+// Client until it is done and downloads the result. Large matrices have to be calculated asynchronously.
+// The calculation is deleted if the context is done before the calculation.
+func (s *MatrixService) CalculateMatrixAndWait(
+	ctx context.Context,
+	body *CalculateMatrixBody,
+) (_ *CalculateMatrixResponse, err error) {
+	submitted, err := s.SubmitMatrix(ctx, body)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err != nil {
+			if ctx.Err() != nil {
+				s.abandonMatrix(submitted.MatrixID)
+			}
+			err = fmt.Errorf("calculate matrix %s: %w", submitted.MatrixID, err)
+		}
+	}()
+	status := submitted
+	if !status.Done() {
+		for update := range s.WatchMatrixStatus(ctx, submitted.MatrixID) {
+			if update.Err != nil {
+				return nil, update.Err
+			}
+			status = update.Status
+		}
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+	}
+	switch status.Status {
+	case MatrixStatusCompleted:
+	case MatrixStatusFailed:
+		if status.Error != nil {
+			return nil, fmt.Errorf("failed: %s: %s", status.Error.Title, status.Error.Cause)
+		}
+		return nil, fmt.Errorf("failed")
+	default:
+		return nil, fmt.Errorf("unexpected status %q", status.Status)
+	}
+	resultURL := s.URL.ResolveReference(&url.URL{Path: "matrix/" + submitted.MatrixID})
+	if status.ResultURL != "" {
+		if resultURL, err = url.Parse(status.ResultURL); err != nil {
+			return nil, err
+		}
+	}
+	resp, err := s.getMatrix(ctx, resultURL)
+	if err != nil {
+		return nil, err
+	}
+	resp.RegionWarning = body.RegionWarning(resp)
+	return resp, nil
+}
+
+// DeleteMatrix deletes an asynchronous matrix calculation, cancelling it if it is still in progress. Calculations
+// that are no longer needed should be deleted to not use up the quota of the account.
+func (s *MatrixService) DeleteMatrix(ctx context.Context, matrixID string) (err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("delete matrix %s: %w", matrixID, err)
+		}
+	}()
+	u, err := s.URL.Parse("matrix/" + matrixID)
+	if err != nil {
+		return err
+	}
+	r, err := s.Client.NewRequest(ctx, u, http.MethodDelete, "", nil)
+	if err != nil {
+		return err
+	}
+	return s.Client.Do(r, nil)
+}
+
+// abandonMatrixTimeout is the timeout of deleting a matrix calculation after its context is done.
+const abandonMatrixTimeout = 10 * time.Second
+
+// abandonMatrix deletes a matrix calculation on a best effort basis, after the context of the caller is done.
+func (s *MatrixService) abandonMatrix(matrixID string) {
+	ctx, cancel := context.WithTimeout(context.Background(), abandonMatrixTimeout)
+	defer cancel()
+	_ = s.DeleteMatrix(ctx, matrixID)
+}
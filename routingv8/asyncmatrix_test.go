@@ -0,0 +1,122 @@
+package routingv8_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"go.einride.tech/here/routingv8"
+	"gotest.tools/v3/assert"
+)
+
+func TestMatrixService_CalculateMatrixAndWait(t *testing.T) {
+	t.Parallel()
+	const result = `{
+		"matrixId": "m1",
+		"matrix": {"numOrigins": 1, "numDestinations": 1, "travelTimes": [60], "errorCodes": [0]},
+		"regionDefinition": {"type": "world"}
+	}`
+	statuses := []string{
+		`{"matrixId":"m1","status":"inProgress"}`,
+		`{"matrixId":"m1","status":"completed","resultUrl":"https://aws-eu-west-1.matrix.router.hereapi.com/v8/matrix/m1"}`,
+	}
+	var requests []string
+	client := routingv8.NewClient(httpClientFunc(func(req *http.Request) (*http.Response, error) {
+		requests = append(requests, req.Method+" "+req.URL.Host+req.URL.Path+"?"+req.URL.RawQuery)
+		body := result
+		switch {
+		case req.Method == http.MethodPost:
+			body = `{"matrixId":"m1","status":"accepted","statusUrl":"https://matrix.router.hereapi.com/v8/matrix/m1/status"}`
+		case strings.HasSuffix(req.URL.Path, "/status"):
+			body = statuses[0]
+			statuses = statuses[1:]
+		}
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(body))}, nil
+	}))
+	client.Clock = &fakeClock{}
+	client.PollBackoff = routingv8.ConstantBackoff{Interval: time.Second}
+	resp, err := client.Matrix.CalculateMatrixAndWait(context.Background(), &routingv8.CalculateMatrixBody{
+		Origins:          []*routingv8.GeoWaypoint{{Lat: 57.707752, Long: 11.949767}},
+		RegionDefinition: routingv8.RegionDefinition{Type: routingv8.RegionTypeWorld},
+		Profile:          routingv8.ProfileTruckFast,
+	})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, []int32{60}, resp.Matrix.TravelTimes)
+	assert.DeepEqual(t, []string{
+		"POST matrix.router.hereapi.com/v8/matrix?async=true",
+		"GET matrix.router.hereapi.com/v8/matrix/m1/status?",
+		"GET matrix.router.hereapi.com/v8/matrix/m1/status?",
+		"GET aws-eu-west-1.matrix.router.hereapi.com/v8/matrix/m1?",
+	}, requests)
+}
+
+func TestMatrixService_CalculateMatrixAndWait_Failed(t *testing.T) {
+	t.Parallel()
+	client := routingv8.NewClient(httpClientFunc(func(req *http.Request) (*http.Response, error) {
+		body := `{"matrixId":"m1","status":"accepted"}`
+		if req.Method == http.MethodGet {
+			body = `{"matrixId":"m1","status":"failed","error":{"title":"Too many origins","cause":"limit 10000"}}`
+		}
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(body))}, nil
+	}))
+	_, err := client.Matrix.CalculateMatrixAndWait(context.Background(), &routingv8.CalculateMatrixBody{
+		RegionDefinition: routingv8.RegionDefinition{Type: routingv8.RegionTypeWorld},
+		Profile:          routingv8.ProfileTruckFast,
+	})
+	assert.Error(t, err, "calculate matrix m1: failed: Too many origins: limit 10000")
+}
+
+func TestMatrixService_GetMatrix(t *testing.T) {
+	t.Parallel()
+	var path string
+	client := routingv8.NewClient(httpClientFunc(func(req *http.Request) (*http.Response, error) {
+		path = req.URL.Path
+		return &http.Response{
+			StatusCode: http.StatusNotFound,
+			Body:       io.NopCloser(strings.NewReader(`{"title":"Matrix not found","status":404}`)),
+		}, nil
+	}))
+	_, err := client.Matrix.GetMatrix(context.Background(), "m1")
+	assert.ErrorContains(t, err, "get matrix m1: Title: Matrix not found")
+	assert.Equal(t, "/v8/matrix/m1", path)
+}
+
+func TestMatrixService_DeleteMatrix(t *testing.T) {
+	t.Parallel()
+	var request string
+	client := routingv8.NewClient(httpClientFunc(func(req *http.Request) (*http.Response, error) {
+		request = req.Method + " " + req.URL.Path
+		return &http.Response{StatusCode: http.StatusNoContent, Body: io.NopCloser(strings.NewReader(""))}, nil
+	}))
+	assert.NilError(t, client.Matrix.DeleteMatrix(context.Background(), "m1"))
+	assert.Equal(t, "DELETE /v8/matrix/m1", request)
+}
+
+func TestMatrixService_CalculateMatrixAndWait_Cancelled(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var requests []string
+	client := routingv8.NewClient(httpClientFunc(func(req *http.Request) (*http.Response, error) {
+		requests = append(requests, req.Method+" "+req.URL.Path)
+		body := `{"matrixId":"m1","status":"inProgress"}`
+		switch req.Method {
+		case http.MethodPost:
+			body = `{"matrixId":"m1","status":"accepted"}`
+		case http.MethodGet:
+			cancel()
+		case http.MethodDelete:
+			return &http.Response{StatusCode: http.StatusNoContent, Body: io.NopCloser(strings.NewReader(""))}, nil
+		}
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(body))}, nil
+	}))
+	_, err := client.Matrix.CalculateMatrixAndWait(ctx, &routingv8.CalculateMatrixBody{
+		RegionDefinition: routingv8.WorldRegion(),
+		Profile:          routingv8.ProfileTruckFast,
+	})
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, "DELETE /v8/matrix/m1", requests[len(requests)-1])
+}
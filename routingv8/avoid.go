@@ -0,0 +1,89 @@
+package routingv8
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// AvoidFeature is a road feature routes should avoid.
+type AvoidFeature int
+
+const (
+	AvoidFeatureUnspecified AvoidFeature = iota
+	AvoidFeatureSeasonalClosure
+	AvoidFeatureTollRoad
+	AvoidFeatureControlledAccessHighway
+	AvoidFeatureFerry
+	AvoidFeatureCarShuttleTrain
+	AvoidFeatureTunnel
+	AvoidFeatureDirtRoad
+	AvoidFeatureDifficultTurns
+	AvoidFeatureUTurns
+)
+
+func (a AvoidFeature) String() string {
+	switch a {
+	case AvoidFeatureUnspecified:
+		return unspecified
+	case AvoidFeatureSeasonalClosure:
+		return "seasonalClosure"
+	case AvoidFeatureTollRoad:
+		return "tollRoad"
+	case AvoidFeatureControlledAccessHighway:
+		return "controlledAccessHighway"
+	case AvoidFeatureFerry:
+		return "ferry"
+	case AvoidFeatureCarShuttleTrain:
+		return "carShuttleTrain"
+	case AvoidFeatureTunnel:
+		return "tunnel"
+	case AvoidFeatureDirtRoad:
+		return "dirtRoad"
+	case AvoidFeatureDifficultTurns:
+		return "difficultTurns"
+	case AvoidFeatureUTurns:
+		return "uTurns"
+	default:
+		return invalid
+	}
+}
+
+// Avoid describes what routes should avoid.
+type Avoid struct {
+	// Features of roads to avoid.
+	Features []AvoidFeature
+	// Areas to avoid.
+	Areas []BoundingBox
+	// Segments to avoid, as segment references, e.g. "here:cm:segment:76771992#+".
+	Segments []string
+}
+
+// addQuery adds the avoid parameters to the query.
+func (a *Avoid) addQuery(values url.Values) error {
+	if len(a.Features) > 0 {
+		features := make([]string, 0, len(a.Features))
+		for _, feature := range a.Features {
+			name := feature.String()
+			if name == invalid || name == unspecified {
+				return fmt.Errorf("invalid avoid feature: %d", int(feature))
+			}
+			features = append(features, name)
+		}
+		values.Add("avoid[features]", strings.Join(features, ","))
+	}
+	if len(a.Areas) > 0 {
+		areas := make([]string, 0, len(a.Areas))
+		for _, area := range a.Areas {
+			if area.South > area.North {
+				return fmt.Errorf("invalid avoid area: south %v above north %v", area.South, area.North)
+			}
+			areas = append(areas, fmt.Sprintf("bbox:%v,%v,%v,%v", area.West, area.South, area.East, area.North))
+		}
+		values.Add("avoid[areas]", strings.Join(areas, "|"))
+	}
+	if len(a.Segments) > 0 {
+		values.Add("avoid[segments]", strings.Join(a.Segments, ","))
+	}
+	return nil
+}
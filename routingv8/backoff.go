@@ -0,0 +1,58 @@
+package routingv8
+
+import (
+	"time"
+
+	"go.einride.tech/here/internal/hereapi"
+)
+
+// Backoff decides how long to wait before polling an asynchronous calculation again or retrying a request.
+type Backoff = hereapi.Backoff
+
+// ConstantBackoff waits the same interval before every attempt.
+type ConstantBackoff struct {
+	Interval time.Duration
+}
+
+var _ Backoff = ConstantBackoff{}
+
+// Delay returns the interval.
+func (c ConstantBackoff) Delay(int, time.Duration) time.Duration {
+	return c.Interval
+}
+
+// ExponentialBackoff multiplies the delay by Multiplier for every attempt, up to Max.
+type ExponentialBackoff = hereapi.ExponentialBackoff
+
+// ServerSuggestedBackoff waits the interval suggested by the server, and falls back to another strategy when the
+// server did not suggest one.
+type ServerSuggestedBackoff struct {
+	// Fallback strategy. Defaults to waiting one second.
+	Fallback Backoff
+	// Max delay. Zero means no limit.
+	Max time.Duration
+}
+
+var _ Backoff = ServerSuggestedBackoff{}
+
+// Delay returns the suggested interval, or the delay of the fallback strategy.
+func (s ServerSuggestedBackoff) Delay(attempt int, suggested time.Duration) time.Duration {
+	delay := suggested
+	if delay <= 0 {
+		if s.Fallback == nil {
+			delay = time.Second
+		} else {
+			delay = s.Fallback.Delay(attempt, suggested)
+		}
+	}
+	if s.Max > 0 && delay > s.Max {
+		return s.Max
+	}
+	return delay
+}
+
+// DefaultPollBackoff is the strategy used for polling asynchronous calculations when the Client has none.
+var DefaultPollBackoff Backoff = ServerSuggestedBackoff{
+	Fallback: ExponentialBackoff{Initial: 500 * time.Millisecond, Max: 10 * time.Second},
+	Max:      time.Minute,
+}
@@ -0,0 +1,31 @@
+package routingv8_test
+
+import (
+	"testing"
+	"time"
+
+	"go.einride.tech/here/routingv8"
+	"gotest.tools/v3/assert"
+)
+
+func TestBackoff(t *testing.T) {
+	t.Parallel()
+	constant := routingv8.ConstantBackoff{Interval: time.Second}
+	assert.Equal(t, time.Second, constant.Delay(5, time.Minute))
+
+	exponential := routingv8.ExponentialBackoff{Initial: time.Second, Max: 5 * time.Second}
+	assert.Equal(t, time.Second, exponential.Delay(1, 0))
+	assert.Equal(t, 2*time.Second, exponential.Delay(2, 0))
+	assert.Equal(t, 4*time.Second, exponential.Delay(3, 0))
+	assert.Equal(t, 5*time.Second, exponential.Delay(4, 0))
+	assert.Equal(t, 5*time.Second, exponential.Delay(1000, 0))
+
+	suggested := routingv8.ServerSuggestedBackoff{Fallback: constant, Max: time.Minute}
+	assert.Equal(t, 3*time.Second, suggested.Delay(1, 3*time.Second))
+	assert.Equal(t, time.Second, suggested.Delay(1, 0))
+	assert.Equal(t, time.Minute, suggested.Delay(1, time.Hour))
+
+	defaulted := routingv8.ServerSuggestedBackoff{Max: 500 * time.Millisecond}
+	assert.Equal(t, 500*time.Millisecond, defaulted.Delay(1, 0))
+	assert.Equal(t, time.Second, routingv8.ServerSuggestedBackoff{}.Delay(1, 0))
+}
@@ -0,0 +1,76 @@
+package routingv8
+
+import (
+	"fmt"
+	"math"
+
+	"go.einride.tech/here/geo"
+)
+
+// BoundingBox is the area between two latitudes and two longitudes. Boxes crossing the antimeridian are not
+// supported.
+type BoundingBox struct {
+	North float64
+	South float64
+	East  float64
+	West  float64
+}
+
+// BoundsOf returns the smallest bounding box containing all points. The zero BoundingBox is returned if there are
+// no points.
+func BoundsOf(points ...GeoWaypoint) BoundingBox {
+	if len(points) == 0 {
+		return BoundingBox{}
+	}
+	b := BoundingBox{North: points[0].Lat, South: points[0].Lat, East: points[0].Long, West: points[0].Long}
+	for _, p := range points[1:] {
+		b.North = math.Max(b.North, p.Lat)
+		b.South = math.Min(b.South, p.Lat)
+		b.East = math.Max(b.East, p.Long)
+		b.West = math.Min(b.West, p.Long)
+	}
+	return b
+}
+
+// BoundsOfRoute returns the smallest bounding box containing the polylines of all sections of the route. Sections
+// without a polyline contribute their departure and arrival locations.
+func BoundsOfRoute(route *Route) (BoundingBox, error) {
+	var points []GeoWaypoint
+	for i := range route.Sections {
+		section := &route.Sections[i]
+		if section.Polyline == "" {
+			points = append(points, section.Departure.Place.Location, section.Arrival.Place.Location)
+			continue
+		}
+		sectionPoints, err := section.decodePolyline()
+		if err != nil {
+			return BoundingBox{}, fmt.Errorf("bounds of route %s: section %s: %v", route.ID, section.ID, err)
+		}
+		points = append(points, sectionPoints...)
+	}
+	return BoundsOf(points...), nil
+}
+
+// Pad returns the bounding box extended by the given distance in meters in all directions.
+func (b BoundingBox) Pad(meters float64) BoundingBox {
+	dLat := meters / geo.EarthRadius * 180 / math.Pi
+	// Pad longitudes at the latitude closest to a pole, so that the padding is at least the given distance.
+	maxLat := math.Min(89, math.Max(math.Abs(b.North), math.Abs(b.South))+dLat)
+	dLong := dLat / math.Cos(maxLat*math.Pi/180)
+	return BoundingBox{
+		North: math.Min(90, b.North+dLat),
+		South: math.Max(-90, b.South-dLat),
+		East:  math.Min(180, b.East+dLong),
+		West:  math.Max(-180, b.West-dLong),
+	}
+}
+
+// Contains reports whether the point is inside the bounding box.
+func (b BoundingBox) Contains(p GeoWaypoint) bool {
+	return b.South <= p.Lat && p.Lat <= b.North && b.West <= p.Long && p.Long <= b.East
+}
+
+// Center returns the center of the bounding box.
+func (b BoundingBox) Center() GeoWaypoint {
+	return GeoWaypoint{Lat: (b.North + b.South) / 2, Long: (b.East + b.West) / 2}
+}
@@ -0,0 +1,31 @@
+package routingv8_test
+
+import (
+	"testing"
+
+	"go.einride.tech/here/routingv8"
+	"gotest.tools/v3/assert"
+)
+
+func TestBoundsOf(t *testing.T) {
+	t.Parallel()
+	gothenburg := routingv8.GeoWaypoint{Lat: 57.707752, Long: 11.949767}
+	stockholm := routingv8.GeoWaypoint{Lat: 59.337492, Long: 18.063672}
+	bounds := routingv8.BoundsOf(gothenburg, stockholm)
+	assert.Equal(t, routingv8.BoundingBox{North: 59.337492, South: 57.707752, East: 18.063672, West: 11.949767}, bounds)
+	padded := bounds.Pad(1000)
+	assert.Assert(t, padded.North > bounds.North && padded.South < bounds.South)
+	assert.Assert(t, padded.East > bounds.East && padded.West < bounds.West)
+	assert.Assert(t, padded.Contains(gothenburg))
+	assert.Assert(t, !routingv8.BoundsOf(gothenburg).Contains(stockholm))
+	assert.Equal(t, routingv8.BoundingBox{}, routingv8.BoundsOf())
+}
+
+func TestBoundsOfRoute(t *testing.T) {
+	t.Parallel()
+	bounds, err := routingv8.BoundsOfRoute(&routingv8.Route{
+		Sections: []routingv8.Section{{Polyline: "BFoz5xJ67i1B1B7PzIhaxL7Y"}},
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, routingv8.BoundingBox{North: 50.10228, South: 50.09878, East: 8.69821, West: 8.68752}, bounds)
+}
@@ -14,6 +14,43 @@ func (c *CalculateMatrixRequest) QueryString() string {
 	return values.Encode()
 }
 
+// validate checks the body before it is sent.
+func (b *CalculateMatrixBody) validate() error {
+	if err := b.RegionDefinition.Validate(); err != nil {
+		return err
+	}
+	if err := b.DepartureTime.Validate(); err != nil {
+		return err
+	}
+	if b.MatrixAttributes != nil {
+		if err := b.MatrixAttributes.Validate(); err != nil {
+			return err
+		}
+	}
+	switch profile := b.Profile.String(); profile {
+	case unspecified:
+	case invalid:
+		return fmt.Errorf("invalid profile: %d", int(b.Profile))
+	default:
+		if b.RegionDefinition.Type != RegionTypeWorld {
+			return fmt.Errorf(
+				"invalid profile: %s requires a world region definition, got %s",
+				profile,
+				b.RegionDefinition.Type.String(),
+			)
+		}
+	}
+	return nil
+}
+
+// marshal returns the JSON of the body sent, with DepartureTimePreferTraffic resolved to a time-independent
+// departure for matrices with a Profile, which do not support traffic.
+func (b *CalculateMatrixBody) marshal() ([]byte, error) {
+	body := *b
+	body.DepartureTime = b.DepartureTime.resolve(b.Profile != ProfileUnspecified)
+	return json.Marshal(&body)
+}
+
 // CalculateMatrix returns a matrix of route summaries.
 // The required parameters for this resource are a region definition and a set of start and destination waypoints.
 // See https://developer.here.com/documentation/matrix-routing-api/8.6.0/dev_guide/topics/get-started/send-request.html
@@ -24,14 +61,17 @@ func (s *MatrixService) CalculateMatrix(
 ) (_ *CalculateMatrixResponse, err error) {
 	defer func() {
 		if err != nil {
-			err = fmt.Errorf("calculate matrix: %v", err)
+			err = fmt.Errorf("calculate matrix: %w", err)
 		}
 	}()
+	if err := req.Body.validate(); err != nil {
+		return nil, &invalidInputError{err: err}
+	}
 	u, err := s.URL.Parse("matrix")
 	if err != nil {
 		return nil, err
 	}
-	bytes, err := json.Marshal(req.Body)
+	bytes, err := req.Body.marshal()
 	if err != nil {
 		return nil, err
 	}
@@ -43,5 +83,21 @@ func (s *MatrixService) CalculateMatrix(
 	if err := s.Client.Do(r, &resp); err != nil {
 		return nil, err
 	}
+	if s.Client.ValidateResponses {
+		if err := resp.Matrix.Validate(); err != nil {
+			return nil, err
+		}
+	}
+	resp.RegionWarning = req.Body.RegionWarning(&resp)
 	return &resp, nil
 }
+
+// CalculateMatrixSync calculates a matrix synchronously and returns it in the response, which is faster than
+// CalculateMatrixAndWait for small matrices, e.g. up to 15 origins and 100 destinations. Larger matrices are
+// rejected by the API and have to be calculated asynchronously.
+func (s *MatrixService) CalculateMatrixSync(
+	ctx context.Context,
+	body *CalculateMatrixBody,
+) (*CalculateMatrixResponse, error) {
+	return s.CalculateMatrix(ctx, &CalculateMatrixRequest{Async: false, Body: body})
+}
@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"io"
 	"net/http"
+	"strings"
 	"testing"
 
 	"go.einride.tech/here/routingv8"
@@ -84,3 +85,200 @@ func TestMatrixService_CalculateMatrix(t *testing.T) {
 	assert.NilError(t, err)
 	assert.DeepEqual(t, &exp, got)
 }
+
+func TestMatrixService_CalculateMatrixSync(t *testing.T) {
+	t.Parallel()
+	var query string
+	var body struct {
+		Destinations     []routingv8.GeoWaypoint `json:"destinations"`
+		MatrixAttributes []string                `json:"matrixAttributes"`
+	}
+	client := routingv8.NewClient(httpClientFunc(func(req *http.Request) (*http.Response, error) {
+		query = req.URL.RawQuery
+		if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+			return nil, err
+		}
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(`{
+			"matrixId": "m1",
+			"matrix": {"numOrigins": 1, "numDestinations": 2, "travelTimes": [60, 120], "distances": [900, 1800]},
+			"regionDefinition": {"type": "world"}
+		}`))}, nil
+	}))
+	resp, err := client.Matrix.CalculateMatrixSync(context.Background(), &routingv8.CalculateMatrixBody{
+		Origins:          []*routingv8.GeoWaypoint{{Lat: 57.707752, Long: 11.949767}},
+		Destinations:     []*routingv8.GeoWaypoint{{Lat: 57.7, Long: 11.9}, {Lat: 57.8, Long: 12}},
+		RegionDefinition: routingv8.RegionDefinition{Type: routingv8.RegionTypeWorld},
+		Profile:          routingv8.ProfileTruckFast,
+		MatrixAttributes: &routingv8.MatrixAttributes{
+			routingv8.MatrixAttributeDistances,
+			routingv8.MatrixAttributeTravelTimes,
+		},
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, "async=false", query)
+	assert.Equal(t, 2, len(body.Destinations))
+	assert.DeepEqual(t, []string{"distances", "travelTimes"}, body.MatrixAttributes)
+	assert.DeepEqual(t, []int32{60, 120}, resp.Matrix.TravelTimes)
+	assert.DeepEqual(t, []int32{900, 1800}, resp.Matrix.Distances)
+}
+
+func TestMatrixService_CalculateMatrix_profile(t *testing.T) {
+	t.Parallel()
+	var profile string
+	client := routingv8.NewClient(httpClientFunc(func(req *http.Request) (*http.Response, error) {
+		var body struct {
+			Profile string `json:"profile"`
+		}
+		if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+			return nil, err
+		}
+		profile = body.Profile
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(`{"matrixId":"123"}`)),
+		}, nil
+	}))
+	for _, tt := range []struct {
+		name string
+		body routingv8.CalculateMatrixBody
+		err  string
+	}{
+		{
+			name: "world",
+			body: routingv8.CalculateMatrixBody{RegionDefinition: routingv8.WorldRegion(), Profile: routingv8.ProfileCarShort},
+		},
+		{
+			name: "without profile",
+			body: routingv8.CalculateMatrixBody{RegionDefinition: routingv8.AutoCircleRegion(0)},
+		},
+		{
+			name: "auto circle",
+			body: routingv8.CalculateMatrixBody{
+				RegionDefinition: routingv8.AutoCircleRegion(0),
+				Profile:          routingv8.ProfileTruckFast,
+			},
+			err: "invalid profile: truckFast requires a world region definition, got autoCircle",
+		},
+		{
+			name: "invalid",
+			body: routingv8.CalculateMatrixBody{RegionDefinition: routingv8.WorldRegion(), Profile: 42},
+			err:  "invalid profile: 42",
+		},
+		{
+			name: "invalid departure time",
+			body: routingv8.CalculateMatrixBody{RegionDefinition: routingv8.WorldRegion(), DepartureTime: "soon"},
+			err:  `invalid departure time: "soon"`,
+		},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			profile = ""
+			_, err := client.Matrix.CalculateMatrixSync(context.Background(), &tt.body)
+			if tt.err != "" {
+				assert.ErrorContains(t, err, tt.err)
+				assert.Assert(t, routingv8.IsInvalidInput(err))
+				return
+			}
+			assert.NilError(t, err)
+			if tt.body.Profile != routingv8.ProfileUnspecified {
+				assert.Equal(t, tt.body.Profile.String(), profile)
+			}
+		})
+	}
+}
+
+func TestMatrixService_CalculateMatrix_preferTraffic(t *testing.T) {
+	t.Parallel()
+	for _, tt := range []struct {
+		name     string
+		body     routingv8.CalculateMatrixBody
+		expected map[string]interface{}
+	}{
+		{
+			name: "profile without traffic",
+			body: routingv8.CalculateMatrixBody{
+				RegionDefinition: routingv8.WorldRegion(),
+				Profile:          routingv8.ProfileCarFast,
+				DepartureTime:    routingv8.DepartureTimePreferTraffic,
+			},
+			expected: map[string]interface{}{"departureTime": "any"},
+		},
+		{
+			name: "region with traffic",
+			body: routingv8.CalculateMatrixBody{
+				RegionDefinition: routingv8.AutoCircleRegion(0),
+				DepartureTime:    routingv8.DepartureTimePreferTraffic,
+			},
+			expected: map[string]interface{}{},
+		},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			departureTime := map[string]interface{}{}
+			client := routingv8.NewClient(httpClientFunc(func(req *http.Request) (*http.Response, error) {
+				var body map[string]interface{}
+				if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+					return nil, err
+				}
+				if value, ok := body["departureTime"]; ok {
+					departureTime["departureTime"] = value
+				}
+				return &http.Response{
+					StatusCode: http.StatusOK,
+					Body:       io.NopCloser(strings.NewReader(`{"matrixId":"123"}`)),
+				}, nil
+			}))
+			_, err := client.Matrix.CalculateMatrixSync(context.Background(), &tt.body)
+			assert.NilError(t, err)
+			assert.DeepEqual(t, tt.expected, departureTime)
+			assert.Equal(t, routingv8.DepartureTimePreferTraffic, tt.body.DepartureTime, "body is not modified")
+		})
+	}
+}
+
+func TestMatrixAttributes(t *testing.T) {
+	t.Parallel()
+	t.Run("json", func(t *testing.T) {
+		t.Parallel()
+		attributes := routingv8.MatrixAttributes{routingv8.MatrixAttributeDistances}
+		b, err := json.Marshal(&attributes)
+		assert.NilError(t, err)
+		assert.Equal(t, `["distances"]`, string(b))
+		var got routingv8.MatrixAttributes
+		assert.NilError(t, json.Unmarshal([]byte(`["travelTimes","distances"]`), &got))
+		assert.DeepEqual(
+			t,
+			routingv8.MatrixAttributes{routingv8.MatrixAttributeTravelTimes, routingv8.MatrixAttributeDistances},
+			got,
+		)
+		assert.Error(t, json.Unmarshal([]byte(`["tolls"]`), &got), `invalid matrix attribute: "tolls"`)
+	})
+	t.Run("validate", func(t *testing.T) {
+		t.Parallel()
+		assert.NilError(t, routingv8.MatrixAttributes{routingv8.MatrixAttributeTravelTimes}.Validate())
+		assert.Error(
+			t,
+			routingv8.MatrixAttributes{routingv8.MatrixAttributeUnspecified}.Validate(),
+			"invalid matrix attribute: 0",
+		)
+		assert.Error(
+			t,
+			routingv8.MatrixAttributes{routingv8.MatrixAttributeDistances, routingv8.MatrixAttributeDistances}.Validate(),
+			"invalid matrix attributes: distances repeated",
+		)
+	})
+	t.Run("request", func(t *testing.T) {
+		t.Parallel()
+		client := routingv8.NewClient(httpClientFunc(func(req *http.Request) (*http.Response, error) {
+			t.Fatal("unexpected request")
+			return nil, nil
+		}))
+		_, err := client.Matrix.CalculateMatrixSync(context.Background(), &routingv8.CalculateMatrixBody{
+			RegionDefinition: routingv8.WorldRegion(),
+			Profile:          routingv8.ProfileCarFast,
+			MatrixAttributes: &routingv8.MatrixAttributes{42},
+		})
+		assert.ErrorContains(t, err, "invalid matrix attribute: 42")
+	})
+}
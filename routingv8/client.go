@@ -8,10 +8,18 @@ import (
 	"io"
 	"net/http"
 	"net/url"
+	"sync/atomic"
+
+	"go.einride.tech/here/internal/hereapi"
+	"go.einride.tech/here/internal/useragent"
 )
 
 const (
-	userAgent = "einride/here-go"
+	userAgent = useragent.UserAgent
+	// ProjectHRNHeader is the header carrying the HERE platform project HRN of a request.
+	ProjectHRNHeader = hereapi.ProjectHRNHeader
+	// RealmHeader is the header carrying the HERE platform realm of a request.
+	RealmHeader = hereapi.RealmHeader
 )
 
 type HTTPClient interface {
@@ -30,6 +38,46 @@ type Client struct {
 
 	UserAgent string
 
+	// ProjectHRN scopes all requests to a HERE platform project,
+	// e.g. "hrn:here:authorization::myrealm:project/my-project".
+	ProjectHRN string
+
+	// Realm of the HERE platform organization, required by some enterprise deployments.
+	Realm string
+
+	// RetryPolicy for failed requests. Requests are not retried if nil.
+	RetryPolicy *RetryPolicy
+
+	// Clock used for retries, polling and request timing. Defaults to SystemClock.
+	Clock Clock
+
+	// QueryValidator is called with the path and the query parameters of every request before it is sent.
+	// A non-nil error aborts the request, which allows enforcing policies for all callers of the Client.
+	QueryValidator func(path string, query url.Values) error
+
+	// ValidateResponses enables validation of decoded responses. Inconsistent responses are returned as a
+	// *ValidationError instead of being passed on.
+	ValidateResponses bool
+
+	// CompressionThreshold is the size in bytes from which request bodies are gzip compressed, e.g.
+	// DefaultCompressionThreshold. Bodies are not compressed if zero. If the API rejects a compressed body, it is
+	// sent again uncompressed and compression is disabled for the following requests.
+	CompressionThreshold int
+
+	// compressionRejected is set to 1 when the API has rejected a compressed body.
+	compressionRejected int32
+
+	// DebugScrubber is applied to debug dumps before they are written, e.g. to redact coordinates and addresses
+	// from logs. See WithDebugDump.
+	DebugScrubber func(dump string) string
+
+	// TimingObserver is called with the timing of every attempt of a request, e.g. to record metrics of the
+	// latency of the network and of HERE. Timings are also written to debug dumps, see WithDebugDump.
+	TimingObserver func(ctx context.Context, timing RequestTiming)
+
+	// PollBackoff is the strategy for polling asynchronous calculations. Defaults to DefaultPollBackoff.
+	PollBackoff Backoff
+
 	// Matrix service.
 	Matrix  *MatrixService
 	Routing *RoutingService
@@ -41,23 +89,6 @@ type service struct {
 	Client *Client
 }
 
-// A responseError reports the error caused by an API request.
-type responseError struct {
-	// HTTP response that caused this error
-	Response *HereErrorResponse
-}
-
-func (r *responseError) Error() string {
-	return fmt.Sprintf(
-		"Title: %v, Status: %d, Code: %v, Cause: %v, Action: %v",
-		r.Response.Title,
-		r.Response.Status,
-		r.Response.Code,
-		r.Response.Cause,
-		r.Response.Action,
-	)
-}
-
 // NewClient returns a new HERE API Client. If a nil httpClient is
 // provided, a new http.Client will be used. To use API methods which require
 // authentication, provide an http.Client that will perform the authentication
@@ -89,6 +120,15 @@ func (c *Client) NewRequest(
 		u.RawQuery = rawQuery
 	}
 	var r io.Reader
+	compress := c.CompressionThreshold > 0 && len(body) >= c.CompressionThreshold &&
+		atomic.LoadInt32(&c.compressionRejected) == 0
+	if compress {
+		compressed, err := compressBody(body)
+		if err != nil {
+			return nil, err
+		}
+		body = compressed
+	}
 	if len(body) > 0 {
 		r = bytes.NewReader(body)
 	}
@@ -99,55 +139,120 @@ func (c *Client) NewRequest(
 	if body != nil {
 		req.Header.Set("Content-Type", "application/json")
 	}
+	if compress {
+		req.Header.Set("Content-Encoding", "gzip")
+	}
 	if c.UserAgent != "" {
 		req.Header.Set("User-Agent", c.UserAgent)
 	}
+	hereapi.SetPlatformHeaders(req, c.ProjectHRN, c.Realm)
 	return req, nil
 }
 
 // Do sends an API request and returns the API response. The API response is JSON decoded and stored in the value
 // pointed to by v, or returned as an error if an API error has occurred. If v implements the io.Writer interface,
 // the raw response will be written to v, without attempting to decode it.
+// Failed requests are retried according to the RetryPolicy of the Client.
 func (c *Client) Do(req *http.Request, v interface{}) error {
-	resp, err := c.client.Do(req)
+	if c.QueryValidator != nil {
+		if err := c.QueryValidator(req.URL.Path, req.URL.Query()); err != nil {
+			return fmt.Errorf("validate query: %w", err)
+		}
+	}
+	err := c.doRetry(req, v)
+	if err != nil && isCompressed(req) && isCompressionRejected(err) {
+		atomic.StoreInt32(&c.compressionRejected, 1)
+		uncompressed, derr := decompressRequest(req)
+		if derr != nil {
+			return err
+		}
+		return c.doRetry(uncompressed, v)
+	}
+	return err
+}
+
+// doRetry sends an API request, retrying it according to the RetryPolicy of the Client.
+func (c *Client) doRetry(req *http.Request, v interface{}) error {
+	return c.RetryPolicy.Do(req, c.clock(), func() (int, error) {
+		return c.do(req, v)
+	})
+}
+
+// do sends a single attempt of an API request and returns the HTTP status code of the response, or zero if no
+// response was received.
+func (c *Client) do(req *http.Request, v interface{}) (_ int, err error) {
+	dump := hereapi.DebugWriter(req.Context(), c.DebugScrubber)
+	if dump != nil {
+		hereapi.DumpRequest(dump, req)
+	}
+	var resp *http.Response
+	if c.TimingObserver != nil || dump != nil {
+		var tracer *requestTracer
+		req, tracer = traceRequest(req, c.clock())
+		defer func() {
+			c.observeTiming(req.Context(), dump, tracer.done(resp))
+		}()
+	}
+	resp, err = c.client.Do(req)
 	if err != nil {
-		return err
+		return 0, &hereapi.TransportError{Err: err}
+	}
+	if dump != nil {
+		hereapi.DumpResponse(dump, resp)
 	}
 	defer func() {
 		if rerr := resp.Body.Close(); err == nil {
 			err = rerr
 		}
 	}()
-	err = checkResponse(resp)
+	err = hereapi.CheckResponse(resp, decodeErrorResponse)
 	if err != nil {
-		return err
+		return resp.StatusCode, err
+	}
+	if receiver, ok := v.(headerReceiver); ok {
+		receiver.receiveHeader(resp.Header)
 	}
 	if v != nil {
+		body := &contextReader{ctx: req.Context(), r: resp.Body}
 		if w, ok := v.(io.Writer); ok {
-			_, err = io.Copy(w, resp.Body)
+			_, err = io.Copy(w, body)
 			if err != nil {
-				return err
+				return resp.StatusCode, err
 			}
 		} else {
-			err = json.NewDecoder(resp.Body).Decode(v)
+			err = json.NewDecoder(body).Decode(v)
 			if err != nil {
-				return err
+				return resp.StatusCode, err
 			}
 		}
 	}
-	return err
+	return resp.StatusCode, err
 }
 
-// checkResponse checks the API response for errors, and returns them if present. A response is considered an
-// error if it has a status code outside the 200 range.
-func checkResponse(r *http.Response) error {
-	if c := r.StatusCode; c >= 200 && c <= 299 {
-		return nil
-	}
+// headerReceiver is implemented by response values which need the headers of successful responses.
+type headerReceiver interface {
+	receiveHeader(header http.Header)
+}
+
+// decodeErrorResponse decodes the body of an error response into a *HereErrorResponse.
+func decodeErrorResponse(statusCode int, body io.Reader) error {
 	var response HereErrorResponse
-	err := json.NewDecoder(r.Body).Decode(&response)
-	if err != nil {
-		return err
+	if err := json.NewDecoder(body).Decode(&response); err != nil {
+		// Errors from gateways and load balancers are not necessarily JSON.
+		response = HereErrorResponse{Title: http.StatusText(statusCode), Status: statusCode}
+	}
+	return &response
+}
+
+// contextReader reads from r until the context is done, so that cancelled requests stop decoding large responses.
+type contextReader struct {
+	ctx context.Context
+	r   io.Reader
+}
+
+func (c *contextReader) Read(p []byte) (int, error) {
+	if err := c.ctx.Err(); err != nil {
+		return 0, err
 	}
-	return &responseError{Response: &response}
+	return c.r.Read(p)
 }
@@ -0,0 +1,95 @@
+package routingv8_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"go.einride.tech/here/routingv8"
+	"gotest.tools/v3/assert"
+)
+
+type httpClientFunc func(*http.Request) (*http.Response, error)
+
+func (f httpClientFunc) Do(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// cancelingReader cancels the context after the first read.
+type cancelingReader struct {
+	cancel func()
+	r      io.Reader
+}
+
+func (c *cancelingReader) Read(p []byte) (int, error) {
+	c.cancel()
+	return c.r.Read(p[:1])
+}
+
+func TestClient_Do_Canceled(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	client := routingv8.NewClient(httpClientFunc(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body: io.NopCloser(&cancelingReader{
+				cancel: cancel,
+				r:      strings.NewReader(`{"routes":[{"id":"route-1"}]}`),
+			}),
+		}, nil
+	}))
+	_, err := client.Routing.Routes(ctx, &routingv8.RoutesRequest{TransportMode: routingv8.TransportModeCar})
+	assert.Assert(t, errors.Is(err, context.Canceled), err)
+}
+
+func TestClient_QueryValidator(t *testing.T) {
+	t.Parallel()
+	errTooManyAlternatives := errors.New("too many alternatives")
+	var sent bool
+	client := routingv8.NewClient(httpClientFunc(func(req *http.Request) (*http.Response, error) {
+		sent = true
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(`{}`))}, nil
+	}))
+	var path string
+	client.QueryValidator = func(p string, query url.Values) error {
+		path = p
+		if query.Get("alternatives") != "" {
+			return errTooManyAlternatives
+		}
+		return nil
+	}
+	_, err := client.Routing.Routes(context.Background(), &routingv8.RoutesRequest{
+		TransportMode: routingv8.TransportModeTruck,
+	})
+	assert.Assert(t, errors.Is(err, errTooManyAlternatives))
+	assert.Equal(t, "/v8/routes", path)
+	assert.Assert(t, !sent)
+	_, err = client.Routing.Routes(context.Background(), &routingv8.RoutesRequest{
+		TransportMode: routingv8.TransportModeTruck,
+		Alternatives:  routingv8.NoAlternatives,
+	})
+	assert.NilError(t, err)
+	assert.Assert(t, sent)
+}
+
+func TestClient_ProjectHeaders(t *testing.T) {
+	t.Parallel()
+	var header http.Header
+	client := routingv8.NewClient(httpClientFunc(func(req *http.Request) (*http.Response, error) {
+		header = req.Header
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(`{}`))}, nil
+	}))
+	client.ProjectHRN = "hrn:here:authorization::org123:project/routing"
+	client.Realm = "org123"
+	_, err := client.Routing.Routes(context.Background(), &routingv8.RoutesRequest{
+		TransportMode: routingv8.TransportModeCar,
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, "hrn:here:authorization::org123:project/routing", header.Get(routingv8.ProjectHRNHeader))
+	assert.Equal(t, "org123", header.Get(routingv8.RealmHeader))
+}
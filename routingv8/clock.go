@@ -0,0 +1,21 @@
+package routingv8
+
+import "go.einride.tech/here/internal/hereapi"
+
+// Clock provides the current time and timers. Tests can inject a fake Clock in the Client to control time
+// deterministically instead of sleeping.
+type Clock = hereapi.Clock
+
+// Timer is a single event timer of a Clock.
+type Timer = hereapi.Timer
+
+// SystemClock is the Clock of the operating system.
+type SystemClock = hereapi.SystemClock
+
+// clock returns the Clock of the Client, SystemClock if not set.
+func (c *Client) clock() Clock {
+	if c.Clock != nil {
+		return c.Clock
+	}
+	return SystemClock{}
+}
@@ -0,0 +1,56 @@
+package routingv8
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// binaryVersion is the first byte of binary encoded values, incremented on incompatible changes of the encoding.
+// Version 1 was a gob encoding, which dropped pointers to zero values such as the Waypoint 0 of the origin.
+// Version 2 is the JSON encoding of the API, so that values round-trip as if they were decoded from a response.
+const binaryVersion byte = 2
+
+// MarshalBinary implements encoding.BinaryMarshaler, e.g. for caching routes.
+func (r *Route) MarshalBinary() ([]byte, error) {
+	type route Route
+	return marshalBinary("route", (*route)(r))
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (r *Route) UnmarshalBinary(data []byte) error {
+	type route Route
+	return unmarshalBinary("route", data, (*route)(r))
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler, e.g. for caching routes.
+func (r *RoutesResponse) MarshalBinary() ([]byte, error) {
+	type routesResponse RoutesResponse
+	return marshalBinary("routes response", (*routesResponse)(r))
+}
+
+// UnmarshalBinary implements encoding.BinaryUnmarshaler.
+func (r *RoutesResponse) UnmarshalBinary(data []byte) error {
+	type routesResponse RoutesResponse
+	return unmarshalBinary("routes response", data, (*routesResponse)(r))
+}
+
+func marshalBinary(name string, v interface{}) ([]byte, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("marshal %s: %v", name, err)
+	}
+	return append([]byte{binaryVersion}, data...), nil
+}
+
+func unmarshalBinary(name string, data []byte, v interface{}) error {
+	if len(data) == 0 {
+		return fmt.Errorf("unmarshal %s: empty data", name)
+	}
+	if data[0] != binaryVersion {
+		return fmt.Errorf("unmarshal %s: unsupported version %d", name, data[0])
+	}
+	if err := json.Unmarshal(data[1:], v); err != nil {
+		return fmt.Errorf("unmarshal %s: %v", name, err)
+	}
+	return nil
+}
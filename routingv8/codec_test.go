@@ -0,0 +1,59 @@
+package routingv8_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"go.einride.tech/here/routingv8"
+	"go.einride.tech/here/routingv8/routingv8test"
+	"gotest.tools/v3/assert"
+)
+
+func TestRoutesResponse_MarshalBinary(t *testing.T) {
+	t.Parallel()
+	var expected routingv8.RoutesResponse
+	assert.NilError(t, json.Unmarshal([]byte(routingv8test.RoutesResponseJSON), &expected))
+	data, err := expected.MarshalBinary()
+	assert.NilError(t, err)
+	var actual routingv8.RoutesResponse
+	assert.NilError(t, actual.UnmarshalBinary(data))
+	assert.DeepEqual(t, expected, actual)
+}
+
+func TestRoute_MarshalBinary(t *testing.T) {
+	t.Parallel()
+	var resp routingv8.RoutesResponse
+	assert.NilError(t, json.Unmarshal([]byte(routingv8test.EVRoutesResponseJSON), &resp))
+	expected := resp.Routes[0]
+	data, err := expected.MarshalBinary()
+	assert.NilError(t, err)
+	var actual routingv8.Route
+	assert.NilError(t, actual.UnmarshalBinary(data))
+	assert.DeepEqual(t, expected, actual)
+	assert.ErrorContains(t, actual.UnmarshalBinary(append([]byte{0}, data[1:]...)), "unsupported version 0")
+}
+
+func TestRoute_MarshalBinary_zeroValues(t *testing.T) {
+	t.Parallel()
+	origin := 0
+	expected := routingv8.Route{
+		ID: "route-1",
+		Sections: []routingv8.Section{
+			{
+				ID:        "section-1",
+				Departure: routingv8.RoutePlace{Place: routingv8.Place{Waypoint: &origin}},
+				Actions:   []routingv8.Action{},
+				Notices:   []routingv8.Notice{},
+			},
+		},
+	}
+	data, err := expected.MarshalBinary()
+	assert.NilError(t, err)
+	var actual routingv8.Route
+	assert.NilError(t, actual.UnmarshalBinary(data))
+	assert.DeepEqual(t, expected, actual)
+	assert.Assert(t, actual.Sections[0].Departure.Place.Waypoint != nil)
+	assert.Equal(t, 0, *actual.Sections[0].Departure.Place.Waypoint)
+	assert.Assert(t, actual.Sections[0].Actions != nil)
+	assert.ErrorContains(t, actual.UnmarshalBinary(append([]byte{1}, data[1:]...)), "unsupported version 1")
+}
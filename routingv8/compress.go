@@ -0,0 +1,66 @@
+package routingv8
+
+import (
+	"bytes"
+	"compress/gzip"
+	"errors"
+	"io"
+	"net/http"
+
+	"go.einride.tech/here/internal/hereapi"
+)
+
+// DefaultCompressionThreshold is a reasonable Client.CompressionThreshold, above which compressing the body saves
+// more time on the network than it costs.
+const DefaultCompressionThreshold = 64 << 10
+
+// compressBody returns the body gzip compressed.
+func compressBody(body []byte) ([]byte, error) {
+	var b bytes.Buffer
+	w := gzip.NewWriter(&b)
+	if _, err := w.Write(body); err != nil {
+		return nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return b.Bytes(), nil
+}
+
+// isCompressed reports whether the body of the request is compressed.
+func isCompressed(req *http.Request) bool {
+	return req.Header.Get("Content-Encoding") == "gzip"
+}
+
+// isCompressionRejected reports whether err is the rejection of a compressed request body.
+func isCompressionRejected(err error) bool {
+	var responseErr *hereapi.ResponseError
+	return errors.As(err, &responseErr) && responseErr.StatusCode == http.StatusUnsupportedMediaType
+}
+
+// decompressRequest returns a copy of the request with the body decompressed.
+func decompressRequest(req *http.Request) (*http.Request, error) {
+	if req.GetBody == nil {
+		return nil, errors.New("decompress request: body can not be read again")
+	}
+	compressed, err := req.GetBody()
+	if err != nil {
+		return nil, err
+	}
+	r, err := gzip.NewReader(compressed)
+	if err != nil {
+		return nil, err
+	}
+	body, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	clone := req.Clone(req.Context())
+	clone.Header.Del("Content-Encoding")
+	clone.ContentLength = int64(len(body))
+	clone.Body = io.NopCloser(bytes.NewReader(body))
+	clone.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(body)), nil
+	}
+	return clone, nil
+}
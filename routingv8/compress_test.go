@@ -0,0 +1,68 @@
+package routingv8_test
+
+import (
+	"compress/gzip"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"go.einride.tech/here/routingv8"
+	"gotest.tools/v3/assert"
+)
+
+func TestClient_CompressionThreshold(t *testing.T) {
+	t.Parallel()
+	const response = `{"matrix":{"numOrigins":1,"numDestinations":1},"regionDefinition":{"type":"world"}}`
+	var encodings []string
+	var bodies []routingv8.CalculateMatrixBody
+	client := routingv8.NewClient(httpClientFunc(func(req *http.Request) (*http.Response, error) {
+		encodings = append(encodings, req.Header.Get("Content-Encoding"))
+		var body io.Reader = req.Body
+		if req.Header.Get("Content-Encoding") == "gzip" {
+			if len(encodings) == 2 {
+				return &http.Response{
+					StatusCode: http.StatusUnsupportedMediaType,
+					Body:       io.NopCloser(strings.NewReader(`{"title":"Unsupported Media Type","status":415}`)),
+				}, nil
+			}
+			r, err := gzip.NewReader(req.Body)
+			if err != nil {
+				return nil, err
+			}
+			body = r
+		}
+		var decoded routingv8.CalculateMatrixBody
+		if err := json.NewDecoder(body).Decode(&decoded); err != nil {
+			return nil, err
+		}
+		bodies = append(bodies, decoded)
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(response))}, nil
+	}))
+	client.CompressionThreshold = 300
+	calculate := func(n int) {
+		origins := make([]*routingv8.GeoWaypoint, n)
+		for i := range origins {
+			origins[i] = &routingv8.GeoWaypoint{Lat: 57.707752, Long: 11.949767}
+		}
+		_, err := client.Matrix.CalculateMatrix(context.Background(), &routingv8.CalculateMatrixRequest{
+			Body: &routingv8.CalculateMatrixBody{
+				Origins:          origins,
+				RegionDefinition: routingv8.RegionDefinition{Type: routingv8.RegionTypeWorld},
+				Profile:          routingv8.ProfileTruckFast,
+			},
+		})
+		assert.NilError(t, err)
+	}
+	calculate(1)
+	calculate(10)
+	calculate(10)
+	calculate(10)
+	assert.DeepEqual(t, []string{"", "gzip", "", "", ""}, encodings)
+	assert.Equal(t, 4, len(bodies))
+	for i, n := range []int{1, 10, 10, 10} {
+		assert.Equal(t, n, len(bodies[i].Origins))
+	}
+}
@@ -0,0 +1,110 @@
+package routingv8
+
+import (
+	"fmt"
+	"math"
+
+	"go.einride.tech/here/geo"
+)
+
+// DefaultCorridorWidth is the distance in meters from a reference route within which a route is considered to
+// follow it, tolerating differences of the polylines of the same roads.
+const DefaultCorridorWidth = 25
+
+// metersPerDegreeLatitude is used to skip segments far away from a point without computing distances.
+const metersPerDegreeLatitude = 111320
+
+// CorridorDeviation describes how much a route deviates from the corridor around a reference route.
+type CorridorDeviation struct {
+	// Shared is the share of the length of the route, between 0 and 1, inside the corridor.
+	Shared float64
+	// DeviatingLength is the length in meters of the route outside the corridor.
+	DeviatingLength float64
+	// MaxDistance is the largest distance in meters of a point of the route from the reference route.
+	MaxDistance float64
+}
+
+// Deviation returns the share of the length of the route, between 0 and 1, outside the corridor.
+func (c *CorridorDeviation) Deviation() float64 {
+	return 1 - c.Shared
+}
+
+// ScoreCorridorDeviation scores how much route deviates from the corridor of the given width in meters around the
+// reference route. Routes have to be requested with the polyline return attribute.
+func ScoreCorridorDeviation(reference, route *Route, width float64) (*CorridorDeviation, error) {
+	referencePoints, err := routeGeometry(reference)
+	if err != nil {
+		return nil, fmt.Errorf("score corridor deviation: reference: %v", err)
+	}
+	points, err := routeGeometry(route)
+	if err != nil {
+		return nil, fmt.Errorf("score corridor deviation: %v", err)
+	}
+	return corridorDeviation(referencePoints, points, width), nil
+}
+
+func corridorDeviation(reference, points []GeoWaypoint, width float64) *CorridorDeviation {
+	var result CorridorDeviation
+	inside := make([]bool, len(points))
+	for i, p := range points {
+		d := distanceToPolyline(p, reference, width)
+		inside[i] = d <= width
+		if d > result.MaxDistance {
+			result.MaxDistance = d
+		}
+	}
+	var total float64
+	for i := 1; i < len(points); i++ {
+		d := distance(points[i-1], points[i])
+		total += d
+		if !inside[i-1] || !inside[i] {
+			result.DeviatingLength += d
+		}
+	}
+	if total > 0 {
+		result.Shared = (total - result.DeviatingLength) / total
+	} else if len(points) > 0 && inside[0] {
+		result.Shared = 1
+	}
+	return &result
+}
+
+// distanceToPolyline returns the distance in meters from p to the closest segment of the polyline. Once a segment
+// within the cutoff is found, segments further away than the cutoff in latitude alone are skipped.
+func distanceToPolyline(p GeoWaypoint, polyline []GeoWaypoint, cutoff float64) float64 {
+	if len(polyline) == 1 {
+		return distance(p, polyline[0])
+	}
+	cutoffDegrees := cutoff / metersPerDegreeLatitude
+	min := math.Inf(1)
+	for i := 1; i < len(polyline); i++ {
+		start, end := polyline[i-1], polyline[i]
+		if min <= cutoff &&
+			(p.Lat < math.Min(start.Lat, end.Lat)-cutoffDegrees || p.Lat > math.Max(start.Lat, end.Lat)+cutoffDegrees) {
+			continue
+		}
+		if d := geo.DistanceToSegment(p.point(), start.point(), end.point()); d < min {
+			min = d
+		}
+	}
+	return min
+}
+
+// MostFamiliarRoute returns the index of the route deviating the least from the corridor of the given width around
+// the reference route, e.g. to prefer the route a driver usually takes among the alternatives of a response.
+func MostFamiliarRoute(reference *Route, routes []Route, width float64) (int, error) {
+	if len(routes) == 0 {
+		return 0, fmt.Errorf("most familiar route: no routes")
+	}
+	best, bestShared := 0, -1.0
+	for i := range routes {
+		deviation, err := ScoreCorridorDeviation(reference, &routes[i], width)
+		if err != nil {
+			return 0, fmt.Errorf("most familiar route: route %s: %v", routes[i].ID, err)
+		}
+		if deviation.Shared > bestShared {
+			best, bestShared = i, deviation.Shared
+		}
+	}
+	return best, nil
+}
@@ -0,0 +1,42 @@
+package routingv8_test
+
+import (
+	"testing"
+
+	"go.einride.tech/here/routingv8"
+	"gotest.tools/v3/assert"
+)
+
+func TestScoreCorridorDeviation(t *testing.T) {
+	t.Parallel()
+	p := func(lat, lng float64) routingv8.GeoWaypoint {
+		return routingv8.GeoWaypoint{Lat: lat, Long: lng}
+	}
+	route := func(points ...routingv8.GeoWaypoint) routingv8.Route {
+		return routingv8.Route{Sections: []routingv8.Section{{Polyline: encodePolyline(t, points...)}}}
+	}
+	reference := route(p(57.70, 11.9), p(57.72, 11.9), p(57.74, 11.9))
+	// Follows the reference with a lateral offset of about 6 meters.
+	parallel := route(p(57.70, 11.9001), p(57.71, 11.9001), p(57.74, 11.9001))
+	// Leaves the reference halfway.
+	detour := route(p(57.70, 11.9), p(57.72, 11.9), p(57.73, 11.95), p(57.74, 11.9))
+
+	deviation, err := routingv8.ScoreCorridorDeviation(&reference, &parallel, routingv8.DefaultCorridorWidth)
+	assert.NilError(t, err)
+	assert.Equal(t, 1.0, deviation.Shared)
+	assert.Equal(t, 0.0, deviation.DeviatingLength)
+	assert.Assert(t, deviation.MaxDistance > 5 && deviation.MaxDistance < 7, deviation.MaxDistance)
+
+	deviation, err = routingv8.ScoreCorridorDeviation(&reference, &detour, routingv8.DefaultCorridorWidth)
+	assert.NilError(t, err)
+	assert.Assert(t, deviation.Shared > 0.2 && deviation.Shared < 0.3, deviation.Shared)
+	assert.Assert(t, deviation.Deviation() > 0.5)
+	assert.Assert(t, deviation.MaxDistance > 2900, deviation.MaxDistance)
+
+	routes := []routingv8.Route{detour, parallel}
+	best, err := routingv8.MostFamiliarRoute(&reference, routes, routingv8.DefaultCorridorWidth)
+	assert.NilError(t, err)
+	assert.Equal(t, 1, best)
+	_, err = routingv8.MostFamiliarRoute(&reference, nil, routingv8.DefaultCorridorWidth)
+	assert.Error(t, err, "most familiar route: no routes")
+}
@@ -0,0 +1,119 @@
+package routingv8
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// CredentialsProblem is the cause of a failed credential validation.
+type CredentialsProblem int
+
+const (
+	CredentialsProblemUnspecified CredentialsProblem = iota
+	// CredentialsProblemInvalid means that the API key or token was rejected.
+	CredentialsProblemInvalid
+	// CredentialsProblemExpiredToken means that the OAuth token has expired.
+	CredentialsProblemExpiredToken
+	// CredentialsProblemNotEntitled means that the credentials are valid but lack access to the endpoint.
+	CredentialsProblemNotEntitled
+	// CredentialsProblemNetwork means that the endpoint could not be reached.
+	CredentialsProblemNetwork
+)
+
+func (p CredentialsProblem) String() string {
+	switch p {
+	case CredentialsProblemUnspecified:
+		return "unspecified"
+	case CredentialsProblemInvalid:
+		return "invalid credentials"
+	case CredentialsProblemExpiredToken:
+		return "expired token"
+	case CredentialsProblemNotEntitled:
+		return "not entitled"
+	case CredentialsProblemNetwork:
+		return "network failure"
+	}
+	return "invalid"
+}
+
+// CredentialsError is returned by ValidateCredentials.
+type CredentialsError struct {
+	// Problem found.
+	Problem CredentialsProblem
+	// Endpoint that failed, e.g. "routes" or "matrix".
+	Endpoint string
+	// StatusCode of the response, zero if no response was received.
+	StatusCode int
+	// Err is the underlying error.
+	Err error
+}
+
+func (e *CredentialsError) Error() string {
+	var action string
+	switch e.Problem {
+	case CredentialsProblemInvalid:
+		action = "check the configured API key or OAuth credentials"
+	case CredentialsProblemExpiredToken:
+		action = "refresh the OAuth token"
+	case CredentialsProblemNotEntitled:
+		action = "enable the service for the project or app in the HERE platform"
+	case CredentialsProblemNetwork:
+		action = "check network access to the HERE API, including proxies and firewalls"
+	default:
+		action = "see the underlying error"
+	}
+	return fmt.Sprintf("validate credentials for %s: %v: %s: %v", e.Endpoint, e.Problem, action, e.Err)
+}
+
+// Unwrap returns the underlying error.
+func (e *CredentialsError) Unwrap() error {
+	return e.Err
+}
+
+// ValidateCredentials verifies that the credentials of the client are valid and entitled to the routing and
+// matrix endpoints, so that deployments can fail fast at startup. The returned error is a *CredentialsError.
+func (c *Client) ValidateCredentials(ctx context.Context) error {
+	endpoints := []struct {
+		name string
+		url  *url.URL
+	}{
+		{name: "routes", url: c.Routing.URL.ResolveReference(&url.URL{Path: "routes"})},
+		{name: "matrix", url: c.Matrix.URL.ResolveReference(&url.URL{Path: "matrix/00000000-0000-0000-0000-000000000000"})},
+	}
+	for _, endpoint := range endpoints {
+		result, err := c.probe(ctx, endpoint.url)
+		if err == nil {
+			continue
+		}
+		credentialsErr := &CredentialsError{Endpoint: endpoint.name, Err: err}
+		if result != nil {
+			credentialsErr.StatusCode = result.StatusCode
+		}
+		credentialsErr.Problem = credentialsProblem(credentialsErr.StatusCode, err)
+		return credentialsErr
+	}
+	return nil
+}
+
+func credentialsProblem(statusCode int, err error) CredentialsProblem {
+	switch statusCode {
+	case 0:
+		return CredentialsProblemNetwork
+	case http.StatusUnauthorized:
+		var response *HereErrorResponse
+		if errors.As(err, &response) {
+			description := strings.ToLower(response.ErrorDescription + " " + response.Cause)
+			if strings.Contains(description, "expired") {
+				return CredentialsProblemExpiredToken
+			}
+		}
+		return CredentialsProblemInvalid
+	case http.StatusForbidden:
+		return CredentialsProblemNotEntitled
+	}
+	return CredentialsProblemUnspecified
+}
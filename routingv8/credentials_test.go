@@ -0,0 +1,78 @@
+package routingv8_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"go.einride.tech/here/routingv8"
+	"gotest.tools/v3/assert"
+)
+
+func TestClient_ValidateCredentials(t *testing.T) {
+	t.Parallel()
+	for _, tt := range []struct {
+		name     string
+		matrix   int
+		body     string
+		err      error
+		problem  routingv8.CredentialsProblem
+		endpoint string
+	}{
+		{name: "valid", matrix: http.StatusNotFound},
+		{
+			name:     "invalid key",
+			matrix:   http.StatusUnauthorized,
+			body:     `{"error":"Unauthorized","error_description":"apiKey invalid. apiKey not found."}`,
+			problem:  routingv8.CredentialsProblemInvalid,
+			endpoint: "matrix",
+		},
+		{
+			name:     "expired token",
+			matrix:   http.StatusUnauthorized,
+			body:     `{"error":"Unauthorized","error_description":"Token Validation Failure - Token is expired"}`,
+			problem:  routingv8.CredentialsProblemExpiredToken,
+			endpoint: "matrix",
+		},
+		{
+			name:     "not entitled",
+			matrix:   http.StatusForbidden,
+			body:     `{"error":"Forbidden","error_description":"These credentials do not authorize access"}`,
+			problem:  routingv8.CredentialsProblemNotEntitled,
+			endpoint: "matrix",
+		},
+		{
+			name:     "network",
+			err:      errors.New("no such host"),
+			problem:  routingv8.CredentialsProblemNetwork,
+			endpoint: "routes",
+		},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			client := routingv8.NewClient(httpClientFunc(func(req *http.Request) (*http.Response, error) {
+				if tt.err != nil {
+					return nil, tt.err
+				}
+				statusCode, body := http.StatusBadRequest, `{"title":"Malformed request","status":400}`
+				if req.URL.Host == "matrix.router.hereapi.com" {
+					statusCode, body = tt.matrix, tt.body
+				}
+				return &http.Response{StatusCode: statusCode, Body: io.NopCloser(strings.NewReader(body))}, nil
+			}))
+			err := client.ValidateCredentials(context.Background())
+			if tt.problem == routingv8.CredentialsProblemUnspecified {
+				assert.NilError(t, err)
+				return
+			}
+			var credentialsErr *routingv8.CredentialsError
+			assert.Assert(t, errors.As(err, &credentialsErr))
+			assert.Equal(t, tt.problem, credentialsErr.Problem)
+			assert.Equal(t, tt.endpoint, credentialsErr.Endpoint)
+		})
+	}
+}
@@ -0,0 +1,90 @@
+package routingv8
+
+import "time"
+
+const (
+	// SectionModeFerry is the transport mode of ferry sections.
+	SectionModeFerry = "ferry"
+	// SectionModeCarShuttleTrain is the transport mode of car shuttle train sections.
+	SectionModeCarShuttleTrain = "carShuttleTrain"
+)
+
+// IsFerry reports whether the section is a ferry crossing.
+func (s *Section) IsFerry() bool {
+	return s.Type == SectionModeFerry || s.Transport.Mode == SectionModeFerry
+}
+
+// IsCarShuttleTrain reports whether the section is on a car shuttle train.
+func (s *Section) IsCarShuttleTrain() bool {
+	return s.Type == SectionModeCarShuttleTrain || s.Transport.Mode == SectionModeCarShuttleTrain
+}
+
+// Crossing is a section of a route on which the vehicle is carried by a ferry or car shuttle train.
+type Crossing struct {
+	// SectionID of the crossing.
+	SectionID string
+	// Mode is SectionModeFerry or SectionModeCarShuttleTrain.
+	Mode string
+	// Name of the line, if known.
+	Name string
+	// Operator of the crossing, if known.
+	Operator string
+	// Departure and Arrival of the crossing.
+	Departure, Arrival RoutePlace
+	// Duration of the crossing, including waiting for the departure.
+	Duration time.Duration
+	// Scheduled is true if the crossing has a known operator, whose timetable the duration is based on.
+	// Durations of unscheduled crossings are estimates and may be unreliable.
+	Scheduled bool
+}
+
+// UsesFerry reports whether any section of the route is a ferry crossing.
+func (r *Route) UsesFerry() bool {
+	for i := range r.Sections {
+		if r.Sections[i].IsFerry() {
+			return true
+		}
+	}
+	return false
+}
+
+// UsesCarShuttleTrain reports whether any section of the route is on a car shuttle train.
+func (r *Route) UsesCarShuttleTrain() bool {
+	for i := range r.Sections {
+		if r.Sections[i].IsCarShuttleTrain() {
+			return true
+		}
+	}
+	return false
+}
+
+// Crossings returns the ferry and car shuttle train sections of the route.
+func (r *Route) Crossings() []Crossing {
+	var result []Crossing
+	for i := range r.Sections {
+		section := &r.Sections[i]
+		var mode string
+		switch {
+		case section.IsFerry():
+			mode = SectionModeFerry
+		case section.IsCarShuttleTrain():
+			mode = SectionModeCarShuttleTrain
+		default:
+			continue
+		}
+		crossing := Crossing{
+			SectionID: section.ID,
+			Mode:      mode,
+			Name:      section.Transport.Name,
+			Departure: section.Departure,
+			Arrival:   section.Arrival,
+			Duration:  time.Duration(section.Summary.Duration) * time.Second,
+		}
+		if section.Agency != nil && section.Agency.Name != "" {
+			crossing.Operator = section.Agency.Name
+			crossing.Scheduled = true
+		}
+		result = append(result, crossing)
+	}
+	return result
+}
@@ -0,0 +1,39 @@
+package routingv8_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"go.einride.tech/here/routingv8"
+	"gotest.tools/v3/assert"
+)
+
+func TestRoute_Crossings(t *testing.T) {
+	t.Parallel()
+	var route routingv8.Route
+	assert.NilError(t, json.Unmarshal([]byte(`{
+  "sections": [
+    {"id": "drive", "type": "vehicle", "transport": {"mode": "car"}},
+    {
+      "id": "ferry",
+      "type": "transit",
+      "transport": {"mode": "ferry", "name": "Göteborg - Frederikshavn"},
+      "agency": {"id": "stena", "name": "Stena Line"},
+      "summary": {"duration": 12600}
+    },
+    {"id": "shuttle", "type": "transit", "transport": {"mode": "carShuttleTrain"}, "summary": {"duration": 2100}}
+  ]
+}`), &route))
+	assert.Assert(t, route.UsesFerry())
+	assert.Assert(t, route.UsesCarShuttleTrain())
+	crossings := route.Crossings()
+	assert.Equal(t, 2, len(crossings))
+	assert.Equal(t, "Stena Line", crossings[0].Operator)
+	assert.Equal(t, "Göteborg - Frederikshavn", crossings[0].Name)
+	assert.Equal(t, 210*time.Minute, crossings[0].Duration)
+	assert.Assert(t, crossings[0].Scheduled)
+	assert.Equal(t, routingv8.SectionModeCarShuttleTrain, crossings[1].Mode)
+	assert.Assert(t, !crossings[1].Scheduled)
+	assert.Assert(t, !(&routingv8.Route{Sections: route.Sections[:1]}).UsesFerry())
+}
@@ -0,0 +1,154 @@
+package routingv8
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// LabeledWaypoint is a waypoint with an optional label, e.g. a customer or depot ID.
+type LabeledWaypoint struct {
+	Label    string
+	Waypoint GeoWaypoint
+}
+
+// MatrixCSV holds the origins and destinations of a matrix read from CSV.
+type MatrixCSV struct {
+	Origins      []LabeledWaypoint
+	Destinations []LabeledWaypoint
+}
+
+// ReadMatrixCSV reads origins and destinations from CSV with the columns latitude, longitude and an optional label.
+// A header row is skipped. Coordinates are validated and errors name the offending line.
+func ReadMatrixCSV(origins, destinations io.Reader) (*MatrixCSV, error) {
+	var m MatrixCSV
+	var err error
+	if m.Origins, err = ReadWaypointsCSV(origins); err != nil {
+		return nil, fmt.Errorf("read origins: %v", err)
+	}
+	if m.Destinations, err = ReadWaypointsCSV(destinations); err != nil {
+		return nil, fmt.Errorf("read destinations: %v", err)
+	}
+	return &m, nil
+}
+
+// ReadWaypointsCSV reads waypoints from CSV with the columns latitude, longitude and an optional label.
+// A header row is skipped.
+func ReadWaypointsCSV(r io.Reader) ([]LabeledWaypoint, error) {
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	var result []LabeledWaypoint
+	for line := 1; ; line++ {
+		record, err := reader.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if line == 1 && isWaypointsCSVHeader(record) {
+			continue
+		}
+		waypoint, err := parseWaypointRecord(record)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %v", line, err)
+		}
+		result = append(result, waypoint)
+	}
+	if len(result) == 0 {
+		return nil, fmt.Errorf("no waypoints")
+	}
+	return result, nil
+}
+
+func isWaypointsCSVHeader(record []string) bool {
+	if len(record) == 0 {
+		return false
+	}
+	_, err := strconv.ParseFloat(strings.TrimSpace(record[0]), 64)
+	return err != nil
+}
+
+func parseWaypointRecord(record []string) (LabeledWaypoint, error) {
+	if len(record) < 2 || len(record) > 3 {
+		return LabeledWaypoint{}, fmt.Errorf("expected 2 or 3 columns, got %d", len(record))
+	}
+	lat, err := strconv.ParseFloat(strings.TrimSpace(record[0]), 64)
+	if err != nil || lat < -90 || lat > 90 {
+		return LabeledWaypoint{}, fmt.Errorf("invalid latitude: %q", record[0])
+	}
+	lng, err := strconv.ParseFloat(strings.TrimSpace(record[1]), 64)
+	if err != nil || lng < -180 || lng > 180 {
+		return LabeledWaypoint{}, fmt.Errorf("invalid longitude: %q", record[1])
+	}
+	waypoint := LabeledWaypoint{Waypoint: GeoWaypoint{Lat: lat, Long: lng}}
+	if len(record) == 3 {
+		waypoint.Label = strings.TrimSpace(record[2])
+	}
+	return waypoint, nil
+}
+
+// Body returns a matrix request body with the origins and destinations, and the other fields copied from template.
+func (m *MatrixCSV) Body(template CalculateMatrixBody) *CalculateMatrixBody {
+	body := template
+	body.Origins = make([]*GeoWaypoint, 0, len(m.Origins))
+	for i := range m.Origins {
+		waypoint := m.Origins[i].Waypoint
+		body.Origins = append(body.Origins, &waypoint)
+	}
+	body.Destinations = make([]*GeoWaypoint, 0, len(m.Destinations))
+	for i := range m.Destinations {
+		waypoint := m.Destinations[i].Waypoint
+		body.Destinations = append(body.Destinations, &waypoint)
+	}
+	return &body
+}
+
+// WriteResults writes the matrix as CSV with one row per origin and destination pair, with the columns origin,
+// destination, travel time in seconds, distance in meters and error code. Origins and destinations are written as
+// their labels, or as their indices if they have none. Attributes missing from the matrix are left empty.
+func (m *MatrixCSV) WriteResults(w io.Writer, matrix *MatrixResponse) error {
+	if matrix.NumOrigins != len(m.Origins) || matrix.NumDestinations != len(m.Destinations) {
+		return fmt.Errorf(
+			"write results: matrix is %dx%d, expected %dx%d",
+			matrix.NumOrigins, matrix.NumDestinations, len(m.Origins), len(m.Destinations),
+		)
+	}
+	writer := csv.NewWriter(w)
+	if err := writer.Write([]string{"origin", "destination", "travel_time", "distance", "error_code"}); err != nil {
+		return fmt.Errorf("write results: %v", err)
+	}
+	for i, origin := range m.Origins {
+		for j, destination := range m.Destinations {
+			k := i*matrix.NumDestinations + j
+			record := []string{csvLabel(origin, i), csvLabel(destination, j), "", "", strconv.Itoa(ErrorCodeSuccess)}
+			if k < len(matrix.TravelTimes) {
+				record[2] = strconv.Itoa(int(matrix.TravelTimes[k]))
+			}
+			if k < len(matrix.Distances) {
+				record[3] = strconv.Itoa(int(matrix.Distances[k]))
+			}
+			if k < len(matrix.ErrorCodes) {
+				record[4] = strconv.Itoa(int(matrix.ErrorCodes[k]))
+			}
+			if err := writer.Write(record); err != nil {
+				return fmt.Errorf("write results: %v", err)
+			}
+		}
+	}
+	writer.Flush()
+	if err := writer.Error(); err != nil {
+		return fmt.Errorf("write results: %v", err)
+	}
+	return nil
+}
+
+func csvLabel(waypoint LabeledWaypoint, index int) string {
+	if waypoint.Label != "" {
+		return waypoint.Label
+	}
+	return strconv.Itoa(index)
+}
@@ -0,0 +1,41 @@
+package routingv8_test
+
+import (
+	"strings"
+	"testing"
+
+	"go.einride.tech/here/routingv8"
+	"gotest.tools/v3/assert"
+)
+
+func TestMatrixCSV(t *testing.T) {
+	t.Parallel()
+	m, err := routingv8.ReadMatrixCSV(
+		strings.NewReader("lat,lng,label\n57.707752,11.949767,gothenburg\n59.337492,18.063672,stockholm\n"),
+		strings.NewReader("55.604981,13.003822\n"),
+	)
+	assert.NilError(t, err)
+	body := m.Body(routingv8.CalculateMatrixBody{TransportMode: routingv8.TransportModeTruck})
+	assert.Equal(t, 2, len(body.Origins))
+	assert.Equal(t, 59.337492, body.Origins[1].Lat)
+	assert.Equal(t, 13.003822, body.Destinations[0].Long)
+	assert.Equal(t, routingv8.TransportModeTruck, body.TransportMode)
+	var b strings.Builder
+	assert.NilError(t, m.WriteResults(&b, &routingv8.MatrixResponse{
+		NumOrigins:      2,
+		NumDestinations: 1,
+		TravelTimes:     []int32{10800, 21600},
+		ErrorCodes:      routingv8.ErrorCodes{routingv8.ErrorCodeSuccess, routingv8.ErrorCodeDisconnected},
+	}))
+	assert.Equal(
+		t,
+		"origin,destination,travel_time,distance,error_code\ngothenburg,0,10800,,0\nstockholm,0,21600,,1\n",
+		b.String(),
+	)
+}
+
+func TestReadWaypointsCSV_Invalid(t *testing.T) {
+	t.Parallel()
+	_, err := routingv8.ReadWaypointsCSV(strings.NewReader("57.7,11.9\n97.7,11.9\n"))
+	assert.Error(t, err, `line 2: invalid latitude: "97.7"`)
+}
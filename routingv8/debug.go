@@ -0,0 +1,15 @@
+package routingv8
+
+import (
+	"context"
+	"io"
+
+	"go.einride.tech/here/internal/hereapi"
+)
+
+// WithDebugDump returns a context which dumps the requests sent with it, and their responses, to w.
+// Credentials are redacted from the dump. Dumping is enabled per call to avoid logging all traffic of a client.
+// The dumps include the requests of the clients of the other services of the library.
+func WithDebugDump(ctx context.Context, w io.Writer) context.Context {
+	return hereapi.WithDebugDump(ctx, w)
+}
@@ -0,0 +1,65 @@
+package routingv8_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"go.einride.tech/here/routingv8"
+	"gotest.tools/v3/assert"
+)
+
+func TestWithDebugDump(t *testing.T) {
+	t.Parallel()
+	client := routingv8.NewClient(httpClientFunc(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			ProtoMajor: 1,
+			ProtoMinor: 1,
+			Header:     http.Header{"Content-Type": []string{"application/json"}},
+			Body:       io.NopCloser(strings.NewReader(`{"routes":[{"id":"route"}]}`)),
+		}, nil
+	}))
+	var dump bytes.Buffer
+	ctx := routingv8.WithDebugDump(context.Background(), &dump)
+	u := *client.Routing.URL
+	u.Path += "routes"
+	req, err := client.NewRequest(ctx, &u, http.MethodGet, "apiKey=secret&transportMode=car", nil)
+	assert.NilError(t, err)
+	req.Header.Set("Authorization", "Bearer secret")
+	var resp routingv8.RoutesResponse
+	assert.NilError(t, client.Do(req, &resp))
+	assert.Equal(t, "route", resp.Routes[0].ID)
+	assert.Assert(t, !strings.Contains(dump.String(), "secret"))
+	assert.Assert(t, strings.Contains(dump.String(), "GET /v8/routes?apiKey=REDACTED&transportMode=car HTTP/1.1"))
+	assert.Assert(t, strings.Contains(dump.String(), "Authorization: REDACTED"))
+	assert.Assert(t, strings.Contains(dump.String(), `{"routes":[{"id":"route"}]}`))
+}
+
+func TestClient_DebugScrubber(t *testing.T) {
+	t.Parallel()
+	client := routingv8.NewClient(httpClientFunc(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			ProtoMajor: 1,
+			ProtoMinor: 1,
+			Body:       io.NopCloser(strings.NewReader(`{"routes":[]}`)),
+		}, nil
+	}))
+	client.DebugScrubber = func(dump string) string {
+		return strings.ReplaceAll(dump, "57.707752%2C11.949767", "ORIGIN")
+	}
+	var dump bytes.Buffer
+	ctx := routingv8.WithDebugDump(context.Background(), &dump)
+	_, err := client.Routing.Routes(ctx, &routingv8.RoutesRequest{
+		Origin:        routingv8.GeoWaypoint{Lat: 57.707752, Long: 11.949767},
+		Destination:   routingv8.GeoWaypoint{Lat: 59.337492, Long: 18.063672},
+		TransportMode: routingv8.TransportModeCar,
+	})
+	assert.NilError(t, err)
+	assert.Assert(t, !strings.Contains(dump.String(), "57.707752"))
+	assert.Assert(t, strings.Contains(dump.String(), "origin=ORIGIN"))
+}
@@ -0,0 +1,104 @@
+package routingv8
+
+import (
+	"fmt"
+	"time"
+)
+
+// DepartureTime of routes and matrix calculations. The departure time decides which traffic is applied to the
+// routes:
+//
+//   - DepartureTimeNow, the zero value, departs when the request is received and applies live traffic.
+//   - DepartAt departs at a specific time and applies the traffic predicted for it.
+//   - DepartureTimeAny calculates routes without time-dependent restrictions and traffic.
+//   - DepartureTimePreferTraffic applies live traffic if the request supports it, and is time-independent
+//     otherwise.
+type DepartureTime string
+
+const (
+	// DepartureTimeNow departs when the request is received, with live traffic. The departure time is not sent.
+	DepartureTimeNow DepartureTime = ""
+	// DepartureTimeAny enforces non time-aware routing.
+	DepartureTimeAny DepartureTime = "any"
+	// DepartureTimePreferTraffic departs now with live traffic when the request supports traffic, and falls back
+	// to DepartureTimeAny when it does not, e.g. for matrices calculated with a Profile, which require a
+	// time-independent departure. It is resolved by the client and never sent as is.
+	DepartureTimePreferTraffic DepartureTime = "preferTraffic"
+)
+
+// DepartAt returns the departure time t, with the predicted traffic of t. The zero time departs now.
+func DepartAt(t time.Time) DepartureTime {
+	if t.IsZero() {
+		return DepartureTimeNow
+	}
+	return DepartureTime(t.Format(time.RFC3339))
+}
+
+// IsNow returns true if the departure is when the request is received.
+func (d DepartureTime) IsNow() bool {
+	return d == DepartureTimeNow
+}
+
+// IsAny returns true if the departure is time-independent.
+func (d DepartureTime) IsAny() bool {
+	return d == DepartureTimeAny
+}
+
+// IsPreferTraffic returns true if the departure is now with traffic where supported, and time-independent
+// otherwise.
+func (d DepartureTime) IsPreferTraffic() bool {
+	return d == DepartureTimePreferTraffic
+}
+
+// resolve returns the departure time sent for a request, which supports traffic unless timeIndependent.
+func (d DepartureTime) resolve(timeIndependent bool) DepartureTime {
+	if !d.IsPreferTraffic() {
+		return d
+	}
+	if timeIndependent {
+		return DepartureTimeAny
+	}
+	return DepartureTimeNow
+}
+
+// Time returns the time of a departure at a specific time, and false for DepartureTimeNow, DepartureTimeAny,
+// DepartureTimePreferTraffic and invalid departure times.
+func (d DepartureTime) Time() (time.Time, bool) {
+	if d.IsNow() || d.IsAny() || d.IsPreferTraffic() {
+		return time.Time{}, false
+	}
+	t, err := time.Parse(time.RFC3339, string(d))
+	if err != nil {
+		return time.Time{}, false
+	}
+	return t, true
+}
+
+// Validate returns an error if the departure time is neither now, any, prefer traffic nor an RFC 3339 timestamp.
+func (d DepartureTime) Validate() error {
+	if d.IsNow() || d.IsAny() || d.IsPreferTraffic() {
+		return nil
+	}
+	if _, err := time.Parse(time.RFC3339, string(d)); err != nil {
+		return fmt.Errorf("invalid departure time: %q", string(d))
+	}
+	return nil
+}
+
+// MarshalText implements encoding.TextMarshaler and fails for invalid departure times.
+func (d DepartureTime) MarshalText() ([]byte, error) {
+	if err := d.Validate(); err != nil {
+		return nil, err
+	}
+	return []byte(d), nil
+}
+
+// UnmarshalText implements encoding.TextUnmarshaler and fails for invalid departure times.
+func (d *DepartureTime) UnmarshalText(b []byte) error {
+	value := DepartureTime(b)
+	if err := value.Validate(); err != nil {
+		return err
+	}
+	*d = value
+	return nil
+}
@@ -0,0 +1,65 @@
+package routingv8_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"go.einride.tech/here/routingv8"
+	"gotest.tools/v3/assert"
+)
+
+func TestDepartureTime(t *testing.T) {
+	t.Parallel()
+	t.Run("marshal", func(t *testing.T) {
+		t.Parallel()
+		at := time.Date(2022, 6, 1, 10, 0, 0, 0, time.UTC)
+		for _, tt := range []struct {
+			departure routingv8.DepartureTime
+			expected  string
+		}{
+			{departure: routingv8.DepartureTimeNow, expected: `{"regionDefinition":{"type":"world"}}`},
+			{departure: routingv8.DepartureTimeAny, expected: `{"departureTime":"any","regionDefinition":{"type":"world"}}`},
+			{
+				departure: routingv8.DepartAt(at),
+				expected:  `{"departureTime":"2022-06-01T10:00:00Z","regionDefinition":{"type":"world"}}`,
+			},
+		} {
+			b, err := json.Marshal(struct {
+				DepartureTime    routingv8.DepartureTime    `json:"departureTime,omitempty"`
+				RegionDefinition routingv8.RegionDefinition `json:"regionDefinition"`
+			}{DepartureTime: tt.departure, RegionDefinition: routingv8.WorldRegion()})
+			assert.NilError(t, err)
+			assert.Equal(t, tt.expected, string(b))
+		}
+		_, err := json.Marshal(routingv8.DepartureTime("tomorrow"))
+		assert.ErrorContains(t, err, `invalid departure time: "tomorrow"`)
+	})
+	t.Run("unmarshal", func(t *testing.T) {
+		t.Parallel()
+		var departure routingv8.DepartureTime
+		assert.NilError(t, json.Unmarshal([]byte(`"any"`), &departure))
+		assert.Assert(t, departure.IsAny())
+		_, ok := departure.Time()
+		assert.Assert(t, !ok)
+		assert.NilError(t, json.Unmarshal([]byte(`"2022-06-01T12:00:00+02:00"`), &departure))
+		got, ok := departure.Time()
+		assert.Assert(t, ok)
+		assert.Assert(t, got.Equal(time.Date(2022, 6, 1, 10, 0, 0, 0, time.UTC)))
+		assert.ErrorContains(t, json.Unmarshal([]byte(`"never"`), &departure), `invalid departure time: "never"`)
+	})
+	t.Run("prefer traffic", func(t *testing.T) {
+		t.Parallel()
+		var departure routingv8.DepartureTime
+		assert.NilError(t, json.Unmarshal([]byte(`"preferTraffic"`), &departure))
+		assert.Assert(t, departure.IsPreferTraffic())
+		assert.Assert(t, !departure.IsNow() && !departure.IsAny())
+		_, ok := departure.Time()
+		assert.Assert(t, !ok)
+		assert.NilError(t, departure.Validate())
+	})
+	t.Run("zero time departs now", func(t *testing.T) {
+		t.Parallel()
+		assert.Assert(t, routingv8.DepartAt(time.Time{}).IsNow())
+	})
+}
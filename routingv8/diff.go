@@ -0,0 +1,150 @@
+package routingv8
+
+import (
+	"fmt"
+	"time"
+)
+
+// GeometrySegment is a continuous part of a route geometry.
+type GeometrySegment struct {
+	// Points of the segment.
+	Points []GeoWaypoint
+	// Length of the segment in meters.
+	Length float64
+}
+
+// RouteDiff describes how a route changed, e.g. between two calculations of a monitored route.
+type RouteDiff struct {
+	// Removed are the segments of the first route not covered by the second route.
+	Removed []GeometrySegment
+	// Added are the segments of the second route not covered by the first route.
+	Added []GeometrySegment
+	// DurationDelta is the duration of the second route minus the duration of the first route.
+	DurationDelta time.Duration
+	// LengthDelta is the length in meters of the second route minus the length of the first route.
+	LengthDelta int
+	// TollDelta is the toll cost of the second route minus the toll cost of the first route, in TollCurrency.
+	TollDelta float64
+	// TollCurrency is the currency of TollDelta, the currency of the first toll fare of the routes.
+	TollCurrency string
+	// UnknownTolls is the number of tolls of the routes without a fare in TollCurrency, which are not part of
+	// TollDelta.
+	UnknownTolls int
+}
+
+// GeometryChanged reports whether the geometry of the routes differs.
+func (d *RouteDiff) GeometryChanged() bool {
+	return len(d.Removed) > 0 || len(d.Added) > 0
+}
+
+// DiffRoutes compares route b with route a. Routes have to be requested with the polyline, summary and tolls
+// return attributes.
+func DiffRoutes(a, b *Route) (*RouteDiff, error) {
+	pointsA, err := routeGeometry(a)
+	if err != nil {
+		return nil, fmt.Errorf("diff routes: %v", err)
+	}
+	pointsB, err := routeGeometry(b)
+	if err != nil {
+		return nil, fmt.Errorf("diff routes: %v", err)
+	}
+	diff := &RouteDiff{
+		Removed:      uncoveredSegments(pointsA, pointsB),
+		Added:        uncoveredSegments(pointsB, pointsA),
+		TollCurrency: tollCurrency(a, b),
+	}
+	durationA, lengthA := routeTotals(a)
+	durationB, lengthB := routeTotals(b)
+	diff.DurationDelta = durationB - durationA
+	diff.LengthDelta = lengthB - lengthA
+	diff.TollDelta = routeTollCost(b, diff.TollCurrency) - routeTollCost(a, diff.TollCurrency)
+	diff.UnknownTolls = unknownTolls(a, diff.TollCurrency) + unknownTolls(b, diff.TollCurrency)
+	return diff, nil
+}
+
+// edgeKey identifies a segment between two consecutive polyline points, regardless of the direction.
+type edgeKey struct {
+	from, to pointKey
+}
+
+func keyOfEdge(a, b GeoWaypoint) edgeKey {
+	from, to := keyOf(a), keyOf(b)
+	if to.lat < from.lat || (to.lat == from.lat && to.long < from.long) {
+		from, to = to, from
+	}
+	return edgeKey{from: from, to: to}
+}
+
+// uncoveredSegments returns the parts of a consisting of edges which are not edges of b.
+func uncoveredSegments(a, b []GeoWaypoint) []GeometrySegment {
+	edges := make(map[edgeKey]struct{}, len(b))
+	for i := 1; i < len(b); i++ {
+		edges[keyOfEdge(b[i-1], b[i])] = struct{}{}
+	}
+	var segments []GeometrySegment
+	var current *GeometrySegment
+	for i := 1; i < len(a); i++ {
+		if _, ok := edges[keyOfEdge(a[i-1], a[i])]; ok {
+			current = nil
+			continue
+		}
+		if current == nil {
+			segments = append(segments, GeometrySegment{Points: []GeoWaypoint{a[i-1]}})
+			current = &segments[len(segments)-1]
+		}
+		current.Points = append(current.Points, a[i])
+		current.Length += distance(a[i-1], a[i])
+	}
+	return segments
+}
+
+func routeTotals(route *Route) (time.Duration, int) {
+	var duration time.Duration
+	var length int
+	for _, section := range route.Sections {
+		duration += time.Duration(section.Summary.Duration) * time.Second
+		length += int(section.Summary.Length)
+	}
+	return duration, length
+}
+
+func tollCurrency(routes ...*Route) string {
+	for _, route := range routes {
+		for _, section := range route.Sections {
+			for _, toll := range section.Tolls {
+				for _, fare := range toll.Fares {
+					if fare.Price.Currency != "" {
+						return fare.Price.Currency
+					}
+				}
+			}
+		}
+	}
+	return ""
+}
+
+// routeTollCost returns the toll cost of the route in the currency. Tolls without a fare in the currency are not
+// part of the cost, see unknownTolls.
+func routeTollCost(route *Route, currency string) float64 {
+	var cost float64
+	for _, section := range route.Sections {
+		for _, toll := range section.Tolls {
+			fare, _ := tollFare(toll.Fares, currency)
+			cost += fare
+		}
+	}
+	return cost
+}
+
+// unknownTolls returns the number of tolls of the route without a fare in the currency.
+func unknownTolls(route *Route, currency string) int {
+	var unknown int
+	for _, section := range route.Sections {
+		for _, toll := range section.Tolls {
+			if _, ok := tollFare(toll.Fares, currency); !ok {
+				unknown++
+			}
+		}
+	}
+	return unknown
+}
@@ -0,0 +1,72 @@
+package routingv8_test
+
+import (
+	"testing"
+	"time"
+
+	"go.einride.tech/here/flexpolyline"
+	"go.einride.tech/here/routingv8"
+	"gotest.tools/v3/assert"
+)
+
+func encodePolyline(t *testing.T, points ...routingv8.GeoWaypoint) string {
+	t.Helper()
+	polyline := flexpolyline.Polyline{Precision: 5}
+	for _, p := range points {
+		polyline.Points = append(polyline.Points, flexpolyline.Point{Lat: p.Lat, Lng: p.Long})
+	}
+	encoded, err := flexpolyline.Encode(&polyline)
+	assert.NilError(t, err)
+	return encoded
+}
+
+func TestDiffRoutes(t *testing.T) {
+	t.Parallel()
+	p := func(lat float64) routingv8.GeoWaypoint {
+		return routingv8.GeoWaypoint{Lat: lat, Long: 11.9}
+	}
+	detour := routingv8.GeoWaypoint{Lat: 57.715, Long: 11.91}
+	toll := func(value float64) []routingv8.Toll {
+		return []routingv8.Toll{{Fares: []routingv8.Fare{{Price: routingv8.Price{Currency: "SEK", Value: value}}}}}
+	}
+	a := routingv8.Route{Sections: []routingv8.Section{{
+		Polyline: encodePolyline(t, p(57.70), p(57.71), p(57.72), p(57.73)),
+		Summary:  routingv8.Summary{Duration: 600, Length: 3300},
+		Tolls:    toll(45),
+	}}}
+	b := routingv8.Route{Sections: []routingv8.Section{{
+		Polyline: encodePolyline(t, p(57.70), p(57.71), detour, p(57.72), p(57.73)),
+		Summary:  routingv8.Summary{Duration: 720, Length: 3500},
+		Tolls:    toll(30),
+	}}}
+	diff, err := routingv8.DiffRoutes(&a, &b)
+	assert.NilError(t, err)
+	assert.Assert(t, diff.GeometryChanged())
+	assert.Equal(t, 1, len(diff.Removed))
+	assert.DeepEqual(t, []routingv8.GeoWaypoint{p(57.71), p(57.72)}, diff.Removed[0].Points)
+	assert.Equal(t, 1, len(diff.Added))
+	assert.DeepEqual(t, []routingv8.GeoWaypoint{p(57.71), detour, p(57.72)}, diff.Added[0].Points)
+	assert.Assert(t, diff.Added[0].Length > diff.Removed[0].Length)
+	assert.Equal(t, 2*time.Minute, diff.DurationDelta)
+	assert.Equal(t, 200, diff.LengthDelta)
+	assert.Equal(t, -15.0, diff.TollDelta)
+	assert.Equal(t, "SEK", diff.TollCurrency)
+
+	assert.Equal(t, 0, diff.UnknownTolls)
+
+	same, err := routingv8.DiffRoutes(&a, &a)
+	assert.NilError(t, err)
+	assert.Assert(t, !same.GeometryChanged())
+	assert.Equal(t, time.Duration(0), same.DurationDelta)
+
+	// A toll in another currency without a converted price is not part of the delta.
+	c := b
+	c.Sections = []routingv8.Section{b.Sections[0]}
+	c.Sections[0].Tolls = append(toll(30), routingv8.Toll{
+		Fares: []routingv8.Fare{{Price: routingv8.Price{Currency: "NOK", Value: 100}}},
+	})
+	mixed, err := routingv8.DiffRoutes(&a, &c)
+	assert.NilError(t, err)
+	assert.Equal(t, -15.0, mixed.TollDelta)
+	assert.Equal(t, 1, mixed.UnknownTolls)
+}
@@ -0,0 +1,105 @@
+package routingv8
+
+import "time"
+
+// RouteDTO is a flat representation of a Route for serialization formats like gob or protobuf. It holds only
+// primitive fields, times are stored as Unix milliseconds with their UTC offset.
+// Actions, spans, incidents and tolls of the route are not included.
+type RouteDTO struct {
+	ID       string
+	Sections []SectionDTO
+}
+
+// SectionDTO is a flat representation of a Section.
+type SectionDTO struct {
+	ID                         string
+	Type                       string
+	TransportMode              string
+	Polyline                   string
+	DepartureTimeUnixMilli     int64
+	DepartureTimeOffsetSeconds int32
+	DepartureLat               float64
+	DepartureLng               float64
+	ArrivalTimeUnixMilli       int64
+	ArrivalTimeOffsetSeconds   int32
+	ArrivalLat                 float64
+	ArrivalLng                 float64
+	DurationSeconds            int32
+	LengthMeters               int32
+	BaseDurationSeconds        int32
+	NoticeCodes                []string
+}
+
+// NewRouteDTO converts the route to its flat representation.
+func NewRouteDTO(route *Route) RouteDTO {
+	dto := RouteDTO{ID: route.ID, Sections: make([]SectionDTO, 0, len(route.Sections))}
+	for _, section := range route.Sections {
+		s := SectionDTO{
+			ID:                  section.ID,
+			Type:                section.Type,
+			TransportMode:       section.Transport.Mode,
+			Polyline:            section.Polyline,
+			DepartureLat:        section.Departure.Place.Location.Lat,
+			DepartureLng:        section.Departure.Place.Location.Long,
+			ArrivalLat:          section.Arrival.Place.Location.Lat,
+			ArrivalLng:          section.Arrival.Place.Location.Long,
+			DurationSeconds:     section.Summary.Duration,
+			LengthMeters:        section.Summary.Length,
+			BaseDurationSeconds: section.Summary.BaseDuration,
+		}
+		s.DepartureTimeUnixMilli, s.DepartureTimeOffsetSeconds = timeToDTO(section.Departure.Time)
+		s.ArrivalTimeUnixMilli, s.ArrivalTimeOffsetSeconds = timeToDTO(section.Arrival.Time)
+		for _, notice := range section.Notices {
+			s.NoticeCodes = append(s.NoticeCodes, notice.Code)
+		}
+		dto.Sections = append(dto.Sections, s)
+	}
+	return dto
+}
+
+// Route converts the flat representation back to a route.
+func (r *RouteDTO) Route() Route {
+	route := Route{ID: r.ID, Sections: make([]Section, 0, len(r.Sections))}
+	for _, s := range r.Sections {
+		section := Section{
+			ID:        s.ID,
+			Type:      s.Type,
+			Transport: Transport{Mode: s.TransportMode},
+			Polyline:  s.Polyline,
+			Departure: RoutePlace{
+				Time:  timeFromDTO(s.DepartureTimeUnixMilli, s.DepartureTimeOffsetSeconds),
+				Place: Place{Location: GeoWaypoint{Lat: s.DepartureLat, Long: s.DepartureLng}},
+			},
+			Arrival: RoutePlace{
+				Time:  timeFromDTO(s.ArrivalTimeUnixMilli, s.ArrivalTimeOffsetSeconds),
+				Place: Place{Location: GeoWaypoint{Lat: s.ArrivalLat, Long: s.ArrivalLng}},
+			},
+			Summary: Summary{
+				Duration:     s.DurationSeconds,
+				Length:       s.LengthMeters,
+				BaseDuration: s.BaseDurationSeconds,
+			},
+		}
+		for _, code := range s.NoticeCodes {
+			section.Notices = append(section.Notices, Notice{Code: code})
+		}
+		route.Sections = append(route.Sections, section)
+	}
+	return route
+}
+
+// timeToDTO converts t to Unix milliseconds and the UTC offset in seconds. The zero time is converted to zeros.
+func timeToDTO(t time.Time) (int64, int32) {
+	if t.IsZero() {
+		return 0, 0
+	}
+	_, offset := t.Zone()
+	return t.UnixNano() / int64(time.Millisecond), int32(offset)
+}
+
+func timeFromDTO(unixMilli int64, offset int32) time.Time {
+	if unixMilli == 0 && offset == 0 {
+		return time.Time{}
+	}
+	return time.Unix(0, unixMilli*int64(time.Millisecond)).In(time.FixedZone("", int(offset)))
+}
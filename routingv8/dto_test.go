@@ -0,0 +1,28 @@
+package routingv8_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"go.einride.tech/here/routingv8"
+	"go.einride.tech/here/routingv8/routingv8test"
+	"gotest.tools/v3/assert"
+)
+
+func TestRouteDTO(t *testing.T) {
+	t.Parallel()
+	var resp routingv8.RoutesResponse
+	assert.NilError(t, json.Unmarshal([]byte(routingv8test.RoutesResponseJSON), &resp))
+	expected := resp.Routes[0]
+	dto := routingv8.NewRouteDTO(&expected)
+	assert.Equal(t, int32(2*60*60), dto.Sections[0].DepartureTimeOffsetSeconds)
+	assert.DeepEqual(t, []string{"violatedVehicleRestriction"}, dto.Sections[0].NoticeCodes)
+	actual := dto.Route()
+	assert.Equal(t, expected.ID, actual.ID)
+	section := actual.Sections[0]
+	assert.Equal(t, expected.Sections[0].Departure.Time.String(), section.Departure.Time.String())
+	assert.Equal(t, expected.Sections[0].Arrival.Place.Location, section.Arrival.Place.Location)
+	assert.Equal(t, expected.Sections[0].Summary, section.Summary)
+	assert.Equal(t, expected.Sections[0].Polyline, section.Polyline)
+	assert.Equal(t, "truck", section.Transport.Mode)
+}
@@ -0,0 +1,87 @@
+package routingv8
+
+import (
+	"fmt"
+
+	"go.einride.tech/here/flexpolyline"
+)
+
+// ElevationSummary summarizes the elevation profile of a section or route.
+type ElevationSummary struct {
+	// Ascent is the total elevation gain in meters.
+	Ascent float64
+	// Descent is the total elevation loss in meters.
+	Descent float64
+	// Min is the lowest elevation in meters.
+	Min float64
+	// Max is the highest elevation in meters.
+	Max float64
+}
+
+func (e *ElevationSummary) add(o *ElevationSummary) {
+	e.Ascent += o.Ascent
+	e.Descent += o.Descent
+	if o.Min < e.Min {
+		e.Min = o.Min
+	}
+	if o.Max > e.Max {
+		e.Max = o.Max
+	}
+}
+
+// ElevationSummary computes the ascent and descent of the section from its polyline. The section has to be
+// requested with the polyline and elevation return attributes, the summary is nil if the polyline has no elevation.
+func (s *Section) ElevationSummary() (*ElevationSummary, error) {
+	if s.Polyline == "" {
+		return nil, nil
+	}
+	polyline, err := flexpolyline.Decode(s.Polyline)
+	if err != nil {
+		return nil, fmt.Errorf("elevation summary of section %s: %v", s.ID, err)
+	}
+	switch polyline.ThirdDimension {
+	case flexpolyline.ThirdDimensionAltitude, flexpolyline.ThirdDimensionElevation:
+	default:
+		return nil, nil
+	}
+	if len(polyline.Points) == 0 {
+		return nil, nil
+	}
+	first := polyline.Points[0].Third
+	summary := &ElevationSummary{Min: first, Max: first}
+	for i := 1; i < len(polyline.Points); i++ {
+		elevation := polyline.Points[i].Third
+		if delta := elevation - polyline.Points[i-1].Third; delta > 0 {
+			summary.Ascent += delta
+		} else {
+			summary.Descent -= delta
+		}
+		if elevation < summary.Min {
+			summary.Min = elevation
+		}
+		if elevation > summary.Max {
+			summary.Max = elevation
+		}
+	}
+	return summary, nil
+}
+
+// RouteElevationSummary combines the elevation summaries of the sections of the route. The summary is nil if none
+// of the sections has elevation.
+func RouteElevationSummary(route *Route) (*ElevationSummary, error) {
+	var result *ElevationSummary
+	for i := range route.Sections {
+		summary, err := route.Sections[i].ElevationSummary()
+		if err != nil {
+			return nil, err
+		}
+		switch {
+		case summary == nil:
+		case result == nil:
+			result = summary
+		default:
+			result.add(summary)
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,24 @@
+package routingv8_test
+
+import (
+	"testing"
+
+	"go.einride.tech/here/routingv8"
+	"gotest.tools/v3/assert"
+)
+
+func TestRouteElevationSummary(t *testing.T) {
+	t.Parallel()
+	route := routingv8.Route{
+		Sections: []routingv8.Section{
+			{ID: "flat", Polyline: "BFoz5xJ67i1B1B7PzIhaxL7Y"},
+			{ID: "uphill", Polyline: "BlBoz5xJ67i1BU1B7PUzIhaUxL7YU"},
+		},
+	}
+	flat, err := route.Sections[0].ElevationSummary()
+	assert.NilError(t, err)
+	assert.Assert(t, flat == nil)
+	summary, err := routingv8.RouteElevationSummary(&route)
+	assert.NilError(t, err)
+	assert.DeepEqual(t, &routingv8.ElevationSummary{Ascent: 30, Min: 10, Max: 40}, summary)
+}
@@ -0,0 +1,77 @@
+package routingv8
+
+import (
+	"errors"
+
+	"go.einride.tech/here/internal/hereapi"
+)
+
+// invalidInputError is returned for requests rejected before they are sent.
+type invalidInputError struct {
+	err error
+}
+
+func (e *invalidInputError) Error() string {
+	return e.err.Error()
+}
+
+func (e *invalidInputError) Unwrap() error {
+	return e.err
+}
+
+// IsRetryable reports whether err is a temporary failure, which may succeed if the request is retried. Network
+// errors, rate limited requests and server errors are retryable. The RetryPolicy of the Client retries the same
+// errors. Errors joining several errors, such as FallbackError, are retryable if any of them is. The errors of the
+// clients of the other services of the library, except the legacy routingv7 client, are classified the same way.
+func IsRetryable(err error) bool {
+	if errs, ok := joinedErrors(err); ok {
+		return anyError(errs, IsRetryable)
+	}
+	return hereapi.IsRetryable(err)
+}
+
+// IsInvalidInput reports whether err is caused by an invalid request, either rejected before it was sent or by
+// the API. Such requests fail permanently until they are corrected.
+func IsInvalidInput(err error) bool {
+	if errs, ok := joinedErrors(err); ok {
+		return anyError(errs, IsInvalidInput)
+	}
+	var invalidInputErr *invalidInputError
+	if errors.As(err, &invalidInputErr) {
+		return true
+	}
+	return hereapi.IsInvalidInput(err)
+}
+
+// IsAuth reports whether err is caused by invalid, expired or insufficient credentials.
+func IsAuth(err error) bool {
+	if errs, ok := joinedErrors(err); ok {
+		return anyError(errs, IsAuth)
+	}
+	var credentialsErr *CredentialsError
+	if errors.As(err, &credentialsErr) {
+		switch credentialsErr.Problem {
+		case CredentialsProblemInvalid, CredentialsProblemExpiredToken, CredentialsProblemNotEntitled:
+			return true
+		}
+	}
+	return hereapi.IsAuth(err)
+}
+
+// joinedErrors returns the errors joined by err or an error it wraps, e.g. the provider errors of a FallbackError.
+func joinedErrors(err error) ([]error, bool) {
+	var joined interface{ Unwrap() []error }
+	if !errors.As(err, &joined) {
+		return nil, false
+	}
+	return joined.Unwrap(), true
+}
+
+func anyError(errs []error, f func(error) bool) bool {
+	for _, err := range errs {
+		if f(err) {
+			return true
+		}
+	}
+	return false
+}
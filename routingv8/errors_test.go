@@ -0,0 +1,70 @@
+package routingv8_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"go.einride.tech/here/routingv8"
+	"gotest.tools/v3/assert"
+)
+
+func TestErrorClassification(t *testing.T) {
+	t.Parallel()
+	routes := func(statusCode int, transportErr error, req *routingv8.RoutesRequest) error {
+		client := routingv8.NewClient(httpClientFunc(func(*http.Request) (*http.Response, error) {
+			if transportErr != nil {
+				return nil, transportErr
+			}
+			return &http.Response{
+				StatusCode: statusCode,
+				Body:       io.NopCloser(strings.NewReader(fmt.Sprintf(`{"status":%d}`, statusCode))),
+			}, nil
+		}))
+		_, err := client.Routing.Routes(context.Background(), req)
+		return err
+	}
+	valid := &routingv8.RoutesRequest{TransportMode: routingv8.TransportModeCar}
+	for _, tt := range []struct {
+		name         string
+		err          error
+		retryable    bool
+		invalidInput bool
+		auth         bool
+	}{
+		{name: "nil"},
+		{name: "network", err: routes(0, errors.New("connection reset"), valid), retryable: true},
+		{name: "canceled", err: routes(0, context.Canceled, valid)},
+		{name: "rate limited", err: routes(http.StatusTooManyRequests, nil, valid), retryable: true},
+		{name: "server", err: routes(http.StatusBadGateway, nil, valid), retryable: true},
+		{name: "bad request", err: routes(http.StatusBadRequest, nil, valid), invalidInput: true},
+		{name: "unauthorized", err: routes(http.StatusUnauthorized, nil, valid), auth: true},
+		{name: "forbidden", err: routes(http.StatusForbidden, nil, valid), auth: true},
+		{name: "client side", err: routes(http.StatusOK, nil, &routingv8.RoutesRequest{}), invalidInput: true},
+		{
+			name: "retries exhausted",
+			err: &routingv8.RetryError{Attempts: []routingv8.Attempt{
+				{Err: routes(http.StatusServiceUnavailable, nil, valid)},
+			}},
+			retryable: true,
+		},
+		{
+			name: "expired token",
+			err:  &routingv8.CredentialsError{Problem: routingv8.CredentialsProblemExpiredToken},
+			auth: true,
+		},
+		{name: "other", err: errors.New("boom")},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.retryable, routingv8.IsRetryable(tt.err))
+			assert.Equal(t, tt.invalidInput, routingv8.IsInvalidInput(tt.err))
+			assert.Equal(t, tt.auth, routingv8.IsAuth(tt.err))
+		})
+	}
+}
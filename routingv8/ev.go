@@ -0,0 +1,158 @@
+package routingv8
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// SpeedConsumption is a point of a consumption table of an electric vehicle.
+type SpeedConsumption struct {
+	// Speed in km/h.
+	Speed float64
+	// Consumption in Wh/m at the speed.
+	Consumption float64
+}
+
+// ChargingCurvePoint is a point of the charging curve of an electric vehicle.
+type ChargingCurvePoint struct {
+	// Charge of the battery in kWh.
+	Charge float64
+	// Power in kW the battery is charged with at the charge.
+	Power float64
+}
+
+// EV describes an electric vehicle, for routes with energy consumption and charging stops.
+type EV struct {
+	// FreeFlowSpeedTable is the consumption by speed without traffic, sorted by speed. Required.
+	FreeFlowSpeedTable []SpeedConsumption
+	// TrafficSpeedTable is the consumption by speed in heavy traffic, sorted by speed.
+	TrafficSpeedTable []SpeedConsumption
+	// AuxiliaryConsumption in Wh/s, e.g. for climate control.
+	AuxiliaryConsumption float64
+	// Ascent is the additional consumption in Wh/m of elevation gain.
+	Ascent float64
+	// Descent is the recovery in Wh/m of elevation loss.
+	Descent float64
+	// InitialCharge of the battery at departure in kWh.
+	InitialCharge float64
+	// MaxCharge is the capacity of the battery in kWh.
+	MaxCharge float64
+	// ChargingCurve of the battery, sorted by charge.
+	ChargingCurve []ChargingCurvePoint
+	// MaxChargeAfterChargingStation in kWh.
+	MaxChargeAfterChargingStation float64
+	// MinChargeAtChargingStation in kWh.
+	MinChargeAtChargingStation float64
+	// MinChargeAtDestination in kWh.
+	MinChargeAtDestination float64
+	// ChargingSetupDuration is the time to set up each charging stop.
+	ChargingSetupDuration time.Duration
+	// MakeReachable inserts charging stops along the route, so that the destination can be reached.
+	// Requires the initial charge, max charge, charging curve and the minimum charges.
+	MakeReachable bool
+	// ConnectorTypes the vehicle can charge with, e.g. "iec62196Type2Combo".
+	ConnectorTypes []string
+}
+
+// addQuery adds the EV parameters to the query of a routes request. Zero values are omitted.
+func (e *EV) addQuery(values url.Values) error {
+	if len(e.FreeFlowSpeedTable) == 0 {
+		return fmt.Errorf("invalid EV: free flow speed table required")
+	}
+	if e.MakeReachable && (e.MaxCharge == 0 || len(e.ChargingCurve) == 0) {
+		return fmt.Errorf("invalid EV: make reachable requires max charge and charging curve")
+	}
+	values.Add("ev[freeFlowSpeedTable]", speedTable(e.FreeFlowSpeedTable))
+	if len(e.TrafficSpeedTable) > 0 {
+		values.Add("ev[trafficSpeedTable]", speedTable(e.TrafficSpeedTable))
+	}
+	for _, p := range []struct {
+		name  string
+		value float64
+	}{
+		{name: "auxiliaryConsumption", value: e.AuxiliaryConsumption},
+		{name: "ascent", value: e.Ascent},
+		{name: "descent", value: e.Descent},
+		{name: "initialCharge", value: e.InitialCharge},
+		{name: "maxCharge", value: e.MaxCharge},
+		{name: "maxChargeAfterChargingStation", value: e.MaxChargeAfterChargingStation},
+		{name: "minChargeAtChargingStation", value: e.MinChargeAtChargingStation},
+		{name: "minChargeAtDestination", value: e.MinChargeAtDestination},
+	} {
+		if p.value < 0 {
+			return fmt.Errorf("invalid EV %s: %v", p.name, p.value)
+		}
+		if p.value > 0 {
+			values.Add("ev["+p.name+"]", formatFloat(p.value))
+		}
+	}
+	if len(e.ChargingCurve) > 0 {
+		points := make([]string, 0, 2*len(e.ChargingCurve))
+		for _, p := range e.ChargingCurve {
+			points = append(points, formatFloat(p.Charge), formatFloat(p.Power))
+		}
+		values.Add("ev[chargingCurve]", strings.Join(points, ","))
+	}
+	if seconds := int64(e.ChargingSetupDuration / time.Second); seconds > 0 {
+		values.Add("ev[chargingSetupDuration]", strconv.FormatInt(seconds, 10))
+	}
+	if e.MakeReachable {
+		values.Add("ev[makeReachable]", "true")
+	}
+	if len(e.ConnectorTypes) > 0 {
+		values.Add("ev[connectorTypes]", strings.Join(e.ConnectorTypes, ","))
+	}
+	return nil
+}
+
+func speedTable(table []SpeedConsumption) string {
+	points := make([]string, 0, 2*len(table))
+	for _, p := range table {
+		points = append(points, formatFloat(p.Speed), formatFloat(p.Consumption))
+	}
+	return strings.Join(points, ",")
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
+
+// ChargingStop is a stop at a charging station inserted into an EV route.
+type ChargingStop struct {
+	// SectionID of the section arriving at the charging station.
+	SectionID string
+	// Place of the charging station.
+	Place Place
+	// ArrivalCharge in kWh.
+	ArrivalCharge float64
+	// TargetCharge in kWh.
+	TargetCharge float64
+	// Duration of the stop, including setup and charging.
+	Duration time.Duration
+}
+
+// ChargingStops returns the charging stops of an EV route.
+func (r *Route) ChargingStops() []ChargingStop {
+	var result []ChargingStop
+	for _, section := range r.Sections {
+		if section.Arrival.Place.Type != PlaceTypeChargingStation {
+			continue
+		}
+		stop := ChargingStop{
+			SectionID:     section.ID,
+			Place:         section.Arrival.Place,
+			ArrivalCharge: section.Arrival.Charge,
+		}
+		for _, action := range section.PostActions {
+			stop.Duration += time.Duration(action.Duration) * time.Second
+			if action.Action == PostActionCharging {
+				stop.TargetCharge = action.TargetCharge
+			}
+		}
+		result = append(result, stop)
+	}
+	return result
+}
@@ -0,0 +1,76 @@
+package routingv8_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"go.einride.tech/here/routingv8"
+	"go.einride.tech/here/routingv8/routingv8test"
+	"gotest.tools/v3/assert"
+)
+
+func TestRoutingService_Routes_EV(t *testing.T) {
+	t.Parallel()
+	var query url.Values
+	client := routingv8.NewClient(httpClientFunc(func(req *http.Request) (*http.Response, error) {
+		query = req.URL.Query()
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Body:       io.NopCloser(strings.NewReader(routingv8test.EVRoutesResponseJSON)),
+		}, nil
+	}))
+	resp, err := client.Routing.Routes(context.Background(), &routingv8.RoutesRequest{
+		TransportMode: routingv8.TransportModeCar,
+		EV: &routingv8.EV{
+			FreeFlowSpeedTable: []routingv8.SpeedConsumption{
+				{Speed: 0, Consumption: 0.239},
+				{Speed: 27, Consumption: 0.239},
+			},
+			InitialCharge:          48,
+			MaxCharge:              80,
+			ChargingCurve:          []routingv8.ChargingCurvePoint{{Charge: 0, Power: 239}, {Charge: 64, Power: 111}},
+			MinChargeAtDestination: 8,
+			ChargingSetupDuration:  5 * time.Minute,
+			MakeReachable:          true,
+		},
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, "0,0.239,27,0.239", query.Get("ev[freeFlowSpeedTable]"))
+	assert.Equal(t, "0,239,64,111", query.Get("ev[chargingCurve]"))
+	assert.Equal(t, "48", query.Get("ev[initialCharge]"))
+	assert.Equal(t, "300", query.Get("ev[chargingSetupDuration]"))
+	assert.Equal(t, "true", query.Get("ev[makeReachable]"))
+	stops := resp.Routes[0].ChargingStops()
+	assert.Equal(t, 1, len(stops))
+	assert.Equal(t, "IONITY Jönköping", stops[0].Place.Name)
+	assert.Equal(t, 350.0, stops[0].Place.Attributes.Power)
+	assert.Equal(t, 9.6, stops[0].ArrivalCharge)
+	assert.Equal(t, 60.0, stops[0].TargetCharge)
+	assert.Equal(t, 32*time.Minute, stops[0].Duration)
+}
+
+func TestEV_Invalid(t *testing.T) {
+	t.Parallel()
+	client := routingv8.NewClient(httpClientFunc(func(req *http.Request) (*http.Response, error) {
+		return nil, nil
+	}))
+	_, err := client.Routing.Routes(context.Background(), &routingv8.RoutesRequest{
+		TransportMode: routingv8.TransportModeCar,
+		EV:            &routingv8.EV{},
+	})
+	assert.Error(t, err, "invalid EV: free flow speed table required")
+}
+
+func TestSummary_Consumption(t *testing.T) {
+	t.Parallel()
+	var resp routingv8.RoutesResponse
+	assert.NilError(t, json.Unmarshal([]byte(routingv8test.EVRoutesResponseJSON), &resp))
+	assert.Equal(t, 38.4, resp.Routes[0].Sections[0].Summary.Consumption)
+	assert.Equal(t, 12.0, resp.Routes[0].Sections[1].Arrival.Charge)
+}
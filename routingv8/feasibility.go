@@ -0,0 +1,150 @@
+package routingv8
+
+import "fmt"
+
+// NoticeSeverityCritical is the severity of notices which indicate that the route violates a restriction.
+const NoticeSeverityCritical = "critical"
+
+// FeasibilityPolicy describes the requirements a route has to fulfill to be considered feasible.
+type FeasibilityPolicy struct {
+	// AllowCriticalNotices accepts routes with critical notices, such as violated vehicle restrictions.
+	AllowCriticalNotices bool
+	// ForbiddenNoticeCodes rejects routes with any notice matching one of the codes, regardless of severity.
+	ForbiddenNoticeCodes []string
+	// MaxTollCost is the maximum accepted total toll cost of the route. Zero means no limit.
+	MaxTollCost float64
+	// TollCurrency is the currency of MaxTollCost. Fares in other currencies are compared by their converted price,
+	// tolls without a fare in the currency make the toll cost unknown.
+	TollCurrency string
+	// AvoidFerries rejects routes with ferry sections.
+	AvoidFerries bool
+}
+
+// InfeasibilityReasonType is the type of requirement that a route failed to fulfill.
+type InfeasibilityReasonType int
+
+const (
+	InfeasibilityReasonTypeUnspecified InfeasibilityReasonType = iota
+	// InfeasibilityReasonTypeCriticalNotice - a section has a critical notice.
+	InfeasibilityReasonTypeCriticalNotice
+	// InfeasibilityReasonTypeForbiddenNotice - a section has a notice with a forbidden code.
+	InfeasibilityReasonTypeForbiddenNotice
+	// InfeasibilityReasonTypeTollCost - the total toll cost exceeds the maximum.
+	InfeasibilityReasonTypeTollCost
+	// InfeasibilityReasonTypeFerry - a section uses a ferry.
+	InfeasibilityReasonTypeFerry
+	// InfeasibilityReasonTypeUnknownTollCost - a section has a toll without a fare in the toll currency, so the
+	// total toll cost can not be compared with the maximum.
+	InfeasibilityReasonTypeUnknownTollCost
+)
+
+func (t InfeasibilityReasonType) String() string {
+	switch t {
+	case InfeasibilityReasonTypeUnspecified:
+		return unspecified
+	case InfeasibilityReasonTypeCriticalNotice:
+		return "criticalNotice"
+	case InfeasibilityReasonTypeForbiddenNotice:
+		return "forbiddenNotice"
+	case InfeasibilityReasonTypeTollCost:
+		return "tollCost"
+	case InfeasibilityReasonTypeFerry:
+		return "ferry"
+	case InfeasibilityReasonTypeUnknownTollCost:
+		return "unknownTollCost"
+	default:
+		return invalid
+	}
+}
+
+// InfeasibilityReason describes why a route failed a FeasibilityPolicy.
+type InfeasibilityReason struct {
+	// Type of the failed requirement.
+	Type InfeasibilityReasonType
+	// SectionID of the offending section. Empty for route-level reasons.
+	SectionID string
+	// Detail is a human-readable description of the reason.
+	Detail string
+}
+
+func (r InfeasibilityReason) String() string {
+	if r.SectionID == "" {
+		return fmt.Sprintf("%v: %s", r.Type, r.Detail)
+	}
+	return fmt.Sprintf("%v (section %s): %s", r.Type, r.SectionID, r.Detail)
+}
+
+// IsFeasible checks the notices, tolls and sections of the route against the policy.
+// The returned reasons are empty if and only if the route is feasible.
+func IsFeasible(route *Route, policy *FeasibilityPolicy) (bool, []InfeasibilityReason) {
+	var reasons []InfeasibilityReason
+	var tollCost float64
+	for _, section := range route.Sections {
+		for _, notice := range section.Notices {
+			if !policy.AllowCriticalNotices && notice.Severity == NoticeSeverityCritical {
+				reasons = append(reasons, InfeasibilityReason{
+					Type:      InfeasibilityReasonTypeCriticalNotice,
+					SectionID: section.ID,
+					Detail:    fmt.Sprintf("%s: %s", notice.Code, notice.Title),
+				})
+				continue
+			}
+			for _, code := range policy.ForbiddenNoticeCodes {
+				if notice.Code == code {
+					reasons = append(reasons, InfeasibilityReason{
+						Type:      InfeasibilityReasonTypeForbiddenNotice,
+						SectionID: section.ID,
+						Detail:    fmt.Sprintf("%s: %s", notice.Code, notice.Title),
+					})
+					break
+				}
+			}
+		}
+		if policy.AvoidFerries && section.IsFerry() {
+			reasons = append(reasons, InfeasibilityReason{
+				Type:      InfeasibilityReasonTypeFerry,
+				SectionID: section.ID,
+				Detail:    "section uses a ferry",
+			})
+		}
+		for _, toll := range section.Tolls {
+			fare, ok := tollFare(toll.Fares, policy.TollCurrency)
+			if !ok && policy.MaxTollCost > 0 {
+				reasons = append(reasons, InfeasibilityReason{
+					Type:      InfeasibilityReasonTypeUnknownTollCost,
+					SectionID: section.ID,
+					Detail:    fmt.Sprintf("toll without a fare in %s", policy.TollCurrency),
+				})
+			}
+			tollCost += fare
+		}
+	}
+	if policy.MaxTollCost > 0 && tollCost > policy.MaxTollCost {
+		reasons = append(reasons, InfeasibilityReason{
+			Type:   InfeasibilityReasonTypeTollCost,
+			Detail: fmt.Sprintf("toll cost %.2f %s exceeds %.2f", tollCost, policy.TollCurrency, policy.MaxTollCost),
+		})
+	}
+	return len(reasons) == 0, reasons
+}
+
+// tollFare returns the price of the cheapest of the alternative fares of a toll in the currency, using the
+// converted price of fares in other currencies. Fares without a price in the currency are skipped, false is
+// returned if no fare has one. All fares are compared by their price if the currency is empty.
+func tollFare(fares []Fare, currency string) (float64, bool) {
+	var cheapest float64
+	var found bool
+	for _, fare := range fares {
+		price := fare.Price
+		if currency != "" && price.Currency != currency {
+			if fare.ConvertedPrice.Currency != currency {
+				continue
+			}
+			price = fare.ConvertedPrice
+		}
+		if !found || price.Value < cheapest {
+			cheapest, found = price.Value, true
+		}
+	}
+	return cheapest, found
+}
@@ -0,0 +1,104 @@
+package routingv8_test
+
+import (
+	"testing"
+
+	"go.einride.tech/here/routingv8"
+	"gotest.tools/v3/assert"
+)
+
+func TestIsFeasible(t *testing.T) {
+	t.Parallel()
+	route := routingv8.Route{
+		ID: "route-1",
+		Sections: []routingv8.Section{
+			{
+				ID:   "section-1",
+				Type: "vehicle",
+				Notices: []routingv8.Notice{
+					{Title: "Violated vehicle restriction.", Code: "violatedVehicleRestriction", Severity: "critical"},
+				},
+				Tolls: []routingv8.Toll{
+					{
+						Fares: []routingv8.Fare{
+							{Price: routingv8.Price{Currency: "EUR", Value: 12}},
+							{Price: routingv8.Price{Currency: "EUR", Value: 10}},
+						},
+					},
+				},
+			},
+			{
+				ID:        "section-2",
+				Type:      "ferry",
+				Transport: routingv8.Transport{Mode: "ferry"},
+			},
+		},
+	}
+	t.Run("default policy", func(t *testing.T) {
+		t.Parallel()
+		ok, reasons := routingv8.IsFeasible(&route, &routingv8.FeasibilityPolicy{})
+		assert.Assert(t, !ok)
+		assert.Equal(t, 1, len(reasons))
+		assert.Equal(t, routingv8.InfeasibilityReasonTypeCriticalNotice, reasons[0].Type)
+		assert.Equal(t, "section-1", reasons[0].SectionID)
+	})
+	t.Run("strict policy", func(t *testing.T) {
+		t.Parallel()
+		ok, reasons := routingv8.IsFeasible(&route, &routingv8.FeasibilityPolicy{
+			AllowCriticalNotices: true,
+			MaxTollCost:          5,
+			TollCurrency:         "EUR",
+			AvoidFerries:         true,
+		})
+		assert.Assert(t, !ok)
+		assert.Equal(t, 2, len(reasons))
+		assert.Equal(t, routingv8.InfeasibilityReasonTypeFerry, reasons[0].Type)
+		assert.Equal(t, routingv8.InfeasibilityReasonTypeTollCost, reasons[1].Type)
+	})
+	t.Run("lenient policy", func(t *testing.T) {
+		t.Parallel()
+		ok, reasons := routingv8.IsFeasible(&route, &routingv8.FeasibilityPolicy{
+			AllowCriticalNotices: true,
+			MaxTollCost:          10,
+			TollCurrency:         "EUR",
+		})
+		assert.Assert(t, ok)
+		assert.Equal(t, 0, len(reasons))
+	})
+	t.Run("converted and unknown fares", func(t *testing.T) {
+		t.Parallel()
+		route := routingv8.Route{Sections: []routingv8.Section{{
+			ID: "section-1",
+			Tolls: []routingv8.Toll{
+				{
+					Fares: []routingv8.Fare{
+						{
+							Price:          routingv8.Price{Currency: "SEK", Value: 45},
+							ConvertedPrice: routingv8.Price{Currency: "EUR", Value: 4},
+						},
+						// Cheaper in its own currency, but not comparable with the maximum.
+						{Price: routingv8.Price{Currency: "SEK", Value: 1}},
+					},
+				},
+				{Fares: []routingv8.Fare{{Price: routingv8.Price{Currency: "NOK", Value: 1}}}},
+			},
+		}}}
+		ok, reasons := routingv8.IsFeasible(&route, &routingv8.FeasibilityPolicy{
+			AllowCriticalNotices: true,
+			MaxTollCost:          3,
+			TollCurrency:         "EUR",
+		})
+		assert.Assert(t, !ok)
+		assert.DeepEqual(t, []routingv8.InfeasibilityReason{
+			{
+				Type:      routingv8.InfeasibilityReasonTypeUnknownTollCost,
+				SectionID: "section-1",
+				Detail:    "toll without a fare in EUR",
+			},
+			{
+				Type:   routingv8.InfeasibilityReasonTypeTollCost,
+				Detail: "toll cost 4.00 EUR exceeds 3.00",
+			},
+		}, reasons)
+	})
+}
@@ -0,0 +1,69 @@
+package routingv8_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"go.einride.tech/here/routingv8"
+	"go.einride.tech/here/routingv8/routingv8test"
+	"gotest.tools/v3/assert"
+)
+
+func TestFixtures(t *testing.T) {
+	t.Parallel()
+	routingv8test.CheckDecode(t)
+}
+
+func TestFixtures_Routes(t *testing.T) {
+	t.Parallel()
+	var resp routingv8.RoutesResponse
+	assert.NilError(t, json.Unmarshal([]byte(routingv8test.RoutesResponseJSON), &resp))
+	assert.Equal(t, 1, len(resp.Routes))
+	section := resp.Routes[0].Sections[0]
+	assert.Equal(t, "vehicle", section.Type)
+	assert.Equal(t, 3, len(section.Actions))
+	assert.Equal(t, "turn", section.Actions[1].Action)
+	assert.Equal(t, 2, section.Actions[1].Offset)
+	assert.Equal(t, "right", section.Actions[1].Direction)
+	assert.Equal(t, "quite", section.Actions[1].Severity)
+	assert.Equal(t, int32(857), section.Summary.Length)
+	assert.Assert(t, section.Spans[1].SpeedLimit.IsUnlimited())
+	assert.Equal(t, "violatedVehicleRestriction", section.Notices[0].Code)
+	assert.Equal(t, 12000, section.Notices[0].Details[0].MaxGrossWeight)
+	assert.Equal(t, "construction", section.Incidents[0].Type)
+	assert.Equal(t, time.Date(2022, 6, 10, 18, 0, 0, 0, time.UTC), section.Incidents[0].ValidUntil.UTC())
+	assert.Equal(t, 3.4, section.Tolls[0].Fares[0].Price.Value)
+	assert.Equal(t, "SEK", section.Tolls[0].Fares[0].ConvertedPrice.Currency)
+	assert.Equal(t, "TOLL COLLECT", section.TollSystems[0].Name)
+	assert.Equal(t, 8.6981, section.Departure.Place.OriginalLocation.Long)
+}
+
+func TestFixtures_CalculateMatrix(t *testing.T) {
+	t.Parallel()
+	var resp routingv8.CalculateMatrixResponse
+	assert.NilError(t, json.Unmarshal([]byte(routingv8test.CalculateMatrixResponseJSON), &resp))
+	assert.Equal(t, 2, resp.Matrix.NumOrigins)
+	assert.DeepEqual(t, []int32{0, 470230, 471120, 0}, resp.Matrix.Distances)
+	assert.DeepEqual(
+		t,
+		routingv8.ErrorCodes{
+			routingv8.ErrorCodeSuccess,
+			routingv8.ErrorCodeSuccess,
+			routingv8.ErrorCodeSuccess,
+			routingv8.ErrorCodeParameterViolation,
+		},
+		resp.Matrix.ErrorCodes,
+	)
+	assert.Equal(t, routingv8.RegionType(routingv8.RegionTypeWorld), resp.RegionDefinition.Type)
+	assert.Equal(t, 1, len(resp.NoticesWithCode("originOutsideRegion")))
+	assert.Equal(t, 0, len(resp.NoticesWithCode("destinationOutsideRegion")))
+}
+
+func TestFixtures_Error(t *testing.T) {
+	t.Parallel()
+	var resp routingv8.HereErrorResponse
+	assert.NilError(t, json.Unmarshal([]byte(routingv8test.ErrorResponseJSON), &resp))
+	assert.Equal(t, 400, resp.Status)
+	assert.Equal(t, "E605001", resp.Code)
+}
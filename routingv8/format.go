@@ -0,0 +1,60 @@
+package routingv8
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+)
+
+const (
+	metersPerMile = 1609.344
+	metersPerFoot = 0.3048
+)
+
+// FormatDuration formats the duration rounded to minutes for humans, e.g. "1 hr 5 min". Durations shorter than
+// half a minute are formatted as "< 1 min".
+func FormatDuration(d time.Duration) string {
+	minutes := int64(d.Round(time.Minute) / time.Minute)
+	if minutes <= 0 {
+		return "< 1 min"
+	}
+	hours, minutes := minutes/60, minutes%60
+	switch {
+	case hours == 0:
+		return fmt.Sprintf("%d min", minutes)
+	case minutes == 0:
+		return fmt.Sprintf("%d hr", hours)
+	default:
+		return fmt.Sprintf("%d hr %d min", hours, minutes)
+	}
+}
+
+// FormatDistance formats the distance in meters for humans in the units, e.g. "3.2 km" or "2 mi".
+// Unspecified units format the distance in metric units.
+func FormatDistance(meters float64, units Units) string {
+	if units == UnitsImperial {
+		miles := meters / metersPerMile
+		if miles < 0.1 {
+			return fmt.Sprintf("%d ft", int64(math.Round(meters/metersPerFoot/10)*10))
+		}
+		return formatDecimal(miles) + " mi"
+	}
+	if meters < 1000 {
+		return fmt.Sprintf("%d m", int64(math.Round(meters/10)*10))
+	}
+	return formatDecimal(meters/1000) + " km"
+}
+
+// formatDecimal formats the value with one decimal below 100 and without trailing zero decimals.
+func formatDecimal(value float64) string {
+	if value >= 100 {
+		return strconv.FormatFloat(math.Round(value), 'f', 0, 64)
+	}
+	return strconv.FormatFloat(math.Round(value*10)/10, 'f', -1, 64)
+}
+
+// Format formats the length and duration of the summary for humans in the units, e.g. "3.2 km, 1 hr 5 min".
+func (s Summary) Format(units Units) string {
+	return FormatDistance(float64(s.Length), units) + ", " + FormatDuration(time.Duration(s.Duration)*time.Second)
+}
@@ -0,0 +1,54 @@
+package routingv8_test
+
+import (
+	"testing"
+	"time"
+
+	"go.einride.tech/here/routingv8"
+	"gotest.tools/v3/assert"
+)
+
+func TestFormatDuration(t *testing.T) {
+	t.Parallel()
+	for _, tt := range []struct {
+		duration time.Duration
+		expected string
+	}{
+		{duration: 0, expected: "< 1 min"},
+		{duration: 20 * time.Second, expected: "< 1 min"},
+		{duration: 45 * time.Second, expected: "1 min"},
+		{duration: 59 * time.Minute, expected: "59 min"},
+		{duration: time.Hour, expected: "1 hr"},
+		{duration: time.Hour + 5*time.Minute + 10*time.Second, expected: "1 hr 5 min"},
+		{duration: 26*time.Hour + 30*time.Minute, expected: "26 hr 30 min"},
+	} {
+		assert.Equal(t, tt.expected, routingv8.FormatDuration(tt.duration), tt.duration)
+	}
+}
+
+func TestFormatDistance(t *testing.T) {
+	t.Parallel()
+	for _, tt := range []struct {
+		meters   float64
+		units    routingv8.Units
+		expected string
+	}{
+		{meters: 0, units: routingv8.UnitsUnspecified, expected: "0 m"},
+		{meters: 854, units: routingv8.UnitsMetric, expected: "850 m"},
+		{meters: 3210, units: routingv8.UnitsMetric, expected: "3.2 km"},
+		{meters: 5000, units: routingv8.UnitsMetric, expected: "5 km"},
+		{meters: 123456, units: routingv8.UnitsMetric, expected: "123 km"},
+		{meters: 100, units: routingv8.UnitsImperial, expected: "330 ft"},
+		{meters: 3218.688, units: routingv8.UnitsImperial, expected: "2 mi"},
+		{meters: 4000, units: routingv8.UnitsImperial, expected: "2.5 mi"},
+	} {
+		assert.Equal(t, tt.expected, routingv8.FormatDistance(tt.meters, tt.units), tt.meters)
+	}
+}
+
+func TestSummary_Format(t *testing.T) {
+	t.Parallel()
+	summary := routingv8.Summary{Duration: 3900, Length: 3210}
+	assert.Equal(t, "3.2 km, 1 hr 5 min", summary.Format(routingv8.UnitsMetric))
+	assert.Equal(t, "2 mi, 1 hr 5 min", summary.Format(routingv8.UnitsImperial))
+}
@@ -0,0 +1,66 @@
+//go:build go1.18
+// +build go1.18
+
+package routingv8_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"go.einride.tech/here/routingv8"
+	"go.einride.tech/here/routingv8/routingv8test"
+)
+
+func FuzzErrorCodes_UnmarshalJSON(f *testing.F) {
+	for _, seed := range []string{`[0,1,2,3,99]`, `[]`, `null`, `[-1]`, `[1e3]`, `{}`} {
+		f.Add([]byte(seed))
+	}
+	f.Fuzz(func(t *testing.T, b []byte) {
+		var codes routingv8.ErrorCodes
+		_ = codes.UnmarshalJSON(b)
+	})
+}
+
+func FuzzProfile_UnmarshalJSON(f *testing.F) {
+	for _, seed := range []string{`"carFast"`, `"truckFast"`, `""`, `"\u0000"`, `null`, `1`} {
+		f.Add([]byte(seed))
+	}
+	f.Fuzz(func(t *testing.T, b []byte) {
+		var profile routingv8.Profile
+		_ = profile.UnmarshalJSON(b)
+	})
+}
+
+func FuzzRegionType_UnmarshalJSON(f *testing.F) {
+	for _, seed := range []string{`"world"`, `"boundingBox"`, `""`, `null`, `[]`} {
+		f.Add([]byte(seed))
+	}
+	f.Fuzz(func(t *testing.T, b []byte) {
+		var regionType routingv8.RegionType
+		_ = regionType.UnmarshalJSON(b)
+	})
+}
+
+func FuzzRoutesResponse(f *testing.F) {
+	f.Add([]byte(routingv8test.RoutesResponseJSON))
+	f.Add([]byte(routingv8test.EVRoutesResponseJSON))
+	f.Fuzz(func(t *testing.T, b []byte) {
+		var resp routingv8.RoutesResponse
+		if err := json.Unmarshal(b, &resp); err != nil {
+			return
+		}
+		for i := range resp.Routes {
+			for j := range resp.Routes[i].Sections {
+				_, _ = resp.Routes[i].Sections[j].ActionPositions()
+			}
+		}
+	})
+}
+
+func FuzzCalculateMatrixResponse(f *testing.F) {
+	f.Add([]byte(routingv8test.CalculateMatrixResponseJSON))
+	f.Fuzz(func(t *testing.T, b []byte) {
+		var resp routingv8.CalculateMatrixResponse
+		_ = json.Unmarshal(b, &resp)
+	})
+}
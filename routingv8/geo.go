@@ -0,0 +1,12 @@
+package routingv8
+
+import "go.einride.tech/here/geo"
+
+func (g GeoWaypoint) point() geo.Point {
+	return geo.Point{Lat: g.Lat, Lng: g.Long}
+}
+
+// distance returns the great-circle distance in meters between two waypoints.
+func distance(a, b GeoWaypoint) float64 {
+	return geo.Distance(a.point(), b.point())
+}
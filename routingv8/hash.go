@@ -0,0 +1,38 @@
+package routingv8
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"hash"
+)
+
+// RouteGeometryHash returns a stable hash of the geometry of the route. Unlike the route ID, which changes with
+// every response, the hash only changes when the route takes a different path.
+func RouteGeometryHash(route *Route) string {
+	h := sha256.New()
+	for _, section := range route.Sections {
+		writeHashString(h, section.Polyline)
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// RouteHash returns a stable hash of the geometry, transport modes and summaries of the route. It changes when the
+// path, length or duration of any section changes, e.g. due to traffic.
+func RouteHash(route *Route) string {
+	h := sha256.New()
+	for _, section := range route.Sections {
+		writeHashString(h, section.Type)
+		writeHashString(h, section.Transport.Mode)
+		writeHashString(h, section.Polyline)
+		_ = binary.Write(h, binary.BigEndian, section.Summary.Length)
+		_ = binary.Write(h, binary.BigEndian, section.Summary.Duration)
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// writeHashString writes a length-prefixed string, so that the boundaries of consecutive strings are unambiguous.
+func writeHashString(h hash.Hash, s string) {
+	_ = binary.Write(h, binary.BigEndian, uint32(len(s)))
+	_, _ = h.Write([]byte(s))
+}
@@ -0,0 +1,30 @@
+package routingv8_test
+
+import (
+	"testing"
+
+	"go.einride.tech/here/routingv8"
+	"gotest.tools/v3/assert"
+)
+
+func TestRouteHash(t *testing.T) {
+	t.Parallel()
+	route := func(id string, duration int32) *routingv8.Route {
+		return &routingv8.Route{
+			ID: id,
+			Sections: []routingv8.Section{
+				{
+					ID:       id,
+					Type:     "vehicle",
+					Polyline: "BFoz5xJ67i1B1B7PzIhaxL7Y",
+					Summary:  routingv8.Summary{Duration: duration, Length: 857},
+				},
+			},
+		}
+	}
+	first, refreshed, delayed := route("first", 93), route("refreshed", 93), route("delayed", 120)
+	assert.Equal(t, routingv8.RouteHash(first), routingv8.RouteHash(refreshed))
+	assert.Assert(t, routingv8.RouteHash(first) != routingv8.RouteHash(delayed))
+	assert.Equal(t, routingv8.RouteGeometryHash(first), routingv8.RouteGeometryHash(delayed))
+	assert.Assert(t, routingv8.RouteGeometryHash(first) != routingv8.RouteGeometryHash(&routingv8.Route{}))
+}
@@ -0,0 +1,46 @@
+package routingv8
+
+// hazmatTunnelCategories are the conservative ADR tunnel restriction codes of the hazardous goods classes.
+// Goods restricted in tunnels of category B are also restricted in tunnels of categories C, D and E.
+var hazmatTunnelCategories = map[ShippedHazardousGoods]TunnelCategory{
+	ShippedHazardousGoodsExplosive:           TunnelCategoryB,
+	ShippedHazardousGoodsGas:                 TunnelCategoryB,
+	ShippedHazardousGoodsFlammable:           TunnelCategoryD,
+	ShippedHazardousGoodsCombustible:         TunnelCategoryE,
+	ShippedHazardousGoodsOrganic:             TunnelCategoryD,
+	ShippedHazardousGoodsPoison:              TunnelCategoryD,
+	ShippedHazardousGoodsRadioactive:         TunnelCategoryE,
+	ShippedHazardousGoodsCorrosive:           TunnelCategoryE,
+	ShippedHazardousGoodsPoisonousInhalation: TunnelCategoryC,
+	ShippedHazardousGoodsHarmfulToWater:      TunnelCategoryE,
+	ShippedHazardousGoodsOther:               TunnelCategoryE,
+}
+
+// HazmatTruck returns a copy of truck carrying the hazardous goods, with the tunnel category of the most
+// restricted goods. The tunnel categories are conservative defaults, use the tunnel restriction code of the
+// transport document where available.
+func HazmatTruck(truck Truck, goods ...ShippedHazardousGoods) Truck {
+	result := truck
+	result.ShippedHazardousGoods = append(ShippedHazardousGoodsList(nil), truck.ShippedHazardousGoods...)
+	for _, g := range goods {
+		if !containsHazardousGoods(result.ShippedHazardousGoods, g) {
+			result.ShippedHazardousGoods = append(result.ShippedHazardousGoods, g)
+		}
+	}
+	for _, g := range result.ShippedHazardousGoods {
+		category, ok := hazmatTunnelCategories[g]
+		if ok && (result.TunnelCategory == TunnelCategoryUnspecified || category < result.TunnelCategory) {
+			result.TunnelCategory = category
+		}
+	}
+	return result
+}
+
+func containsHazardousGoods(list ShippedHazardousGoodsList, goods ShippedHazardousGoods) bool {
+	for _, g := range list {
+		if g == goods {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,31 @@
+package routingv8_test
+
+import (
+	"testing"
+
+	"go.einride.tech/here/routingv8"
+	"gotest.tools/v3/assert"
+)
+
+func TestHazmatTruck(t *testing.T) {
+	t.Parallel()
+	base := routingv8.Truck{GrossWeight: 40000}
+	flammable := routingv8.HazmatTruck(base, routingv8.ShippedHazardousGoodsFlammable)
+	assert.Equal(t, routingv8.TunnelCategory(routingv8.TunnelCategoryD), flammable.TunnelCategory)
+	assert.Equal(t, 40000, flammable.GrossWeight)
+	assert.Equal(t, 0, len(base.ShippedHazardousGoods))
+	mixed := routingv8.HazmatTruck(
+		flammable,
+		routingv8.ShippedHazardousGoodsExplosive,
+		routingv8.ShippedHazardousGoodsFlammable,
+	)
+	assert.DeepEqual(
+		t,
+		routingv8.ShippedHazardousGoodsList{
+			routingv8.ShippedHazardousGoodsFlammable,
+			routingv8.ShippedHazardousGoodsExplosive,
+		},
+		mixed.ShippedHazardousGoods,
+	)
+	assert.Equal(t, routingv8.TunnelCategory(routingv8.TunnelCategoryB), mixed.TunnelCategory)
+}
@@ -0,0 +1,123 @@
+package routingv8
+
+import (
+	"context"
+	"fmt"
+)
+
+const (
+	// heatmapMaxOrigins is the maximum number of grid points per matrix from the grid to the focus.
+	heatmapMaxOrigins = 15
+	// heatmapMaxDestinations is the maximum number of grid points per matrix from the focus to the grid.
+	heatmapMaxDestinations = 100
+)
+
+type HeatmapRequest struct {
+	// Bounds of the grid.
+	Bounds BoundingBox
+	// Rows and Columns of the grid, at least 2 each so that the grid spans the bounds.
+	Rows    int
+	Columns int
+	// Focus is the point the travel times are calculated from, or to if ToFocus is set.
+	Focus GeoWaypoint
+	// ToFocus calculates the travel times from the grid points to the focus.
+	ToFocus bool
+	// Body of the matrix calculations, e.g. with the Profile and the RegionDefinition. The origins, destinations
+	// and matrix attributes are set for each calculation.
+	Body CalculateMatrixBody
+}
+
+// Heatmap is a grid of travel times, ordered by row from north to south and by column from west to east.
+type Heatmap struct {
+	Rows    int
+	Columns int
+	// Points of the grid.
+	Points []GeoWaypoint
+	// TravelTimes in seconds between the focus and the grid points. Negative for unreachable grid points.
+	TravelTimes []int32
+}
+
+// At returns the point and the travel time of the grid cell at the row and column.
+func (h *Heatmap) At(row, column int) (GeoWaypoint, int32) {
+	i := row*h.Columns + column
+	return h.Points[i], h.TravelTimes[i]
+}
+
+// GridPoints returns rows times columns points evenly spaced over the bounding box, including its corners, ordered
+// by row from north to south and by column from west to east.
+func GridPoints(bounds BoundingBox, rows, columns int) []GeoWaypoint {
+	points := make([]GeoWaypoint, 0, rows*columns)
+	for row := 0; row < rows; row++ {
+		lat := bounds.North
+		if rows > 1 {
+			lat -= (bounds.North - bounds.South) * float64(row) / float64(rows-1)
+		}
+		for column := 0; column < columns; column++ {
+			long := bounds.West
+			if columns > 1 {
+				long += (bounds.East - bounds.West) * float64(column) / float64(columns-1)
+			}
+			points = append(points, GeoWaypoint{Lat: lat, Long: long})
+		}
+	}
+	return points
+}
+
+// Heatmap calculates the travel times between the focus and a grid of points over the bounds, for rendering
+// heatmaps of reachability. The grid is calculated in synchronous matrices small enough for CalculateMatrixSync.
+func (s *MatrixService) Heatmap(ctx context.Context, req *HeatmapRequest) (_ *Heatmap, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("heatmap: %w", err)
+		}
+	}()
+	if req.Rows < 2 || req.Columns < 2 {
+		return nil, &invalidInputError{err: fmt.Errorf("invalid grid: %dx%d, require at least 2x2", req.Rows, req.Columns)}
+	}
+	if req.Bounds.North <= req.Bounds.South || req.Bounds.East <= req.Bounds.West {
+		return nil, &invalidInputError{err: fmt.Errorf("invalid bounds: %+v", req.Bounds)}
+	}
+	heatmap := &Heatmap{
+		Rows:        req.Rows,
+		Columns:     req.Columns,
+		Points:      GridPoints(req.Bounds, req.Rows, req.Columns),
+		TravelTimes: make([]int32, 0, req.Rows*req.Columns),
+	}
+	chunkSize := heatmapMaxDestinations
+	if req.ToFocus {
+		chunkSize = heatmapMaxOrigins
+	}
+	focus := req.Focus
+	for start := 0; start < len(heatmap.Points); start += chunkSize {
+		end := start + chunkSize
+		if end > len(heatmap.Points) {
+			end = len(heatmap.Points)
+		}
+		chunk := make([]*GeoWaypoint, 0, end-start)
+		for i := start; i < end; i++ {
+			chunk = append(chunk, &heatmap.Points[i])
+		}
+		body := req.Body
+		body.MatrixAttributes = &MatrixAttributes{MatrixAttributeTravelTimes}
+		if req.ToFocus {
+			body.Origins, body.Destinations = chunk, []*GeoWaypoint{&focus}
+		} else {
+			body.Origins, body.Destinations = []*GeoWaypoint{&focus}, chunk
+		}
+		resp, err := s.CalculateMatrixSync(ctx, &body)
+		if err != nil {
+			return nil, err
+		}
+		matrix := &resp.Matrix
+		if len(matrix.TravelTimes) != len(chunk) {
+			return nil, fmt.Errorf("got %d travel times for %d grid points", len(matrix.TravelTimes), len(chunk))
+		}
+		for i, travelTime := range matrix.TravelTimes {
+			if len(matrix.ErrorCodes) == len(chunk) && matrix.ErrorCodes[i] != ErrorCodeSuccess {
+				travelTime = -1
+			}
+			heatmap.TravelTimes = append(heatmap.TravelTimes, travelTime)
+		}
+	}
+	return heatmap, nil
+}
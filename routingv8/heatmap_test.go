@@ -0,0 +1,108 @@
+package routingv8_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"go.einride.tech/here/routingv8"
+	"gotest.tools/v3/assert"
+)
+
+func TestGridPoints(t *testing.T) {
+	t.Parallel()
+	points := routingv8.GridPoints(routingv8.BoundingBox{North: 2, South: 0, East: 11, West: 10}, 3, 2)
+	assert.DeepEqual(t, []routingv8.GeoWaypoint{
+		{Lat: 2, Long: 10}, {Lat: 2, Long: 11},
+		{Lat: 1, Long: 10}, {Lat: 1, Long: 11},
+		{Lat: 0, Long: 10}, {Lat: 0, Long: 11},
+	}, points)
+}
+
+func TestMatrixService_Heatmap(t *testing.T) {
+	t.Parallel()
+	var sizes []string
+	client := routingv8.NewClient(httpClientFunc(func(req *http.Request) (*http.Response, error) {
+		var body struct {
+			Origins      []routingv8.GeoWaypoint `json:"origins"`
+			Destinations []routingv8.GeoWaypoint `json:"destinations"`
+		}
+		if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+			return nil, err
+		}
+		sizes = append(sizes, fmt.Sprintf("%dx%d", len(body.Origins), len(body.Destinations)))
+		grid := body.Destinations
+		if len(body.Origins) > 1 {
+			grid = body.Origins
+		}
+		travelTimes := make([]int32, len(grid))
+		errorCodes := make([]int, len(grid))
+		for i, p := range grid {
+			travelTimes[i] = int32(p.Lat*100 + p.Long)
+			if p.Lat == 0 && p.Long == 0 {
+				errorCodes[i] = routingv8.ErrorCodeDisconnected
+			}
+		}
+		resp, err := json.Marshal(map[string]interface{}{
+			"matrixId": "m1",
+			"matrix": map[string]interface{}{
+				"numOrigins":      len(body.Origins),
+				"numDestinations": len(body.Destinations),
+				"travelTimes":     travelTimes,
+				"errorCodes":      errorCodes,
+			},
+			"regionDefinition": map[string]string{"type": "world"},
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(string(resp)))}, nil
+	}))
+	heatmap, err := client.Matrix.Heatmap(context.Background(), &routingv8.HeatmapRequest{
+		Bounds:  routingv8.BoundingBox{North: 2, South: 0, East: 2, West: 0},
+		Rows:    3,
+		Columns: 3,
+		Focus:   routingv8.GeoWaypoint{Lat: 1, Long: 1},
+		Body:    routingv8.CalculateMatrixBody{RegionDefinition: routingv8.WorldRegion(), Profile: routingv8.ProfileCarFast},
+	})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, []string{"1x9"}, sizes)
+	point, travelTime := heatmap.At(0, 2)
+	assert.Equal(t, routingv8.GeoWaypoint{Lat: 2, Long: 2}, point)
+	assert.Equal(t, int32(202), travelTime)
+	_, travelTime = heatmap.At(2, 0)
+	assert.Equal(t, int32(-1), travelTime)
+
+	sizes = nil
+	heatmap, err = client.Matrix.Heatmap(context.Background(), &routingv8.HeatmapRequest{
+		Bounds:  routingv8.BoundingBox{North: 4, South: 1, East: 5, West: 1},
+		Rows:    4,
+		Columns: 5,
+		Focus:   routingv8.GeoWaypoint{Lat: 1, Long: 1},
+		ToFocus: true,
+		Body:    routingv8.CalculateMatrixBody{RegionDefinition: routingv8.WorldRegion(), Profile: routingv8.ProfileCarFast},
+	})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, []string{"15x1", "5x1"}, sizes)
+	assert.Equal(t, 20, len(heatmap.TravelTimes))
+	_, travelTime = heatmap.At(3, 4)
+	assert.Equal(t, int32(105), travelTime)
+}
+
+func TestMatrixService_Heatmap_Invalid(t *testing.T) {
+	t.Parallel()
+	client := routingv8.NewClient(httpClientFunc(func(req *http.Request) (*http.Response, error) {
+		t.Fatal("unexpected request")
+		return nil, nil
+	}))
+	_, err := client.Matrix.Heatmap(context.Background(), &routingv8.HeatmapRequest{
+		Bounds: routingv8.BoundingBox{North: 2, South: 0, East: 2, West: 0},
+		Rows:   1,
+	})
+	assert.Error(t, err, "heatmap: invalid grid: 1x0, require at least 2x2")
+	assert.Assert(t, routingv8.IsInvalidInput(err))
+}
@@ -0,0 +1,59 @@
+package routingv8
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// InstructionsByLanguage requests the route once per language and returns the actions of the first route,
+// keyed by language. The requests are sent concurrently and the Lang of req is ignored.
+func (s *RoutingService) InstructionsByLanguage(
+	ctx context.Context,
+	req *RoutesRequest,
+	langs []string,
+) (_ map[string][]Action, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("instructions by language: %v", err)
+		}
+	}()
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		firstErr error
+	)
+	result := make(map[string][]Action, len(langs))
+	for _, lang := range langs {
+		langReq := *req
+		langReq.Lang = lang
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			resp, err := s.Routes(ctx, &langReq)
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				if firstErr == nil {
+					firstErr = fmt.Errorf("%s: %v", langReq.Lang, err)
+					cancel()
+				}
+				return
+			}
+			var actions []Action
+			if len(resp.Routes) > 0 {
+				for _, section := range resp.Routes[0].Sections {
+					actions = append(actions, section.Actions...)
+				}
+			}
+			result[langReq.Lang] = actions
+		}()
+	}
+	wg.Wait()
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	return result, nil
+}
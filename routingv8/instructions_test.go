@@ -0,0 +1,99 @@
+package routingv8_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+
+	"go.einride.tech/here/routingv8"
+	"gotest.tools/v3/assert"
+)
+
+func TestRoutingService_InstructionsByLanguage(t *testing.T) {
+	t.Parallel()
+	instructions := map[string][2]string{
+		"en-GB": {"Head north.", "Arrive at your destination."},
+		"sv-SE": {"Kör norrut.", "Du är framme."},
+	}
+	for _, tt := range []struct {
+		name     string
+		langs    []string
+		expected map[string][]routingv8.Action
+		err      string
+	}{
+		{
+			name:  "sections merged per language",
+			langs: []string{"en-GB", "sv-SE"},
+			expected: map[string][]routingv8.Action{
+				"en-GB": {
+					{Action: "depart", Instruction: "Head north."},
+					{Action: "arrive", Instruction: "Arrive at your destination."},
+				},
+				"sv-SE": {
+					{Action: "depart", Instruction: "Kör norrut."},
+					{Action: "arrive", Instruction: "Du är framme."},
+				},
+			},
+		},
+		{
+			name:     "no languages",
+			expected: map[string][]routingv8.Action{},
+		},
+		{
+			name:     "no route",
+			langs:    []string{"de-DE"},
+			expected: map[string][]routingv8.Action{"de-DE": nil},
+		},
+		{
+			name:  "unsupported language",
+			langs: []string{"en-GB", "xx"},
+			err:   "instructions by language: xx: Title: Unsupported language",
+		},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			var mu sync.Mutex
+			var requested []string
+			client := routingv8.NewClient(httpClientFunc(func(req *http.Request) (*http.Response, error) {
+				lang := req.URL.Query().Get("lang")
+				mu.Lock()
+				requested = append(requested, lang)
+				mu.Unlock()
+				body := `{"routes":[]}`
+				status := http.StatusOK
+				switch texts, ok := instructions[lang]; {
+				case lang == "xx":
+					status = http.StatusBadRequest
+					body = `{"title":"Unsupported language","status":400}`
+				case ok:
+					body = `{"routes":[{"sections":[` +
+						`{"actions":[{"action":"depart","instruction":"` + texts[0] + `"}]},` +
+						`{"actions":[{"action":"arrive","instruction":"` + texts[1] + `"}]}]}]}`
+				}
+				return &http.Response{StatusCode: status, Body: io.NopCloser(strings.NewReader(body))}, nil
+			}))
+			actions, err := client.Routing.InstructionsByLanguage(context.Background(), &routingv8.RoutesRequest{
+				Origin:        routingv8.GeoWaypoint{Lat: 57.7, Long: 11.9},
+				Destination:   routingv8.GeoWaypoint{Lat: 57.8, Long: 11.9},
+				TransportMode: routingv8.TransportModeCar,
+				Lang:          "fi-FI",
+			}, tt.langs)
+			if tt.err != "" {
+				assert.ErrorContains(t, err, tt.err)
+				assert.Assert(t, actions == nil)
+				return
+			}
+			assert.NilError(t, err)
+			assert.DeepEqual(t, tt.expected, actions)
+			sort.Strings(requested)
+			langs := append([]string(nil), tt.langs...)
+			sort.Strings(langs)
+			assert.DeepEqual(t, langs, requested)
+		})
+	}
+}
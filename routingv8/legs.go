@@ -0,0 +1,38 @@
+package routingv8
+
+// Leg is the part of a route between two consecutive stopover waypoints, e.g. from the origin to the first via.
+type Leg struct {
+	// Sections of the leg, in order.
+	Sections []Section
+	// Departure of the first section.
+	Departure RoutePlace
+	// Arrival of the last section.
+	Arrival RoutePlace
+	// Summary of the leg, aggregated over its sections.
+	Summary Summary
+}
+
+// Legs groups the sections of the route into legs between the requested waypoints. A leg ends with a section
+// arriving at a requested waypoint, such as a via which is not passed through.
+func (r *Route) Legs() []Leg {
+	var legs []Leg
+	var leg Leg
+	for _, section := range r.Sections {
+		if len(leg.Sections) == 0 {
+			leg.Departure = section.Departure
+		}
+		leg.Sections = append(leg.Sections, section)
+		leg.Arrival = section.Arrival
+		leg.Summary.Duration += section.Summary.Duration
+		leg.Summary.Length += section.Summary.Length
+		leg.Summary.BaseDuration += section.Summary.BaseDuration
+		if section.Arrival.Place.Waypoint != nil {
+			legs = append(legs, leg)
+			leg = Leg{}
+		}
+	}
+	if len(leg.Sections) > 0 {
+		legs = append(legs, leg)
+	}
+	return legs
+}
@@ -0,0 +1,53 @@
+package routingv8_test
+
+import (
+	"testing"
+	"time"
+
+	"go.einride.tech/here/routingv8"
+	"gotest.tools/v3/assert"
+)
+
+func TestRoute_Legs(t *testing.T) {
+	t.Parallel()
+	waypoint := func(i int) *int { return &i }
+	route := routingv8.Route{
+		Sections: []routingv8.Section{
+			{
+				ID:      "drive",
+				Arrival: routingv8.RoutePlace{Place: routingv8.Place{Type: "place"}},
+				Summary: routingv8.Summary{Duration: 100, Length: 1000},
+			},
+			{
+				ID:      "ferry",
+				Arrival: routingv8.RoutePlace{Place: routingv8.Place{Waypoint: waypoint(1)}},
+				Summary: routingv8.Summary{Duration: 200, Length: 2000},
+			},
+			{
+				ID:      "last",
+				Arrival: routingv8.RoutePlace{Place: routingv8.Place{Waypoint: waypoint(2)}},
+				Summary: routingv8.Summary{Duration: 50, Length: 500},
+			},
+		},
+	}
+	legs := route.Legs()
+	assert.Equal(t, 2, len(legs))
+	assert.Equal(t, 2, len(legs[0].Sections))
+	assert.Equal(t, routingv8.Summary{Duration: 300, Length: 3000}, legs[0].Summary)
+	assert.Equal(t, 1, *legs[0].Arrival.Place.Waypoint)
+	assert.Equal(t, "last", legs[1].Sections[0].ID)
+}
+
+func TestVia_String(t *testing.T) {
+	t.Parallel()
+	assert.Equal(
+		t,
+		"57.7,11.9!stopDuration=600",
+		routingv8.Via{Location: routingv8.GeoWaypoint{Lat: 57.7, Long: 11.9}, StopDuration: 10 * time.Minute}.String(),
+	)
+	assert.Equal(
+		t,
+		"57.7,11.9!passThrough=true",
+		routingv8.Via{Location: routingv8.GeoWaypoint{Lat: 57.7, Long: 11.9}, PassThrough: true}.String(),
+	)
+}
@@ -0,0 +1,150 @@
+package routingv8
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.einride.tech/here/internal/hereapi"
+)
+
+// ScheduledMatrix is a matrix recalculated at an interval by a MatrixRefresher.
+type ScheduledMatrix struct {
+	// Name identifying the matrix in the snapshots.
+	Name string
+	// Body of the calculation. Leave the departure time unset to calculate the matrix with the traffic at the time
+	// of every refresh.
+	Body *CalculateMatrixBody
+	// Interval between the start of two calculations, e.g. 15 minutes.
+	Interval time.Duration
+}
+
+// MatrixSnapshot is the latest calculation of a ScheduledMatrix. Snapshots are shared by all readers and must not
+// be modified.
+type MatrixSnapshot struct {
+	// Name of the ScheduledMatrix.
+	Name string
+	// Response of the calculation.
+	Response *CalculateMatrixResponse
+	// CalculatedAt is the time the calculation was started, on the Clock of the Client.
+	CalculatedAt time.Time
+}
+
+// MatrixStore stores the snapshots of a MatrixRefresher, e.g. to share them with other processes. Implementations
+// must be safe for concurrent use.
+type MatrixStore interface {
+	// PutMatrix stores the latest snapshot of a matrix.
+	PutMatrix(ctx context.Context, snapshot *MatrixSnapshot) error
+}
+
+// MatrixRefresher recalculates scheduled matrices in the background and keeps the latest snapshot of every matrix,
+// so that dispatch systems can read recent travel times without waiting for a calculation.
+type MatrixRefresher struct {
+	service  *MatrixService
+	matrices []ScheduledMatrix
+
+	// Store receives every new snapshot. Optional.
+	Store MatrixStore
+	// OnError is called with the errors of failed calculations and stores. A failed calculation keeps the previous
+	// snapshot of the matrix. Optional.
+	OnError func(name string, err error)
+
+	// mu serializes the updates of snapshots.
+	mu sync.Mutex
+	// snapshots holds a map[string]*MatrixSnapshot, which is replaced on every update so that reads need no lock.
+	snapshots atomic.Value
+}
+
+// NewMatrixRefresher returns a MatrixRefresher of the matrices, which are calculated with CalculateMatrixAndWait
+// once Run is called.
+func (s *MatrixService) NewMatrixRefresher(matrices ...ScheduledMatrix) *MatrixRefresher {
+	r := &MatrixRefresher{service: s, matrices: append([]ScheduledMatrix(nil), matrices...)}
+	r.snapshots.Store(map[string]*MatrixSnapshot{})
+	return r
+}
+
+// Run calculates every matrix immediately and then at its interval, until the context is done. Run returns the
+// error of the context, or an error without calculating any matrix if the scheduled matrices are invalid.
+func (r *MatrixRefresher) Run(ctx context.Context) error {
+	names := make(map[string]bool, len(r.matrices))
+	for _, m := range r.matrices {
+		switch {
+		case names[m.Name]:
+			return fmt.Errorf("refresh matrices: duplicate matrix name %q", m.Name)
+		case m.Body == nil:
+			return fmt.Errorf("refresh matrices: matrix %q: missing body", m.Name)
+		case m.Interval <= 0:
+			return fmt.Errorf("refresh matrices: matrix %q: invalid interval: %v", m.Name, m.Interval)
+		}
+		names[m.Name] = true
+	}
+	var wg sync.WaitGroup
+	for _, m := range r.matrices {
+		m := m
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			r.schedule(ctx, m)
+		}()
+	}
+	wg.Wait()
+	return ctx.Err()
+}
+
+// schedule refreshes the matrix at its interval until the context is done. The interval is counted from the start
+// of the previous calculation, so that calculations slower than the interval are started back to back.
+func (r *MatrixRefresher) schedule(ctx context.Context, m ScheduledMatrix) {
+	clock := r.service.Client.clock()
+	for {
+		start := clock.Now()
+		if err := r.refresh(ctx, m, start); err != nil && ctx.Err() == nil && r.OnError != nil {
+			r.OnError(m.Name, err)
+		}
+		wait := m.Interval - clock.Now().Sub(start)
+		if wait < 0 {
+			wait = 0
+		}
+		if err := hereapi.Sleep(ctx, clock, wait); err != nil {
+			return
+		}
+	}
+}
+
+func (r *MatrixRefresher) refresh(ctx context.Context, m ScheduledMatrix, start time.Time) (err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("refresh matrix %q: %w", m.Name, err)
+		}
+	}()
+	resp, err := r.service.CalculateMatrixAndWait(ctx, m.Body)
+	if err != nil {
+		return err
+	}
+	snapshot := &MatrixSnapshot{Name: m.Name, Response: resp, CalculatedAt: start}
+	r.mu.Lock()
+	previous := r.snapshots.Load().(map[string]*MatrixSnapshot)
+	next := make(map[string]*MatrixSnapshot, len(previous)+1)
+	for name, s := range previous {
+		next[name] = s
+	}
+	next[m.Name] = snapshot
+	r.snapshots.Store(next)
+	r.mu.Unlock()
+	if r.Store != nil {
+		return r.Store.PutMatrix(ctx, snapshot)
+	}
+	return nil
+}
+
+// Snapshot returns the latest snapshot of the matrix, false if the matrix has not been calculated yet.
+func (r *MatrixRefresher) Snapshot(name string) (*MatrixSnapshot, bool) {
+	snapshot, ok := r.snapshots.Load().(map[string]*MatrixSnapshot)[name]
+	return snapshot, ok
+}
+
+// Snapshots returns the latest snapshots of all calculated matrices by name. The map must not be modified.
+func (r *MatrixRefresher) Snapshots() map[string]*MatrixSnapshot {
+	return r.snapshots.Load().(map[string]*MatrixSnapshot)
+}
@@ -0,0 +1,138 @@
+package routingv8_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"go.einride.tech/here/routingv8"
+	"gotest.tools/v3/assert"
+)
+
+type matrixStoreFunc func(context.Context, *routingv8.MatrixSnapshot) error
+
+func (f matrixStoreFunc) PutMatrix(ctx context.Context, snapshot *routingv8.MatrixSnapshot) error {
+	return f(ctx, snapshot)
+}
+
+func TestMatrixRefresher_Run(t *testing.T) {
+	t.Parallel()
+	var mu sync.Mutex
+	var calculations int
+	client := routingv8.NewClient(httpClientFunc(func(req *http.Request) (*http.Response, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		body := `{"matrixId":"m1","status":"completed"}`
+		if req.Method == http.MethodGet {
+			calculations++
+			body = `{"matrixId":"m1","matrix":{"numOrigins":1,"numDestinations":1,"travelTimes":[` +
+				strconv.Itoa(60*calculations) + `]}}`
+		}
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(body))}, nil
+	}))
+	clock := &fakeClock{}
+	client.Clock = clock
+	refresher := client.Matrix.NewMatrixRefresher(routingv8.ScheduledMatrix{
+		Name: "depots",
+		Body: &routingv8.CalculateMatrixBody{
+			Origins:          []*routingv8.GeoWaypoint{{Lat: 57.707752, Long: 11.949767}},
+			RegionDefinition: routingv8.RegionDefinition{Type: routingv8.RegionTypeWorld},
+		},
+		Interval: 15 * time.Minute,
+	})
+	_, ok := refresher.Snapshot("depots")
+	assert.Assert(t, !ok)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var stored []time.Time
+	refresher.Store = matrixStoreFunc(func(_ context.Context, snapshot *routingv8.MatrixSnapshot) error {
+		latest, ok := refresher.Snapshot("depots")
+		assert.Assert(t, ok)
+		assert.Assert(t, latest == snapshot, "stored snapshots are readable")
+		if stored = append(stored, snapshot.CalculatedAt); len(stored) == 3 {
+			cancel()
+		}
+		return nil
+	})
+	assert.ErrorIs(t, refresher.Run(ctx), context.Canceled)
+	assert.DeepEqual(t, []time.Time{{}, time.Time{}.Add(15 * time.Minute), time.Time{}.Add(30 * time.Minute)}, stored)
+	snapshot, ok := refresher.Snapshot("depots")
+	assert.Assert(t, ok)
+	assert.DeepEqual(t, []int32{180}, snapshot.Response.Matrix.TravelTimes)
+	assert.Equal(t, 1, len(refresher.Snapshots()))
+}
+
+func TestMatrixRefresher_Run_Error(t *testing.T) {
+	t.Parallel()
+	client := routingv8.NewClient(httpClientFunc(func(req *http.Request) (*http.Response, error) {
+		return nil, errors.New("connection refused")
+	}))
+	client.Clock = &fakeClock{}
+	refresher := client.Matrix.NewMatrixRefresher(routingv8.ScheduledMatrix{
+		Name: "depots",
+		Body: &routingv8.CalculateMatrixBody{
+			RegionDefinition: routingv8.RegionDefinition{Type: routingv8.RegionTypeWorld},
+		},
+		Interval: time.Minute,
+	})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var errs []error
+	refresher.OnError = func(name string, err error) {
+		assert.Equal(t, "depots", name)
+		errs = append(errs, err)
+		cancel()
+	}
+	assert.ErrorIs(t, refresher.Run(ctx), context.Canceled)
+	assert.Equal(t, 1, len(errs))
+	assert.ErrorContains(t, errs[0], `refresh matrix "depots": `)
+	assert.ErrorContains(t, errs[0], "connection refused")
+	_, ok := refresher.Snapshot("depots")
+	assert.Assert(t, !ok, "failed calculations are not stored")
+}
+
+func TestMatrixRefresher_Run_Invalid(t *testing.T) {
+	t.Parallel()
+	client := routingv8.NewClient(httpClientFunc(func(req *http.Request) (*http.Response, error) {
+		t.Fatal("unexpected request")
+		return nil, nil
+	}))
+	body := &routingv8.CalculateMatrixBody{}
+	for _, tt := range []struct {
+		name     string
+		matrices []routingv8.ScheduledMatrix
+		err      string
+	}{
+		{
+			name:     "interval",
+			matrices: []routingv8.ScheduledMatrix{{Name: "a", Body: body}},
+			err:      `refresh matrices: matrix "a": invalid interval: 0s`,
+		},
+		{
+			name:     "body",
+			matrices: []routingv8.ScheduledMatrix{{Name: "a", Interval: time.Minute}},
+			err:      `refresh matrices: matrix "a": missing body`,
+		},
+		{
+			name: "duplicate",
+			matrices: []routingv8.ScheduledMatrix{
+				{Name: "a", Body: body, Interval: time.Minute},
+				{Name: "a", Body: body, Interval: time.Minute},
+			},
+			err: `refresh matrices: duplicate matrix name "a"`,
+		},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			err := client.Matrix.NewMatrixRefresher(tt.matrices...).Run(context.Background())
+			assert.Error(t, err, tt.err)
+		})
+	}
+}
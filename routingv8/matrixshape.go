@@ -0,0 +1,82 @@
+package routingv8
+
+import "fmt"
+
+// Transpose returns the matrix with origins and destinations swapped. The values still describe the routes in the
+// original direction, which may differ from the reverse routes, e.g. due to one-way streets. An error is returned if
+// the matrix is inconsistent, e.g. has fewer values than origins times destinations.
+func (m *MatrixResponse) Transpose() (*MatrixResponse, error) {
+	if err := m.Validate(); err != nil {
+		return nil, fmt.Errorf("transpose matrix: %w", err)
+	}
+	result := &MatrixResponse{NumOrigins: m.NumDestinations, NumDestinations: m.NumOrigins}
+	result.TravelTimes = transposeInt32(m.TravelTimes, m.NumOrigins, m.NumDestinations)
+	result.Distances = transposeInt32(m.Distances, m.NumOrigins, m.NumDestinations)
+	if m.ErrorCodes != nil {
+		result.ErrorCodes = make(ErrorCodes, len(m.ErrorCodes))
+		for i := 0; i < m.NumOrigins; i++ {
+			for j := 0; j < m.NumDestinations; j++ {
+				result.ErrorCodes[j*m.NumOrigins+i] = m.ErrorCodes[i*m.NumDestinations+j]
+			}
+		}
+	}
+	return result, nil
+}
+
+// transposeInt32 transposes the values of a matrix with the rows and columns, which must have rows times columns
+// values or be nil.
+func transposeInt32(values []int32, rows, columns int) []int32 {
+	if values == nil {
+		return nil
+	}
+	result := make([]int32, len(values))
+	for i := 0; i < rows; i++ {
+		for j := 0; j < columns; j++ {
+			result[j*rows+i] = values[i*columns+j]
+		}
+	}
+	return result
+}
+
+// Slice returns the submatrix of the given origin and destination indices, in the given order.
+func (m *MatrixResponse) Slice(originIdx []int, destIdx []int) (*MatrixResponse, error) {
+	for _, i := range originIdx {
+		if i < 0 || i >= m.NumOrigins {
+			return nil, fmt.Errorf("slice matrix: origin index %d out of range [0, %d)", i, m.NumOrigins)
+		}
+	}
+	for _, j := range destIdx {
+		if j < 0 || j >= m.NumDestinations {
+			return nil, fmt.Errorf("slice matrix: destination index %d out of range [0, %d)", j, m.NumDestinations)
+		}
+	}
+	if err := m.Validate(); err != nil {
+		return nil, fmt.Errorf("slice matrix: %w", err)
+	}
+	result := &MatrixResponse{NumOrigins: len(originIdx), NumDestinations: len(destIdx)}
+	size := len(originIdx) * len(destIdx)
+	if m.TravelTimes != nil {
+		result.TravelTimes = make([]int32, 0, size)
+	}
+	if m.Distances != nil {
+		result.Distances = make([]int32, 0, size)
+	}
+	if m.ErrorCodes != nil {
+		result.ErrorCodes = make(ErrorCodes, 0, size)
+	}
+	for _, i := range originIdx {
+		for _, j := range destIdx {
+			k := i*m.NumDestinations + j
+			if m.TravelTimes != nil {
+				result.TravelTimes = append(result.TravelTimes, m.TravelTimes[k])
+			}
+			if m.Distances != nil {
+				result.Distances = append(result.Distances, m.Distances[k])
+			}
+			if m.ErrorCodes != nil {
+				result.ErrorCodes = append(result.ErrorCodes, m.ErrorCodes[k])
+			}
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,77 @@
+package routingv8_test
+
+import (
+	"testing"
+
+	"go.einride.tech/here/routingv8"
+	"gotest.tools/v3/assert"
+)
+
+func TestMatrixResponse_Transpose(t *testing.T) {
+	t.Parallel()
+	m := &routingv8.MatrixResponse{
+		NumOrigins:      2,
+		NumDestinations: 3,
+		TravelTimes:     []int32{1, 2, 3, 4, 5, 6},
+		ErrorCodes:      routingv8.ErrorCodes{0, 0, 1, 0, 0, 0},
+	}
+	transposed, err := m.Transpose()
+	assert.NilError(t, err)
+	assert.DeepEqual(t, &routingv8.MatrixResponse{
+		NumOrigins:      3,
+		NumDestinations: 2,
+		TravelTimes:     []int32{1, 4, 2, 5, 3, 6},
+		ErrorCodes:      routingv8.ErrorCodes{0, 0, 0, 0, 1, 0},
+	}, transposed)
+	original, err := transposed.Transpose()
+	assert.NilError(t, err)
+	assert.DeepEqual(t, m, original)
+}
+
+func TestMatrixResponse_Transpose_Inconsistent(t *testing.T) {
+	t.Parallel()
+	for _, tt := range []struct {
+		name   string
+		matrix routingv8.MatrixResponse
+		err    string
+	}{
+		{
+			name:   "travel times",
+			matrix: routingv8.MatrixResponse{NumOrigins: 2, NumDestinations: 3, TravelTimes: []int32{1, 2, 3, 4}},
+			err:    "matrix.travelTimes: got 4 values for 2x3 matrix",
+		},
+		{
+			name:   "error codes",
+			matrix: routingv8.MatrixResponse{NumOrigins: 2, NumDestinations: 3, ErrorCodes: routingv8.ErrorCodes{0, 0}},
+			err:    "matrix.errorCodes: got 2 values for 2x3 matrix",
+		},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			_, err := tt.matrix.Transpose()
+			assert.ErrorContains(t, err, "transpose matrix: ")
+			assert.ErrorContains(t, err, tt.err)
+		})
+	}
+}
+
+func TestMatrixResponse_Slice(t *testing.T) {
+	t.Parallel()
+	m := &routingv8.MatrixResponse{
+		NumOrigins:      2,
+		NumDestinations: 3,
+		TravelTimes:     []int32{1, 2, 3, 4, 5, 6},
+		Distances:       []int32{10, 20, 30, 40, 50, 60},
+	}
+	actual, err := m.Slice([]int{1}, []int{2, 0})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, &routingv8.MatrixResponse{
+		NumOrigins:      1,
+		NumDestinations: 2,
+		TravelTimes:     []int32{6, 4},
+		Distances:       []int32{60, 40},
+	}, actual)
+	_, err = m.Slice([]int{2}, nil)
+	assert.Error(t, err, "slice matrix: origin index 2 out of range [0, 2)")
+}
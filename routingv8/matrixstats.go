@@ -0,0 +1,72 @@
+package routingv8
+
+import (
+	"math"
+	"sort"
+)
+
+// MatrixStats contains statistics of the travel times and distances of a matrix.
+type MatrixStats struct {
+	// TravelTimes statistics in seconds.
+	TravelTimes Stats
+	// Distances statistics in meters.
+	Distances Stats
+}
+
+// Stats contains summary statistics of a set of values.
+type Stats struct {
+	// Count of the values.
+	Count int
+	Min   float64
+	Max   float64
+	Mean  float64
+	// P50 is the median.
+	P50 float64
+	P90 float64
+	P95 float64
+	P99 float64
+}
+
+// Stats returns statistics of the travel times and distances of the matrix, excluding entries with an error code.
+func (m *MatrixResponse) Stats() MatrixStats {
+	return MatrixStats{
+		TravelTimes: m.stats(m.TravelTimes),
+		Distances:   m.stats(m.Distances),
+	}
+}
+
+func (m *MatrixResponse) stats(values []int32) Stats {
+	included := make([]float64, 0, len(values))
+	for i, value := range values {
+		if i < len(m.ErrorCodes) && m.ErrorCodes[i] != ErrorCodeSuccess {
+			continue
+		}
+		included = append(included, float64(value))
+	}
+	if len(included) == 0 {
+		return Stats{}
+	}
+	sort.Float64s(included)
+	var sum float64
+	for _, value := range included {
+		sum += value
+	}
+	return Stats{
+		Count: len(included),
+		Min:   included[0],
+		Max:   included[len(included)-1],
+		Mean:  sum / float64(len(included)),
+		P50:   percentile(included, 50),
+		P90:   percentile(included, 90),
+		P95:   percentile(included, 95),
+		P99:   percentile(included, 99),
+	}
+}
+
+// percentile returns the p-th percentile of the sorted values, using linear interpolation between closest ranks.
+func percentile(sorted []float64, p float64) float64 {
+	rank := p / 100 * float64(len(sorted)-1)
+	lower := int(math.Floor(rank))
+	upper := int(math.Ceil(rank))
+	return sorted[lower] + (sorted[upper]-sorted[lower])*(rank-float64(lower))
+}
@@ -0,0 +1,35 @@
+package routingv8_test
+
+import (
+	"testing"
+
+	"go.einride.tech/here/routingv8"
+	"gotest.tools/v3/assert"
+)
+
+func TestMatrixResponse_Stats(t *testing.T) {
+	t.Parallel()
+	matrix := routingv8.MatrixResponse{
+		NumOrigins:      2,
+		NumDestinations: 3,
+		TravelTimes:     []int32{0, 10, 20, 30, 40, 0},
+		Distances:       []int32{0, 100, 200, 300, 400, 0},
+		ErrorCodes: routingv8.ErrorCodes{
+			routingv8.ErrorCodeSuccess,
+			routingv8.ErrorCodeSuccess,
+			routingv8.ErrorCodeSuccess,
+			routingv8.ErrorCodeSuccess,
+			routingv8.ErrorCodeSuccess,
+			routingv8.ErrorCodeDisconnected,
+		},
+	}
+	stats := matrix.Stats()
+	assert.Equal(t, 5, stats.TravelTimes.Count)
+	assert.Equal(t, 0.0, stats.TravelTimes.Min)
+	assert.Equal(t, 40.0, stats.TravelTimes.Max)
+	assert.Equal(t, 20.0, stats.TravelTimes.Mean)
+	assert.Equal(t, 20.0, stats.TravelTimes.P50)
+	assert.Equal(t, 36.0, stats.TravelTimes.P90)
+	assert.Equal(t, 400.0, stats.Distances.Max)
+	assert.Equal(t, routingv8.Stats{}, (&routingv8.MatrixResponse{}).Stats().Distances)
+}
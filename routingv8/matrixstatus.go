@@ -0,0 +1,140 @@
+package routingv8
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"go.einride.tech/here/internal/hereapi"
+)
+
+const (
+	// MatrixStatusAccepted is the status of a matrix calculation waiting to be processed.
+	MatrixStatusAccepted = "accepted"
+	// MatrixStatusInProgress is the status of a matrix calculation being processed.
+	MatrixStatusInProgress = "inProgress"
+	// MatrixStatusCompleted is the status of a matrix calculation with a result to download.
+	MatrixStatusCompleted = "completed"
+	// MatrixStatusCancelled is the status of a deleted matrix calculation.
+	MatrixStatusCancelled = "cancelled"
+	// MatrixStatusFailed is the status of a failed matrix calculation, see MatrixStatusResponse.Error.
+	MatrixStatusFailed = "failed"
+)
+
+// MatrixStatusResponse is the status of an asynchronous matrix calculation.
+type MatrixStatusResponse struct {
+	// MatrixID is the unique identifier of the matrix.
+	MatrixID string `json:"matrixId"`
+	// Status of the calculation, e.g. MatrixStatusInProgress.
+	Status string `json:"status"`
+	// StatusURL is the URL to poll for the status.
+	StatusURL string `json:"statusUrl,omitempty"`
+	// ResultURL is the URL to download the result from, once completed.
+	ResultURL string `json:"resultUrl,omitempty"`
+	// Error of a failed calculation.
+	Error *HereErrorResponse `json:"error,omitempty"`
+	// RetryAfter is the polling interval suggested by the Retry-After header of the response, zero if the server
+	// did not suggest one.
+	RetryAfter time.Duration `json:"-"`
+}
+
+// rawStatusResponse is the body of a status response with the interval suggested by its Retry-After header.
+type rawStatusResponse struct {
+	body       json.RawMessage
+	retryAfter time.Duration
+}
+
+func (r *rawStatusResponse) receiveHeader(header http.Header) {
+	r.retryAfter = hereapi.RetryAfter(header)
+}
+
+func (r *rawStatusResponse) UnmarshalJSON(b []byte) error {
+	r.body = append(r.body[:0], b...)
+	return nil
+}
+
+// Done reports whether the calculation has finished, successfully or not.
+func (r *MatrixStatusResponse) Done() bool {
+	switch r.Status {
+	case MatrixStatusCompleted, MatrixStatusCancelled, MatrixStatusFailed:
+		return true
+	default:
+		return false
+	}
+}
+
+// MatrixStatus returns the status of an asynchronous matrix calculation.
+// HTTP clients following the redirect of completed calculations receive the result instead of the status, which
+// is reported as completed.
+func (s *MatrixService) MatrixStatus(ctx context.Context, matrixID string) (_ *MatrixStatusResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("matrix status %s: %w", matrixID, err)
+		}
+	}()
+	u, err := s.URL.Parse("matrix/" + matrixID + "/status")
+	if err != nil {
+		return nil, err
+	}
+	r, err := s.Client.NewRequest(ctx, u, http.MethodGet, "", nil)
+	if err != nil {
+		return nil, err
+	}
+	var raw rawStatusResponse
+	if err := s.Client.Do(r, &raw); err != nil {
+		return nil, err
+	}
+	var resp struct {
+		MatrixStatusResponse
+		Matrix *json.RawMessage `json:"matrix"`
+	}
+	if err := json.Unmarshal(raw.body, &resp); err != nil {
+		return nil, err
+	}
+	if resp.Status == "" && resp.Matrix != nil {
+		resp.Status = MatrixStatusCompleted
+	}
+	resp.RetryAfter = raw.retryAfter
+	return &resp.MatrixStatusResponse, nil
+}
+
+// MatrixStatusUpdate is sent by WatchMatrixStatus for every poll of the status.
+type MatrixStatusUpdate struct {
+	// Status of the calculation, nil if polling failed.
+	Status *MatrixStatusResponse
+	// Err is the error of polling the status.
+	Err error
+}
+
+// WatchMatrixStatus polls the status of an asynchronous matrix calculation with the PollBackoff of the Client, which
+// is passed the interval suggested by the Retry-After header of every status, and sends every status on the
+// returned channel. The channel is closed after the calculation is done, polling failed
+// or the context is done.
+func (s *MatrixService) WatchMatrixStatus(ctx context.Context, matrixID string) <-chan MatrixStatusUpdate {
+	updates := make(chan MatrixStatusUpdate)
+	backoff := s.Client.PollBackoff
+	if backoff == nil {
+		backoff = DefaultPollBackoff
+	}
+	clock := s.Client.clock()
+	go func() {
+		defer close(updates)
+		for attempt := 1; ; attempt++ {
+			status, err := s.MatrixStatus(ctx, matrixID)
+			select {
+			case updates <- MatrixStatusUpdate{Status: status, Err: err}:
+			case <-ctx.Done():
+				return
+			}
+			if err != nil || status.Done() {
+				return
+			}
+			if err := hereapi.Sleep(ctx, clock, backoff.Delay(attempt, status.RetryAfter)); err != nil {
+				return
+			}
+		}
+	}()
+	return updates
+}
@@ -0,0 +1,88 @@
+package routingv8_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"go.einride.tech/here/routingv8"
+	"gotest.tools/v3/assert"
+)
+
+func TestMatrixService_WatchMatrixStatus(t *testing.T) {
+	t.Parallel()
+	responses := []string{
+		`{"matrixId":"m1","status":"accepted"}`,
+		`{"matrixId":"m1","status":"inProgress"}`,
+		`{"matrixId":"m1","matrix":{"numOrigins":1,"numDestinations":1},"regionDefinition":{"type":"world"}}`,
+	}
+	var paths []string
+	client := routingv8.NewClient(httpClientFunc(func(req *http.Request) (*http.Response, error) {
+		paths = append(paths, req.URL.Path)
+		body := responses[0]
+		responses = responses[1:]
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(body))}, nil
+	}))
+	clock := &fakeClock{}
+	client.Clock = clock
+	client.PollBackoff = routingv8.ConstantBackoff{Interval: 3 * time.Second}
+	var statuses []string
+	for update := range client.Matrix.WatchMatrixStatus(context.Background(), "m1") {
+		assert.NilError(t, update.Err)
+		statuses = append(statuses, update.Status.Status)
+	}
+	assert.DeepEqual(t, []string{"accepted", "inProgress", "completed"}, statuses)
+	assert.DeepEqual(t, []time.Duration{3 * time.Second, 3 * time.Second}, clock.waits)
+	assert.Equal(t, "/v8/matrix/m1/status", paths[0])
+}
+
+func TestMatrixService_WatchMatrixStatus_RetryAfter(t *testing.T) {
+	t.Parallel()
+	responses := []string{
+		`{"matrixId":"m1","status":"inProgress"}`,
+		`{"matrixId":"m1","status":"inProgress"}`,
+		`{"matrixId":"m1","status":"completed"}`,
+	}
+	retryAfter := []string{"7", "", ""}
+	client := routingv8.NewClient(httpClientFunc(func(req *http.Request) (*http.Response, error) {
+		body, header := responses[0], http.Header{}
+		if retryAfter[0] != "" {
+			header.Set("Retry-After", retryAfter[0])
+		}
+		responses, retryAfter = responses[1:], retryAfter[1:]
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			Header:     header,
+			Body:       io.NopCloser(strings.NewReader(body)),
+		}, nil
+	}))
+	clock := &fakeClock{}
+	client.Clock = clock
+	var suggested []time.Duration
+	for update := range client.Matrix.WatchMatrixStatus(context.Background(), "m1") {
+		assert.NilError(t, update.Err)
+		suggested = append(suggested, update.Status.RetryAfter)
+	}
+	assert.DeepEqual(t, []time.Duration{7 * time.Second, 0, 0}, suggested)
+	// DefaultPollBackoff waits the suggested interval, and falls back to its exponential backoff.
+	assert.DeepEqual(t, []time.Duration{7 * time.Second, time.Second}, clock.waits)
+}
+
+func TestMatrixService_WatchMatrixStatus_Error(t *testing.T) {
+	t.Parallel()
+	client := routingv8.NewClient(httpClientFunc(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: http.StatusNotFound,
+			Body:       io.NopCloser(strings.NewReader(`{"title":"Matrix not found","status":404}`)),
+		}, nil
+	}))
+	var updates []routingv8.MatrixStatusUpdate
+	for update := range client.Matrix.WatchMatrixStatus(context.Background(), "m1") {
+		updates = append(updates, update)
+	}
+	assert.Equal(t, 1, len(updates))
+	assert.ErrorContains(t, updates[0].Err, "matrix status m1: Title: Matrix not found")
+}
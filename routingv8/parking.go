@@ -0,0 +1,112 @@
+package routingv8
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Parking is a place to park a vehicle.
+type Parking struct {
+	// ID of the parking, e.g. of a search result.
+	ID string
+	// Name of the parking.
+	Name string
+	// Location of the parking.
+	Location GeoWaypoint
+}
+
+// ParkingFinder finds parking near a location, ordered by preference. The here package provides a finder of the
+// parking places of the geocodingv7 Browse endpoint, see here.Client.ParkingFinder.
+type ParkingFinder interface {
+	FindParking(ctx context.Context, near GeoWaypoint) ([]Parking, error)
+}
+
+// ParkingFinderFunc adapts a function to the ParkingFinder interface.
+type ParkingFinderFunc func(ctx context.Context, near GeoWaypoint) ([]Parking, error)
+
+// FindParking calls f(ctx, near).
+func (f ParkingFinderFunc) FindParking(ctx context.Context, near GeoWaypoint) ([]Parking, error) {
+	return f(ctx, near)
+}
+
+// DriveParkWalkPlan is a trip driving to a parking near the destination and walking the remaining distance.
+type DriveParkWalkPlan struct {
+	// Parking used.
+	Parking Parking
+	// Drive is the route from the origin to the parking.
+	Drive Route
+	// Walk is the route from the parking to the destination.
+	Walk Route
+	// Duration of the trip, excluding the time to park.
+	Duration time.Duration
+}
+
+// DriveParkWalk plans a trip driving to the parking preferred by the finder near the destination, and walking from
+// there to the destination. Other parameters of the drive are taken from req, whose transport mode has to be car or
+// taxi.
+func (s *RoutingService) DriveParkWalk(
+	ctx context.Context,
+	req *RoutesRequest,
+	finder ParkingFinder,
+) (_ *DriveParkWalkPlan, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("drive park walk: %w", err)
+		}
+	}()
+	if req.TransportMode != TransportModeCar && req.TransportMode != TransportModeTaxi {
+		return nil, fmt.Errorf("invalid transport mode: %s", req.TransportMode.String())
+	}
+	parkings, err := finder.FindParking(ctx, req.Destination)
+	if err != nil {
+		return nil, err
+	}
+	if len(parkings) == 0 {
+		return nil, fmt.Errorf("no parking near destination")
+	}
+	plan := DriveParkWalkPlan{Parking: parkings[0]}
+	drive := *req
+	drive.Destination = plan.Parking.Location
+	drive.Alternatives = NoAlternatives
+	if plan.Drive, err = firstRoute(ctx, s, &drive); err != nil {
+		return nil, fmt.Errorf("drive: %w", err)
+	}
+	walk := RoutesRequest{
+		Origin:        plan.Parking.Location,
+		Destination:   req.Destination,
+		TransportMode: TransportModePedestrian,
+		Lang:          req.Lang,
+		Alternatives:  NoAlternatives,
+	}
+	if arrival := lastArrival(&plan.Drive); !arrival.IsZero() {
+		walk.DepartureTime = DepartAt(arrival)
+	}
+	if plan.Walk, err = firstRoute(ctx, s, &walk); err != nil {
+		return nil, fmt.Errorf("walk: %w", err)
+	}
+	for _, route := range []*Route{&plan.Drive, &plan.Walk} {
+		for _, section := range route.Sections {
+			plan.Duration += time.Duration(section.Summary.Duration) * time.Second
+		}
+	}
+	return &plan, nil
+}
+
+func firstRoute(ctx context.Context, s *RoutingService, req *RoutesRequest) (Route, error) {
+	resp, err := s.Routes(ctx, req)
+	if err != nil {
+		return Route{}, err
+	}
+	if len(resp.Routes) == 0 {
+		return Route{}, fmt.Errorf("no route")
+	}
+	return resp.Routes[0], nil
+}
+
+func lastArrival(route *Route) time.Time {
+	if len(route.Sections) == 0 {
+		return time.Time{}
+	}
+	return route.Sections[len(route.Sections)-1].Arrival.Time
+}
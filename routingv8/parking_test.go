@@ -0,0 +1,57 @@
+package routingv8_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"go.einride.tech/here/routingv8"
+	"gotest.tools/v3/assert"
+)
+
+func TestRoutingService_DriveParkWalk(t *testing.T) {
+	t.Parallel()
+	var queries []url.Values
+	client := routingv8.NewClient(httpClientFunc(func(req *http.Request) (*http.Response, error) {
+		queries = append(queries, req.URL.Query())
+		duration := int32(1200)
+		if req.URL.Query().Get("transportMode") == "pedestrian" {
+			duration = 300
+		}
+		b, err := json.Marshal(routingv8.RoutesResponse{
+			Routes: []routingv8.Route{{Sections: []routingv8.Section{{Summary: routingv8.Summary{Duration: duration}}}}},
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(string(b)))}, nil
+	}))
+	destination := routingv8.GeoWaypoint{Lat: 57.7, Long: 11.97}
+	parking := routingv8.Parking{Name: "Nordstan", Location: routingv8.GeoWaypoint{Lat: 57.708, Long: 11.969}}
+	finder := routingv8.ParkingFinderFunc(
+		func(ctx context.Context, near routingv8.GeoWaypoint) ([]routingv8.Parking, error) {
+			assert.Equal(t, destination, near)
+			return []routingv8.Parking{parking}, nil
+		},
+	)
+	plan, err := client.Routing.DriveParkWalk(context.Background(), &routingv8.RoutesRequest{
+		Origin:        routingv8.GeoWaypoint{Lat: 57.65, Long: 11.9},
+		Destination:   destination,
+		TransportMode: routingv8.TransportModeTaxi,
+		Taxi:          &routingv8.Taxi{AllowDriveThroughTaxiRoads: true},
+	}, finder)
+	assert.NilError(t, err)
+	assert.Equal(t, "Nordstan", plan.Parking.Name)
+	assert.Equal(t, 25*time.Minute, plan.Duration)
+	assert.Equal(t, 2, len(queries))
+	assert.Equal(t, "taxi", queries[0].Get("transportMode"))
+	assert.Equal(t, "true", queries[0].Get("taxi[allowDriveThroughTaxiRoads]"))
+	assert.Equal(t, "57.708,11.969", queries[0].Get("destination"))
+	assert.Equal(t, "pedestrian", queries[1].Get("transportMode"))
+	assert.Equal(t, "57.7,11.97", queries[1].Get("destination"))
+}
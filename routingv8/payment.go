@@ -0,0 +1,63 @@
+package routingv8
+
+import "fmt"
+
+// PaymentMethod is a method accepted for paying a toll fare.
+type PaymentMethod string
+
+const (
+	PaymentMethodCash             PaymentMethod = "cash"
+	PaymentMethodCashExact        PaymentMethod = "cashExact"
+	PaymentMethodCashCoinsOnly    PaymentMethod = "cashCoinsOnly"
+	PaymentMethodCashBillsOnly    PaymentMethod = "cashBillsOnly"
+	PaymentMethodBankCard         PaymentMethod = "bankCard"
+	PaymentMethodCreditCard       PaymentMethod = "creditCard"
+	PaymentMethodDebitCard        PaymentMethod = "debitCard"
+	PaymentMethodPassSubscription PaymentMethod = "passSubscription"
+	PaymentMethodTransponder      PaymentMethod = "transponder"
+	PaymentMethodTravelCard       PaymentMethod = "travelCard"
+	PaymentMethodVideoToll        PaymentMethod = "videoToll"
+)
+
+// ParsePaymentMethod returns the payment method with the value, or an error for unknown payment methods.
+func ParsePaymentMethod(value string) (PaymentMethod, error) {
+	switch method := PaymentMethod(value); method {
+	case PaymentMethodCash,
+		PaymentMethodCashExact,
+		PaymentMethodCashCoinsOnly,
+		PaymentMethodCashBillsOnly,
+		PaymentMethodBankCard,
+		PaymentMethodCreditCard,
+		PaymentMethodDebitCard,
+		PaymentMethodPassSubscription,
+		PaymentMethodTransponder,
+		PaymentMethodTravelCard,
+		PaymentMethodVideoToll:
+		return method, nil
+	default:
+		return "", fmt.Errorf("invalid payment method: %q", value)
+	}
+}
+
+// IsPayableBy reports whether the fare accepts any of the payment methods.
+func (f *Fare) IsPayableBy(methods ...PaymentMethod) bool {
+	for _, accepted := range f.PaymentMethods {
+		for _, method := range methods {
+			if accepted == method {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// FaresPayableBy returns the fares of the toll accepting any of the payment methods.
+func (t *Toll) FaresPayableBy(methods ...PaymentMethod) []Fare {
+	var fares []Fare
+	for _, fare := range t.Fares {
+		if fare.IsPayableBy(methods...) {
+			fares = append(fares, fare)
+		}
+	}
+	return fares
+}
@@ -0,0 +1,38 @@
+package routingv8_test
+
+import (
+	"testing"
+
+	"go.einride.tech/here/routingv8"
+	"gotest.tools/v3/assert"
+)
+
+func TestParsePaymentMethod(t *testing.T) {
+	t.Parallel()
+	method, err := routingv8.ParsePaymentMethod("videoToll")
+	assert.NilError(t, err)
+	assert.Equal(t, routingv8.PaymentMethodVideoToll, method)
+	_, err = routingv8.ParsePaymentMethod("barter")
+	assert.Error(t, err, `invalid payment method: "barter"`)
+}
+
+func TestToll_FaresPayableBy(t *testing.T) {
+	t.Parallel()
+	toll := routingv8.Toll{
+		Fares: []routingv8.Fare{
+			{
+				ID:             "1",
+				PaymentMethods: []routingv8.PaymentMethod{routingv8.PaymentMethodCash, routingv8.PaymentMethodBankCard},
+			},
+			{
+				ID:             "2",
+				PaymentMethods: []routingv8.PaymentMethod{routingv8.PaymentMethodTransponder},
+			},
+		},
+	}
+	fares := toll.FaresPayableBy(routingv8.PaymentMethodTransponder, routingv8.PaymentMethodVideoToll)
+	assert.Equal(t, 1, len(fares))
+	assert.Equal(t, "2", fares[0].ID)
+	assert.Equal(t, 2, len(toll.FaresPayableBy(routingv8.PaymentMethodCash, routingv8.PaymentMethodTransponder)))
+	assert.Equal(t, 0, len(toll.FaresPayableBy()))
+}
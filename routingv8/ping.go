@@ -0,0 +1,46 @@
+package routingv8
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// PingResult describes the outcome of a connectivity check.
+type PingResult struct {
+	// URL that was called.
+	URL string
+	// StatusCode of the response, zero if no response was received.
+	StatusCode int
+	// Latency of the round trip.
+	Latency time.Duration
+}
+
+// Ping sends a cheap authenticated request to the routing service to verify connectivity and credentials, e.g. at
+// startup. The request intentionally lacks the required parameters, so a response rejecting it as malformed means
+// that it reached the service and was authenticated. The result is returned also when the check fails.
+func (c *Client) Ping(ctx context.Context) (*PingResult, error) {
+	result, err := c.probe(ctx, c.Routing.URL.ResolveReference(&url.URL{Path: "routes"}))
+	if err != nil {
+		return result, fmt.Errorf("ping: %w", err)
+	}
+	return result, nil
+}
+
+// probe sends an incomplete request to u. Responses rejecting the request as malformed or referring to a missing
+// resource are not errors, since they are only returned to authenticated and authorized callers.
+func (c *Client) probe(ctx context.Context, u *url.URL) (*PingResult, error) {
+	req, err := c.NewRequest(ctx, u, http.MethodGet, "", nil)
+	if err != nil {
+		return nil, err
+	}
+	start := c.clock().Now()
+	statusCode, err := c.do(req, nil)
+	result := &PingResult{URL: u.String(), StatusCode: statusCode, Latency: c.clock().Now().Sub(start)}
+	if err != nil && statusCode != http.StatusBadRequest && statusCode != http.StatusNotFound {
+		return result, err
+	}
+	return result, nil
+}
@@ -0,0 +1,52 @@
+package routingv8_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+
+	"go.einride.tech/here/routingv8"
+	"gotest.tools/v3/assert"
+)
+
+func TestClient_Ping(t *testing.T) {
+	t.Parallel()
+	for _, tt := range []struct {
+		name       string
+		statusCode int
+		err        error
+		expected   string
+	}{
+		{name: "malformed", statusCode: http.StatusBadRequest},
+		{name: "unauthorized", statusCode: http.StatusUnauthorized, expected: "ping: Title: Unauthorized"},
+		{name: "network", err: errors.New("connection refused"), expected: "ping: connection refused"},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			client := routingv8.NewClient(httpClientFunc(func(req *http.Request) (*http.Response, error) {
+				if tt.err != nil {
+					return nil, tt.err
+				}
+				return &http.Response{
+					StatusCode: tt.statusCode,
+					Body: io.NopCloser(strings.NewReader(
+						`{"title":"` + http.StatusText(tt.statusCode) + `","status":` + strconv.Itoa(tt.statusCode) + `}`,
+					)),
+				}, nil
+			}))
+			result, err := client.Ping(context.Background())
+			if tt.expected != "" {
+				assert.ErrorContains(t, err, tt.expected)
+			} else {
+				assert.NilError(t, err)
+			}
+			assert.Equal(t, "https://router.hereapi.com/v8/routes", result.URL)
+			assert.Equal(t, tt.statusCode, result.StatusCode)
+		})
+	}
+}
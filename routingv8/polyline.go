@@ -0,0 +1,95 @@
+package routingv8
+
+import (
+	"fmt"
+	"math"
+
+	"go.einride.tech/here/flexpolyline"
+)
+
+// decodePolyline decodes the flexible polyline of the section.
+func (s *Section) decodePolyline() ([]GeoWaypoint, error) {
+	if s.Polyline == "" {
+		return nil, nil
+	}
+	polyline, err := flexpolyline.Decode(s.Polyline)
+	if err != nil {
+		return nil, err
+	}
+	points := make([]GeoWaypoint, 0, len(polyline.Points))
+	for _, p := range polyline.Points {
+		point := GeoWaypoint{Lat: p.Lat, Long: p.Lng}
+		switch polyline.ThirdDimension {
+		case flexpolyline.ThirdDimensionAltitude, flexpolyline.ThirdDimensionElevation:
+			point.Elv = p.Third
+		}
+		points = append(points, point)
+	}
+	return points, nil
+}
+
+// DecodedPolyline returns the points of the section polyline, with the elevation in meters if the section was
+// requested with the elevation return attribute.
+func (s *Section) DecodedPolyline() ([]GeoWaypoint, error) {
+	points, err := s.decodePolyline()
+	if err != nil {
+		return nil, fmt.Errorf("decoded polyline of section %s: %v", s.ID, err)
+	}
+	return points, nil
+}
+
+// DefaultPolylineLengthTolerance is the relative difference between the polyline length and the summary length
+// above which a section is considered inconsistent.
+const DefaultPolylineLengthTolerance = 0.05
+
+// PolylineLengthCheck compares the geometric length of a section polyline with the length of its summary.
+type PolylineLengthCheck struct {
+	// SectionID of the checked section.
+	SectionID string
+	// PolylineLength is the geometric length of the decoded polyline in meters.
+	PolylineLength float64
+	// SummaryLength is the length of the section summary in meters.
+	SummaryLength float64
+}
+
+// RelativeDifference returns the absolute difference between the lengths relative to the summary length.
+func (c PolylineLengthCheck) RelativeDifference() float64 {
+	if c.SummaryLength == 0 {
+		if c.PolylineLength == 0 {
+			return 0
+		}
+		return math.Inf(1)
+	}
+	return math.Abs(c.PolylineLength-c.SummaryLength) / c.SummaryLength
+}
+
+// CheckPolylineLength recomputes the geometric length of the section polyline. The section has to be requested with
+// the polyline and summary return attributes.
+func (s *Section) CheckPolylineLength() (PolylineLengthCheck, error) {
+	points, err := s.decodePolyline()
+	if err != nil {
+		return PolylineLengthCheck{}, fmt.Errorf("check polyline length of section %s: %v", s.ID, err)
+	}
+	check := PolylineLengthCheck{SectionID: s.ID, SummaryLength: float64(s.Summary.Length)}
+	for i := 1; i < len(points); i++ {
+		check.PolylineLength += distance(points[i-1], points[i])
+	}
+	return check, nil
+}
+
+// VerifyPolylineLengths returns the checks of all sections of the route where the relative difference between the
+// polyline length and the summary length exceeds the tolerance. Such mismatches usually indicate truncated
+// responses or decoding errors.
+func VerifyPolylineLengths(route *Route, tolerance float64) ([]PolylineLengthCheck, error) {
+	var mismatches []PolylineLengthCheck
+	for i := range route.Sections {
+		check, err := route.Sections[i].CheckPolylineLength()
+		if err != nil {
+			return nil, err
+		}
+		if check.RelativeDifference() > tolerance {
+			mismatches = append(mismatches, check)
+		}
+	}
+	return mismatches, nil
+}
@@ -0,0 +1,39 @@
+package routingv8_test
+
+import (
+	"testing"
+
+	"go.einride.tech/here/routingv8"
+	"gotest.tools/v3/assert"
+)
+
+func TestSection_DecodedPolyline(t *testing.T) {
+	t.Parallel()
+	section := routingv8.Section{ID: "section-1", Polyline: "BlBoz5xJ67i1BU1B7PUzIhaUxL7YU"}
+	points, err := section.DecodedPolyline()
+	assert.NilError(t, err)
+	assert.DeepEqual(t, []routingv8.GeoWaypoint{
+		{Lat: 50.10228, Long: 8.69821, Elv: 10},
+		{Lat: 50.10201, Long: 8.69567, Elv: 20},
+		{Lat: 50.10063, Long: 8.69150, Elv: 30},
+		{Lat: 50.09878, Long: 8.68752, Elv: 40},
+	}, points)
+	section.Polyline = "BFoz5xJ!7i1B"
+	_, err = section.DecodedPolyline()
+	assert.ErrorContains(t, err, "decoded polyline of section section-1")
+}
+
+func TestVerifyPolylineLengths(t *testing.T) {
+	t.Parallel()
+	route := routingv8.Route{
+		Sections: []routingv8.Section{
+			{ID: "consistent", Polyline: "BFoz5xJ67i1B1B7PzIhaxL7Y", Summary: routingv8.Summary{Length: 850}},
+			{ID: "truncated", Polyline: "BFoz5xJ67i1B1B7P", Summary: routingv8.Summary{Length: 850}},
+		},
+	}
+	mismatches, err := routingv8.VerifyPolylineLengths(&route, routingv8.DefaultPolylineLengthTolerance)
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(mismatches))
+	assert.Equal(t, "truncated", mismatches[0].SectionID)
+	assert.Assert(t, mismatches[0].RelativeDifference() > 0.5)
+}
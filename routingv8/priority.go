@@ -0,0 +1,180 @@
+package routingv8
+
+import (
+	"container/heap"
+	"context"
+	"io"
+	"net/http"
+	"sync"
+)
+
+// Priority of a request in a PriorityRoundTripper queue. Requests with higher priority are sent first.
+type Priority int
+
+const (
+	// PriorityBatch is for background jobs, such as large matrix runs.
+	PriorityBatch Priority = -10
+	// PriorityNormal is the priority of requests without an explicit priority.
+	PriorityNormal Priority = 0
+	// PriorityInteractive is for user-facing requests.
+	PriorityInteractive Priority = 10
+)
+
+type priorityContextKey struct{}
+
+// WithPriority returns a context which sends requests with the given priority through a PriorityRoundTripper.
+func WithPriority(ctx context.Context, priority Priority) context.Context {
+	return context.WithValue(ctx, priorityContextKey{}, priority)
+}
+
+// PriorityFromContext returns the priority of the context, PriorityNormal if not set.
+func PriorityFromContext(ctx context.Context) Priority {
+	if priority, ok := ctx.Value(priorityContextKey{}).(Priority); ok {
+		return priority
+	}
+	return PriorityNormal
+}
+
+// PriorityRoundTripper limits the number of concurrent requests and queues the remaining requests by priority,
+// so that interactive requests preempt queued batch requests sharing the same client. A request holds its slot
+// until the body of its response is closed, which includes the download of the response.
+type PriorityRoundTripper struct {
+	next http.RoundTripper
+
+	mu       sync.Mutex
+	free     int
+	waiters  waiterQueue
+	sequence uint64
+}
+
+var _ http.RoundTripper = &PriorityRoundTripper{}
+
+// NewPriorityRoundTripper returns a round tripper which sends at most maxConcurrent requests at a time.
+// If next is nil http.DefaultTransport is used.
+func NewPriorityRoundTripper(maxConcurrent int, next http.RoundTripper) *PriorityRoundTripper {
+	if maxConcurrent < 1 {
+		maxConcurrent = 1
+	}
+	return &PriorityRoundTripper{next: next, free: maxConcurrent}
+}
+
+func (p *PriorityRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := p.acquire(req.Context()); err != nil {
+		return nil, err
+	}
+	next := p.next
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	resp, err := next.RoundTrip(req)
+	if err != nil || resp.Body == nil {
+		p.release()
+		return resp, err
+	}
+	resp.Body = &releasingBody{ReadCloser: resp.Body, release: p.release}
+	return resp, nil
+}
+
+// releasingBody is a response body which releases the slot of its request when closed.
+type releasingBody struct {
+	io.ReadCloser
+	once    sync.Once
+	release func()
+}
+
+func (b *releasingBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.once.Do(b.release)
+	return err
+}
+
+// Queued returns the number of requests waiting to be sent.
+func (p *PriorityRoundTripper) Queued() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return len(p.waiters)
+}
+
+func (p *PriorityRoundTripper) acquire(ctx context.Context) error {
+	p.mu.Lock()
+	if p.free > 0 && len(p.waiters) == 0 {
+		p.free--
+		p.mu.Unlock()
+		return nil
+	}
+	w := &waiter{priority: PriorityFromContext(ctx), sequence: p.sequence, ready: make(chan struct{})}
+	p.sequence++
+	heap.Push(&p.waiters, w)
+	p.mu.Unlock()
+	select {
+	case <-w.ready:
+		return nil
+	case <-ctx.Done():
+		p.mu.Lock()
+		defer p.mu.Unlock()
+		if w.index < 0 {
+			// The slot was handed over concurrently, pass it on.
+			p.releaseLocked()
+		} else {
+			heap.Remove(&p.waiters, w.index)
+		}
+		return ctx.Err()
+	}
+}
+
+func (p *PriorityRoundTripper) release() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.releaseLocked()
+}
+
+func (p *PriorityRoundTripper) releaseLocked() {
+	if len(p.waiters) == 0 {
+		p.free++
+		return
+	}
+	w := heap.Pop(&p.waiters).(*waiter)
+	close(w.ready)
+}
+
+type waiter struct {
+	priority Priority
+	sequence uint64
+	ready    chan struct{}
+	index    int
+}
+
+// waiterQueue is a heap of waiters ordered by priority, then by arrival.
+type waiterQueue []*waiter
+
+func (q waiterQueue) Len() int {
+	return len(q)
+}
+
+func (q waiterQueue) Less(i, j int) bool {
+	if q[i].priority != q[j].priority {
+		return q[i].priority > q[j].priority
+	}
+	return q[i].sequence < q[j].sequence
+}
+
+func (q waiterQueue) Swap(i, j int) {
+	q[i], q[j] = q[j], q[i]
+	q[i].index = i
+	q[j].index = j
+}
+
+func (q *waiterQueue) Push(x interface{}) {
+	w := x.(*waiter)
+	w.index = len(*q)
+	*q = append(*q, w)
+}
+
+func (q *waiterQueue) Pop() interface{} {
+	old := *q
+	w := old[len(old)-1]
+	old[len(old)-1] = nil
+	w.index = -1
+	*q = old[:len(old)-1]
+	return w
+}
@@ -0,0 +1,144 @@
+package routingv8_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"runtime"
+	"strings"
+	"sync"
+	"testing"
+
+	"go.einride.tech/here/routingv8"
+	"gotest.tools/v3/assert"
+)
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestPriorityRoundTripper(t *testing.T) {
+	t.Parallel()
+	var mu sync.Mutex
+	var order []string
+	started, unblock := make(chan struct{}), make(chan struct{})
+	rt := routingv8.NewPriorityRoundTripper(1, roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		if req.URL.Path == "/first" {
+			close(started)
+			<-unblock
+		}
+		mu.Lock()
+		order = append(order, req.URL.Path)
+		mu.Unlock()
+		return &http.Response{StatusCode: http.StatusOK}, nil
+	}))
+	send := func(wg *sync.WaitGroup, path string, priority routingv8.Priority) {
+		defer wg.Done()
+		ctx := routingv8.WithPriority(context.Background(), priority)
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://example.com"+path, nil)
+		assert.NilError(t, err)
+		_, err = rt.RoundTrip(req)
+		assert.NilError(t, err)
+	}
+	var wg sync.WaitGroup
+	wg.Add(4)
+	go send(&wg, "/first", routingv8.PriorityNormal)
+	<-started
+	waitQueued := func(n int) {
+		for rt.Queued() != n {
+			runtime.Gosched()
+		}
+	}
+	go send(&wg, "/batch-1", routingv8.PriorityBatch)
+	waitQueued(1)
+	go send(&wg, "/batch-2", routingv8.PriorityBatch)
+	waitQueued(2)
+	go send(&wg, "/interactive", routingv8.PriorityInteractive)
+	waitQueued(3)
+	close(unblock)
+	wg.Wait()
+	assert.DeepEqual(t, []string{"/first", "/interactive", "/batch-1", "/batch-2"}, order)
+}
+
+func TestPriorityRoundTripper_Canceled(t *testing.T) {
+	t.Parallel()
+	started, unblock := make(chan struct{}), make(chan struct{})
+	rt := routingv8.NewPriorityRoundTripper(1, roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		close(started)
+		<-unblock
+		return &http.Response{StatusCode: http.StatusOK}, nil
+	}))
+	go func() {
+		req, _ := http.NewRequest(http.MethodGet, "https://example.com", nil)
+		_, _ = rt.RoundTrip(req)
+	}()
+	<-started
+	ctx, cancel := context.WithCancel(context.Background())
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://example.com", nil)
+	assert.NilError(t, err)
+	done := make(chan error)
+	go func() {
+		_, err := rt.RoundTrip(req)
+		done <- err
+	}()
+	for rt.Queued() != 1 {
+		runtime.Gosched()
+	}
+	cancel()
+	assert.ErrorIs(t, <-done, context.Canceled)
+	assert.Equal(t, 0, rt.Queued())
+	close(unblock)
+}
+
+func TestPriorityRoundTripper_ReleaseOnBodyClose(t *testing.T) {
+	t.Parallel()
+	rt := routingv8.NewPriorityRoundTripper(1, roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(`{}`))}, nil
+	}))
+	req, err := http.NewRequest(http.MethodGet, "https://example.com/download", nil)
+	assert.NilError(t, err)
+	resp, err := rt.RoundTrip(req)
+	assert.NilError(t, err)
+	done := make(chan error)
+	go func() {
+		req, _ := http.NewRequest(http.MethodGet, "https://example.com/next", nil)
+		resp, err := rt.RoundTrip(req)
+		if err == nil {
+			err = resp.Body.Close()
+		}
+		done <- err
+	}()
+	// The download of the first response holds the slot.
+	for rt.Queued() != 1 {
+		runtime.Gosched()
+	}
+	_, err = io.ReadAll(resp.Body)
+	assert.NilError(t, err)
+	assert.Equal(t, 1, rt.Queued())
+	assert.NilError(t, resp.Body.Close())
+	assert.NilError(t, <-done)
+	// Closing the body again does not release another slot.
+	assert.NilError(t, resp.Body.Close())
+	resp, err = rt.RoundTrip(req)
+	assert.NilError(t, err)
+	go func() {
+		req, _ := http.NewRequest(http.MethodGet, "https://example.com/next", nil)
+		resp, err := rt.RoundTrip(req)
+		if err == nil {
+			err = resp.Body.Close()
+		}
+		done <- err
+	}()
+	for rt.Queued() != 1 {
+		select {
+		case <-done:
+			t.Fatal("request sent while the slot is held")
+		default:
+			runtime.Gosched()
+		}
+	}
+	assert.NilError(t, resp.Body.Close())
+	assert.NilError(t, <-done)
+}
@@ -0,0 +1,100 @@
+package routingv8
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// RouteProvider calculates routes. RoutingService is the HERE implementation, other routing engines can be used
+// as fallbacks by implementing the same interface.
+type RouteProvider interface {
+	Routes(ctx context.Context, req *RoutesRequest) (*RoutesResponse, error)
+}
+
+var _ RouteProvider = &RoutingService{}
+
+// RouteProviderFunc adapts a function to the RouteProvider interface.
+type RouteProviderFunc func(ctx context.Context, req *RoutesRequest) (*RoutesResponse, error)
+
+// Routes calls f(ctx, req).
+func (f RouteProviderFunc) Routes(ctx context.Context, req *RoutesRequest) (*RoutesResponse, error) {
+	return f(ctx, req)
+}
+
+// FallbackRouteProvider tries each of its providers in order and returns the first successful response.
+type FallbackRouteProvider struct {
+	// Providers in order of preference.
+	Providers []RouteProvider
+	// ShouldFallback decides whether to try the next provider after an error.
+	// Defaults to falling back on all errors except cancellation of the context.
+	ShouldFallback func(err error) bool
+}
+
+var _ RouteProvider = &FallbackRouteProvider{}
+
+// NewFallbackRouteProvider returns a RouteProvider which falls back to the next provider when a provider fails.
+func NewFallbackRouteProvider(providers ...RouteProvider) *FallbackRouteProvider {
+	return &FallbackRouteProvider{Providers: providers}
+}
+
+// Routes returns the routes of the first provider that succeeds. If no provider succeeds a *FallbackError with the
+// errors of the providers tried is returned.
+func (f *FallbackRouteProvider) Routes(ctx context.Context, req *RoutesRequest) (*RoutesResponse, error) {
+	if len(f.Providers) == 0 {
+		return nil, fmt.Errorf("fallback routes: no providers")
+	}
+	var errs []error
+	for i, provider := range f.Providers {
+		resp, err := provider.Routes(ctx, req)
+		if err == nil {
+			return resp, nil
+		}
+		errs = append(errs, fmt.Errorf("provider %d: %w", i, err))
+		if ctx.Err() != nil || (f.ShouldFallback != nil && !f.ShouldFallback(err)) {
+			break
+		}
+	}
+	return nil, &FallbackError{Errors: errs}
+}
+
+// FallbackError is returned by FallbackRouteProvider when no provider succeeds. It matches errors.Is and errors.As
+// if any of the provider errors does, e.g. IsRetryable reports whether any provider failed temporarily.
+type FallbackError struct {
+	// Errors of the providers tried, in order.
+	Errors []error
+}
+
+func (e *FallbackError) Error() string {
+	errs := make([]string, 0, len(e.Errors))
+	for _, err := range e.Errors {
+		errs = append(errs, err.Error())
+	}
+	return "fallback routes: " + strings.Join(errs, "; ")
+}
+
+// Unwrap returns the provider errors.
+func (e *FallbackError) Unwrap() []error {
+	return e.Errors
+}
+
+// Is reports whether any of the provider errors matches target.
+func (e *FallbackError) Is(target error) bool {
+	for _, err := range e.Errors {
+		if errors.Is(err, target) {
+			return true
+		}
+	}
+	return false
+}
+
+// As finds the first of the provider errors that matches target.
+func (e *FallbackError) As(target interface{}) bool {
+	for _, err := range e.Errors {
+		if errors.As(err, target) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,97 @@
+package routingv8_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+
+	"go.einride.tech/here/routingv8"
+	"gotest.tools/v3/assert"
+)
+
+func TestFallbackRouteProvider(t *testing.T) {
+	t.Parallel()
+	unavailable := errors.New("unavailable")
+	failing := routingv8.RouteProviderFunc(
+		func(context.Context, *routingv8.RoutesRequest) (*routingv8.RoutesResponse, error) {
+			return nil, unavailable
+		},
+	)
+	secondary := routingv8.RouteProviderFunc(
+		func(context.Context, *routingv8.RoutesRequest) (*routingv8.RoutesResponse, error) {
+			return &routingv8.RoutesResponse{Routes: []routingv8.Route{{ID: "secondary"}}}, nil
+		},
+	)
+	ctx := context.Background()
+	req := &routingv8.RoutesRequest{TransportMode: routingv8.TransportModeCar}
+
+	resp, err := routingv8.NewFallbackRouteProvider(failing, secondary).Routes(ctx, req)
+	assert.NilError(t, err)
+	assert.Equal(t, "secondary", resp.Routes[0].ID)
+
+	_, err = routingv8.NewFallbackRouteProvider(failing, failing).Routes(ctx, req)
+	assert.Error(t, err, "fallback routes: provider 0: unavailable; provider 1: unavailable")
+	assert.Assert(t, errors.Is(err, unavailable))
+	var fallbackErr *routingv8.FallbackError
+	assert.Assert(t, errors.As(err, &fallbackErr))
+	assert.Equal(t, 2, len(fallbackErr.Errors))
+
+	provider := routingv8.NewFallbackRouteProvider(failing, secondary)
+	provider.ShouldFallback = func(error) bool { return false }
+	_, err = provider.Routes(ctx, req)
+	assert.Error(t, err, "fallback routes: provider 0: unavailable")
+
+	_, err = routingv8.NewFallbackRouteProvider().Routes(ctx, req)
+	assert.Error(t, err, "fallback routes: no providers")
+}
+
+func TestFallbackRouteProvider_ErrorClassification(t *testing.T) {
+	t.Parallel()
+	status := func(statusCode int) routingv8.RouteProvider {
+		client := routingv8.NewClient(httpClientFunc(func(req *http.Request) (*http.Response, error) {
+			body := `{"title":"failed","status":` + strconv.Itoa(statusCode) + `}`
+			return &http.Response{StatusCode: statusCode, Body: io.NopCloser(strings.NewReader(body))}, nil
+		}))
+		return client.Routing
+	}
+	req := &routingv8.RoutesRequest{
+		Origin:        routingv8.GeoWaypoint{Lat: 57.7, Long: 11.9},
+		Destination:   routingv8.GeoWaypoint{Lat: 57.8, Long: 11.9},
+		TransportMode: routingv8.TransportModeCar,
+	}
+	for _, tt := range []struct {
+		name      string
+		providers []routingv8.RouteProvider
+		retryable bool
+		auth      bool
+	}{
+		{
+			name:      "unavailable",
+			providers: []routingv8.RouteProvider{status(http.StatusServiceUnavailable)},
+			retryable: true,
+		},
+		{
+			name:      "unauthorized then unavailable",
+			providers: []routingv8.RouteProvider{status(http.StatusUnauthorized), status(http.StatusBadGateway)},
+			retryable: true,
+			auth:      true,
+		},
+		{
+			name:      "bad request",
+			providers: []routingv8.RouteProvider{status(http.StatusBadRequest), status(http.StatusBadRequest)},
+		},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			_, err := routingv8.NewFallbackRouteProvider(tt.providers...).Routes(context.Background(), req)
+			assert.Assert(t, err != nil)
+			assert.Equal(t, tt.retryable, routingv8.IsRetryable(err))
+			assert.Equal(t, tt.auth, routingv8.IsAuth(err))
+		})
+	}
+}
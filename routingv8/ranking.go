@@ -0,0 +1,133 @@
+package routingv8
+
+import (
+	"math"
+	"sort"
+)
+
+// Criterion is a property of a route used to rank routes. Lower values are better.
+type Criterion interface {
+	// Name of the criterion, e.g. "duration".
+	Name() string
+	// Value of the criterion for the route.
+	Value(route *Route) float64
+}
+
+type criterion struct {
+	name  string
+	value func(route *Route) float64
+}
+
+func (c criterion) Name() string {
+	return c.name
+}
+
+func (c criterion) Value(route *Route) float64 {
+	return c.value(route)
+}
+
+// NewCriterion returns a Criterion with the name and the value function, for custom criteria.
+func NewCriterion(name string, value func(route *Route) float64) Criterion {
+	return criterion{name: name, value: value}
+}
+
+var (
+	// CriterionDuration is the duration of a route in seconds.
+	CriterionDuration = NewCriterion("duration", func(route *Route) float64 {
+		duration, _ := routeTotals(route)
+		return duration.Seconds()
+	})
+	// CriterionDistance is the length of a route in meters.
+	CriterionDistance = NewCriterion("distance", func(route *Route) float64 {
+		_, length := routeTotals(route)
+		return float64(length)
+	})
+	// CriterionIncidents is the number of incidents on a route.
+	CriterionIncidents = NewCriterion("incidents", func(route *Route) float64 {
+		var incidents int
+		for _, section := range route.Sections {
+			incidents += len(section.Incidents)
+		}
+		return float64(incidents)
+	})
+	// CriterionFerry is 1 for routes using a ferry and 0 otherwise.
+	CriterionFerry = NewCriterion("ferry", func(route *Route) float64 {
+		if route.UsesFerry() {
+			return 1
+		}
+		return 0
+	})
+)
+
+// CriterionTollCost is the toll cost of a route in the currency, using converted prices for fares in other
+// currencies when available. Tolls without a fare in the currency are not part of the cost.
+func CriterionTollCost(currency string) Criterion {
+	return NewCriterion("tollCost", func(route *Route) float64 {
+		return routeTollCost(route, currency)
+	})
+}
+
+// WeightedCriterion is a criterion with its weight in the score of a route.
+type WeightedCriterion struct {
+	Criterion Criterion
+	Weight    float64
+}
+
+// CriterionScore explains the contribution of a criterion to the score of a route.
+type CriterionScore struct {
+	// Name of the criterion.
+	Name string
+	// Value of the criterion for the route.
+	Value float64
+	// Normalized value between 0 for the best and 1 for the worst of the ranked routes.
+	Normalized float64
+	// Weighted is the normalized value multiplied with the weight of the criterion.
+	Weighted float64
+}
+
+// RankedRoute is a route with its score, lower scores are better.
+type RankedRoute struct {
+	// Index of the route in the ranked routes.
+	Index int
+	// Route that was ranked.
+	Route *Route
+	// Score is the sum of the weighted criteria scores.
+	Score float64
+	// Criteria are the scores of each criterion, in the order of the criteria of the RouteRanker.
+	Criteria []CriterionScore
+}
+
+// RouteRanker ranks routes by a weighted combination of criteria. The values of each criterion are normalized over
+// the ranked routes, so that weights are comparable between criteria with different units.
+type RouteRanker struct {
+	Criteria []WeightedCriterion
+}
+
+// Rank returns the routes ordered from the best to the worst score. Routes with equal scores keep their order.
+func (r *RouteRanker) Rank(routes []Route) []RankedRoute {
+	ranked := make([]RankedRoute, len(routes))
+	for i := range routes {
+		ranked[i] = RankedRoute{Index: i, Route: &routes[i], Criteria: make([]CriterionScore, len(r.Criteria))}
+	}
+	for j, weighted := range r.Criteria {
+		min, max := math.Inf(1), math.Inf(-1)
+		for i := range ranked {
+			value := weighted.Criterion.Value(ranked[i].Route)
+			ranked[i].Criteria[j] = CriterionScore{Name: weighted.Criterion.Name(), Value: value}
+			min = math.Min(min, value)
+			max = math.Max(max, value)
+		}
+		for i := range ranked {
+			score := &ranked[i].Criteria[j]
+			if max > min {
+				score.Normalized = (score.Value - min) / (max - min)
+			}
+			score.Weighted = score.Normalized * weighted.Weight
+			ranked[i].Score += score.Weighted
+		}
+	}
+	sort.SliceStable(ranked, func(i, j int) bool {
+		return ranked[i].Score < ranked[j].Score
+	})
+	return ranked
+}
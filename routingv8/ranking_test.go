@@ -0,0 +1,67 @@
+package routingv8_test
+
+import (
+	"testing"
+
+	"go.einride.tech/here/routingv8"
+	"gotest.tools/v3/assert"
+)
+
+func TestRouteRanker_Rank(t *testing.T) {
+	t.Parallel()
+	route := func(duration, length int32, toll float64, ferry bool) routingv8.Route {
+		section := routingv8.Section{
+			Summary: routingv8.Summary{Duration: duration, Length: length},
+			Tolls: []routingv8.Toll{
+				{Fares: []routingv8.Fare{{Price: routingv8.Price{Currency: "EUR", Value: toll}}}},
+			},
+		}
+		if ferry {
+			section.Type = routingv8.SectionModeFerry
+		}
+		return routingv8.Route{Sections: []routingv8.Section{section}}
+	}
+	routes := []routingv8.Route{
+		route(3600, 100000, 20, false),
+		route(3000, 90000, 0, true),
+		route(4200, 120000, 0, false),
+	}
+	ranker := routingv8.RouteRanker{Criteria: []routingv8.WeightedCriterion{
+		{Criterion: routingv8.CriterionDuration, Weight: 1},
+		{Criterion: routingv8.CriterionTollCost("EUR"), Weight: 1},
+		{Criterion: routingv8.CriterionFerry, Weight: 2},
+		{Criterion: routingv8.CriterionIncidents, Weight: 1},
+	}}
+	ranked := ranker.Rank(routes)
+	assert.Equal(t, 3, len(ranked))
+	assert.DeepEqual(t, []int{2, 0, 1}, []int{ranked[0].Index, ranked[1].Index, ranked[2].Index})
+	assert.Equal(t, 1.0, ranked[0].Score)
+	assert.Equal(t, 1.5, ranked[1].Score)
+	assert.DeepEqual(t, []routingv8.CriterionScore{
+		{Name: "duration", Value: 3600, Normalized: 0.5, Weighted: 0.5},
+		{Name: "tollCost", Value: 20, Normalized: 1, Weighted: 1},
+		{Name: "ferry", Value: 0},
+		{Name: "incidents", Value: 0},
+	}, ranked[1].Criteria)
+	assert.Equal(t, 2.0, ranked[2].Score)
+
+	custom := routingv8.RouteRanker{Criteria: []routingv8.WeightedCriterion{
+		{Criterion: routingv8.CriterionDistance, Weight: 1},
+	}}
+	assert.Equal(t, 1, custom.Rank(routes)[0].Index)
+}
+
+func TestCriterionTollCost(t *testing.T) {
+	t.Parallel()
+	fare := func(currency string, value float64, converted routingv8.Price) routingv8.Fare {
+		return routingv8.Fare{Price: routingv8.Price{Currency: currency, Value: value}, ConvertedPrice: converted}
+	}
+	route := routingv8.Route{Sections: []routingv8.Section{{
+		Tolls: []routingv8.Toll{
+			{Fares: []routingv8.Fare{fare("EUR", 5, routingv8.Price{})}},
+			{Fares: []routingv8.Fare{fare("SEK", 45, routingv8.Price{Currency: "EUR", Value: 4})}},
+			{Fares: []routingv8.Fare{fare("NOK", 1, routingv8.Price{})}},
+		},
+	}}}
+	assert.Equal(t, 9.0, routingv8.CriterionTollCost("EUR").Value(&route))
+}
@@ -0,0 +1,141 @@
+package routingv8
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// WorldRegion returns a region definition without restrictions, required for matrices with a Profile.
+func WorldRegion() RegionDefinition {
+	return RegionDefinition{Type: RegionTypeWorld}
+}
+
+// CircleRegion returns a region definition of the circle with the center and the radius in meters.
+func CircleRegion(center GeoWaypoint, radius int) RegionDefinition {
+	return RegionDefinition{Type: RegionTypeCircle, CircleCenter: &center, CircleRadius: radius}
+}
+
+// BoundingBoxRegion returns a region definition of the bounding box.
+func BoundingBoxRegion(box BoundingBox) RegionDefinition {
+	return RegionDefinition{
+		Type:             RegionTypeBoundingBox,
+		BoundingBoxNorth: box.North,
+		BoundingBoxEast:  box.East,
+		BoundingBoxSouth: box.South,
+		BoundingBoxWest:  box.West,
+	}
+}
+
+// PolygonRegion returns a region definition of the polygon with the outer ring. The ring is closed implicitly by
+// the API, a last point equal to the first point is removed.
+func PolygonRegion(outer ...GeoWaypoint) RegionDefinition {
+	if len(outer) > 1 && outer[0] == outer[len(outer)-1] {
+		outer = outer[:len(outer)-1]
+	}
+	points := make([]*GeoWaypoint, 0, len(outer))
+	for i := range outer {
+		point := outer[i]
+		points = append(points, &point)
+	}
+	return RegionDefinition{Type: RegionTypePolygon, PolygonOuter: points}
+}
+
+// AutoCircleRegion returns a region definition of the smallest circle containing all origins and destinations,
+// extended by the margin in meters. A zero margin uses the default margin of the API.
+func AutoCircleRegion(margin int) RegionDefinition {
+	return RegionDefinition{Type: RegionTypeAutoCircle, AutoCircleMargin: margin}
+}
+
+// Validate checks that the fields of the region type are valid.
+func (r *RegionDefinition) Validate() error {
+	switch r.Type {
+	case RegionTypeWorld:
+	case RegionTypeCircle:
+		if r.CircleCenter == nil {
+			return fmt.Errorf("invalid circle region: missing center")
+		}
+		if err := validateCoordinate(*r.CircleCenter); err != nil {
+			return fmt.Errorf("invalid circle region: %v", err)
+		}
+		if r.CircleRadius <= 0 {
+			return fmt.Errorf("invalid circle region: radius %d", r.CircleRadius)
+		}
+	case RegionTypeBoundingBox:
+		for _, p := range []GeoWaypoint{
+			{Lat: r.BoundingBoxNorth, Long: r.BoundingBoxEast},
+			{Lat: r.BoundingBoxSouth, Long: r.BoundingBoxWest},
+		} {
+			if err := validateCoordinate(p); err != nil {
+				return fmt.Errorf("invalid bounding box region: %v", err)
+			}
+		}
+		if r.BoundingBoxNorth <= r.BoundingBoxSouth {
+			return fmt.Errorf(
+				"invalid bounding box region: north %v not above south %v", r.BoundingBoxNorth, r.BoundingBoxSouth,
+			)
+		}
+	case RegionTypePolygon:
+		outer := r.PolygonOuter
+		for i, p := range outer {
+			if p == nil {
+				return fmt.Errorf("invalid polygon region: point %d is nil", i)
+			}
+			if err := validateCoordinate(*p); err != nil {
+				return fmt.Errorf("invalid polygon region: point %d: %v", i, err)
+			}
+		}
+		if len(outer) > 1 && *outer[0] == *outer[len(outer)-1] {
+			return fmt.Errorf("invalid polygon region: the ring is closed implicitly, the last point repeats the first")
+		}
+		if len(outer) < 3 {
+			return fmt.Errorf("invalid polygon region: %d points, require at least 3", len(outer))
+		}
+	case RegionTypeAutoCircle:
+		if r.AutoCircleMargin < 0 {
+			return fmt.Errorf("invalid auto circle region: margin %d", r.AutoCircleMargin)
+		}
+		if r.AutoCircleMaxRadius < 0 {
+			return fmt.Errorf("invalid auto circle region: max radius %d", r.AutoCircleMaxRadius)
+		}
+	default:
+		return fmt.Errorf("invalid region type: %d", int(r.Type))
+	}
+	return nil
+}
+
+// MarshalJSON marshals only the fields of the region type. All sides of bounding boxes are marshaled, also at the
+// equator and the prime meridian.
+func (r RegionDefinition) MarshalJSON() ([]byte, error) {
+	type regionDefinition RegionDefinition
+	region := regionDefinition{Type: r.Type}
+	switch r.Type {
+	case RegionTypeCircle:
+		region.CircleCenter, region.CircleRadius = r.CircleCenter, r.CircleRadius
+	case RegionTypeBoundingBox:
+		return json.Marshal(struct {
+			Type  RegionType `json:"type"`
+			North float64    `json:"north"`
+			East  float64    `json:"east"`
+			South float64    `json:"south"`
+			West  float64    `json:"west"`
+		}{
+			Type:  r.Type,
+			North: r.BoundingBoxNorth,
+			East:  r.BoundingBoxEast,
+			South: r.BoundingBoxSouth,
+			West:  r.BoundingBoxWest,
+		})
+	case RegionTypePolygon:
+		region.PolygonOuter = r.PolygonOuter
+	case RegionTypeAutoCircle:
+		region.AutoCircleMargin, region.AutoCircleMaxRadius = r.AutoCircleMargin, r.AutoCircleMaxRadius
+	}
+	return json.Marshal(region)
+}
+
+func validateCoordinate(p GeoWaypoint) error {
+	if p.Lat < -90 || p.Lat > 90 || p.Long < -180 || p.Long > 180 {
+		return fmt.Errorf("coordinate %v,%v out of range", p.Lat, p.Long)
+	}
+	return nil
+}
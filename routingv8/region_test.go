@@ -0,0 +1,163 @@
+package routingv8_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"go.einride.tech/here/routingv8"
+	"gotest.tools/v3/assert"
+)
+
+func TestRegionDefinition_MarshalJSON(t *testing.T) {
+	t.Parallel()
+	for _, tt := range []struct {
+		name     string
+		region   routingv8.RegionDefinition
+		expected string
+	}{
+		{
+			name:     "world",
+			region:   routingv8.WorldRegion(),
+			expected: `{"type":"world"}`,
+		},
+		{
+			name:     "circle",
+			region:   routingv8.CircleRegion(routingv8.GeoWaypoint{Lat: 57.7, Long: 11.9}, 10000),
+			expected: `{"type":"circle","center":{"lat":57.7,"lng":11.9},"radius":10000}`,
+		},
+		{
+			name:     "bounding box",
+			region:   routingv8.BoundingBoxRegion(routingv8.BoundingBox{North: 59.5, South: 57.5, East: 18.5, West: 11.5}),
+			expected: `{"type":"boundingBox","north":59.5,"east":18.5,"south":57.5,"west":11.5}`,
+		},
+		{
+			name:     "bounding box at the equator and the prime meridian",
+			region:   routingv8.BoundingBoxRegion(routingv8.BoundingBox{North: 10, South: 0, East: 5, West: 0}),
+			expected: `{"type":"boundingBox","north":10,"east":5,"south":0,"west":0}`,
+		},
+		{
+			name: "polygon",
+			region: routingv8.PolygonRegion(
+				routingv8.GeoWaypoint{Lat: 1, Long: 1},
+				routingv8.GeoWaypoint{Lat: 2, Long: 1},
+				routingv8.GeoWaypoint{Lat: 2, Long: 2},
+				routingv8.GeoWaypoint{Lat: 1, Long: 1},
+			),
+			expected: `{"type":"polygon","outer":[{"lat":1,"lng":1},{"lat":2,"lng":1},{"lat":2,"lng":2}]}`,
+		},
+		{
+			name:     "auto circle",
+			region:   routingv8.AutoCircleRegion(5000),
+			expected: `{"type":"autoCircle","margin":5000}`,
+		},
+		{
+			name:     "auto circle with max radius",
+			region:   routingv8.RegionDefinition{Type: routingv8.RegionTypeAutoCircle, AutoCircleMaxRadius: 400000},
+			expected: `{"type":"autoCircle","maxRadius":400000}`,
+		},
+		{
+			name: "fields of other types",
+			region: routingv8.RegionDefinition{
+				Type:             routingv8.RegionTypeWorld,
+				CircleRadius:     10,
+				AutoCircleMargin: 10,
+			},
+			expected: `{"type":"world"}`,
+		},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.NilError(t, tt.region.Validate())
+			b, err := json.Marshal(tt.region)
+			assert.NilError(t, err)
+			assert.Equal(t, tt.expected, string(b))
+			var got routingv8.RegionDefinition
+			assert.NilError(t, json.Unmarshal(b, &got))
+			assert.Equal(t, tt.region.Type, got.Type)
+			if tt.region.Type == routingv8.RegionTypeBoundingBox {
+				assert.DeepEqual(t, tt.region, got)
+			}
+		})
+	}
+}
+
+func TestRegionDefinition_Validate(t *testing.T) {
+	t.Parallel()
+	for _, tt := range []struct {
+		name   string
+		region routingv8.RegionDefinition
+		err    string
+	}{
+		{
+			name:   "unspecified",
+			region: routingv8.RegionDefinition{},
+			err:    "invalid region type: 0",
+		},
+		{
+			name:   "circle without center",
+			region: routingv8.RegionDefinition{Type: routingv8.RegionTypeCircle, CircleRadius: 10},
+			err:    "invalid circle region: missing center",
+		},
+		{
+			name:   "circle without radius",
+			region: routingv8.CircleRegion(routingv8.GeoWaypoint{Lat: 1, Long: 1}, 0),
+			err:    "invalid circle region: radius 0",
+		},
+		{
+			name:   "bounding box upside down",
+			region: routingv8.BoundingBoxRegion(routingv8.BoundingBox{North: 1, South: 2, East: 2, West: 1}),
+			err:    "invalid bounding box region: north 1 not above south 2",
+		},
+		{
+			name:   "bounding box out of range",
+			region: routingv8.BoundingBoxRegion(routingv8.BoundingBox{North: 91, South: 2, East: 2, West: 1}),
+			err:    "invalid bounding box region: coordinate 91,2 out of range",
+		},
+		{
+			name: "polygon with explicitly closed ring",
+			region: routingv8.RegionDefinition{
+				Type: routingv8.RegionTypePolygon,
+				PolygonOuter: []*routingv8.GeoWaypoint{
+					{Lat: 1, Long: 1}, {Lat: 2, Long: 1}, {Lat: 2, Long: 2}, {Lat: 1, Long: 1},
+				},
+			},
+			err: "invalid polygon region: the ring is closed implicitly, the last point repeats the first",
+		},
+		{
+			name: "polygon with too few points",
+			region: routingv8.PolygonRegion(
+				routingv8.GeoWaypoint{Lat: 1, Long: 1},
+				routingv8.GeoWaypoint{Lat: 2, Long: 1},
+				routingv8.GeoWaypoint{Lat: 1, Long: 1},
+			),
+			err: "invalid polygon region: 2 points, require at least 3",
+		},
+		{
+			name:   "auto circle with negative margin",
+			region: routingv8.AutoCircleRegion(-1),
+			err:    "invalid auto circle region: margin -1",
+		},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.Error(t, tt.region.Validate(), tt.err)
+		})
+	}
+}
+
+func TestMatrixService_CalculateMatrix_invalidRegion(t *testing.T) {
+	t.Parallel()
+	client := routingv8.NewClient(httpClientFunc(func(req *http.Request) (*http.Response, error) {
+		t.Fatal("unexpected request")
+		return nil, nil
+	}))
+	_, err := client.Matrix.CalculateMatrixSync(context.Background(), &routingv8.CalculateMatrixBody{
+		RegionDefinition: routingv8.AutoCircleRegion(-1),
+	})
+	assert.ErrorContains(t, err, "invalid auto circle region")
+	assert.Assert(t, routingv8.IsInvalidInput(err))
+}
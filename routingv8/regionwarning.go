@@ -0,0 +1,119 @@
+package routingv8
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// RegionWarning reports that a matrix was calculated in another region than requested, e.g. because the API clamped
+// an auto circle to its maximum radius, or that origins or destinations are outside of the calculated region. Routes
+// to origins and destinations outside of the calculated region can not be calculated.
+type RegionWarning struct {
+	// Requested region definition.
+	Requested RegionDefinition
+	// Calculated region definition, as returned in the response. Auto circles are returned as circles.
+	Calculated RegionDefinition
+	// Clamped is true if the calculated region is an auto circle clamped to its maximum radius.
+	Clamped bool
+	// OutsideOrigins are the indices of the origins outside of the calculated region.
+	OutsideOrigins []int
+	// OutsideDestinations are the indices of the destinations outside of the calculated region.
+	OutsideDestinations []int
+}
+
+func (w *RegionWarning) String() string {
+	requested, _ := json.Marshal(w.Requested)
+	calculated, _ := json.Marshal(w.Calculated)
+	s := fmt.Sprintf("region adjusted from %s to %s", requested, calculated)
+	if w.Clamped {
+		s += ", clamped to max radius"
+	}
+	if len(w.OutsideOrigins) > 0 || len(w.OutsideDestinations) > 0 {
+		s += fmt.Sprintf(
+			", %d origins and %d destinations outside", len(w.OutsideOrigins), len(w.OutsideDestinations),
+		)
+	}
+	return s
+}
+
+// RegionWarning returns a warning if the region of the response differs from the requested region of the body or
+// does not contain all origins and destinations. Nil is returned for matrices calculated in the requested region
+// with all waypoints inside, and for responses without a region definition.
+func (b *CalculateMatrixBody) RegionWarning(resp *CalculateMatrixResponse) *RegionWarning {
+	requested, calculated := b.RegionDefinition, resp.RegionDefinition
+	if calculated.Type == RegionTypeUnspecified || requested.Type == RegionTypeWorld {
+		return nil
+	}
+	warning := RegionWarning{Requested: requested, Calculated: calculated}
+	var adjusted bool
+	if requested.Type == RegionTypeAutoCircle {
+		warning.Clamped = requested.AutoCircleMaxRadius > 0 && calculated.Type == RegionTypeCircle &&
+			calculated.CircleRadius >= requested.AutoCircleMaxRadius
+		adjusted = warning.Clamped
+	} else {
+		requestedJSON, err := json.Marshal(requested)
+		if err != nil {
+			return nil
+		}
+		calculatedJSON, err := json.Marshal(calculated)
+		if err != nil {
+			return nil
+		}
+		adjusted = !bytes.Equal(requestedJSON, calculatedJSON)
+	}
+	for i, origin := range b.Origins {
+		if origin != nil && !regionContains(calculated, *origin) {
+			warning.OutsideOrigins = append(warning.OutsideOrigins, i)
+		}
+	}
+	destinations := b.Destinations
+	if len(destinations) == 0 {
+		destinations = b.Origins
+	}
+	for i, destination := range destinations {
+		if destination != nil && !regionContains(calculated, *destination) {
+			warning.OutsideDestinations = append(warning.OutsideDestinations, i)
+		}
+	}
+	if !adjusted && len(warning.OutsideOrigins) == 0 && len(warning.OutsideDestinations) == 0 {
+		return nil
+	}
+	return &warning
+}
+
+// regionContains returns true if the point is in the region. Regions which can not be evaluated contain all points.
+func regionContains(r RegionDefinition, p GeoWaypoint) bool {
+	switch r.Type {
+	case RegionTypeCircle:
+		if r.CircleCenter == nil {
+			return true
+		}
+		return distance(*r.CircleCenter, p) <= float64(r.CircleRadius)
+	case RegionTypeBoundingBox:
+		return BoundingBox{
+			North: r.BoundingBoxNorth,
+			South: r.BoundingBoxSouth,
+			East:  r.BoundingBoxEast,
+			West:  r.BoundingBoxWest,
+		}.Contains(p)
+	case RegionTypePolygon:
+		if len(r.PolygonOuter) < 3 {
+			return true
+		}
+		// Ray casting on the plane of latitudes and longitudes, sufficient for the regions of a matrix.
+		var inside bool
+		for i, j := 0, len(r.PolygonOuter)-1; i < len(r.PolygonOuter); j, i = i, i+1 {
+			a, b := r.PolygonOuter[i], r.PolygonOuter[j]
+			if a == nil || b == nil {
+				return true
+			}
+			if (a.Lat > p.Lat) != (b.Lat > p.Lat) && p.Long < (b.Long-a.Long)*(p.Lat-a.Lat)/(b.Lat-a.Lat)+a.Long {
+				inside = !inside
+			}
+		}
+		return inside
+	default:
+		return true
+	}
+}
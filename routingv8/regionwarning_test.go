@@ -0,0 +1,119 @@
+package routingv8_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"go.einride.tech/here/routingv8"
+	"gotest.tools/v3/assert"
+)
+
+func TestMatrixService_CalculateMatrixSync_RegionWarning(t *testing.T) {
+	t.Parallel()
+	client := routingv8.NewClient(httpClientFunc(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(`{
+			"matrixId": "m1",
+			"matrix": {"numOrigins": 1, "numDestinations": 2, "travelTimes": [60, 0], "errorCodes": [0, 3]},
+			"regionDefinition": {"type": "circle", "center": {"lat": 57.7, "lng": 11.9}, "radius": 10000}
+		}`))}, nil
+	}))
+	resp, err := client.Matrix.CalculateMatrixSync(context.Background(), &routingv8.CalculateMatrixBody{
+		Origins: []*routingv8.GeoWaypoint{{Lat: 57.7, Long: 11.9}},
+		Destinations: []*routingv8.GeoWaypoint{
+			{Lat: 57.75, Long: 11.95},
+			{Lat: 59.3, Long: 18.0},
+		},
+		RegionDefinition: routingv8.RegionDefinition{
+			Type:                routingv8.RegionTypeAutoCircle,
+			AutoCircleMaxRadius: 10000,
+		},
+	})
+	assert.NilError(t, err)
+	assert.Assert(t, resp.RegionWarning != nil)
+	assert.Assert(t, resp.RegionWarning.Clamped)
+	assert.Equal(t, 0, len(resp.RegionWarning.OutsideOrigins))
+	assert.DeepEqual(t, []int{1}, resp.RegionWarning.OutsideDestinations)
+	assert.Equal(
+		t,
+		`region adjusted from {"type":"autoCircle","maxRadius":10000} to `+
+			`{"type":"circle","center":{"lat":57.7,"lng":11.9},"radius":10000}, clamped to max radius, `+
+			`0 origins and 1 destinations outside`,
+		resp.RegionWarning.String(),
+	)
+}
+
+func TestCalculateMatrixBody_RegionWarning(t *testing.T) {
+	t.Parallel()
+	origins := []*routingv8.GeoWaypoint{{Lat: 1.5, Long: 1.5}, {Lat: 3, Long: 3}}
+	polygon := routingv8.PolygonRegion(
+		routingv8.GeoWaypoint{Lat: 1, Long: 1},
+		routingv8.GeoWaypoint{Lat: 2, Long: 1},
+		routingv8.GeoWaypoint{Lat: 2, Long: 2},
+		routingv8.GeoWaypoint{Lat: 1, Long: 2},
+	)
+	box := routingv8.BoundingBoxRegion(routingv8.BoundingBox{North: 4, South: 1, East: 4, West: 1})
+	for _, tt := range []struct {
+		name                string
+		requested           routingv8.RegionDefinition
+		calculated          routingv8.RegionDefinition
+		expectWarning       bool
+		outsideOrigins      []int
+		outsideDestinations []int
+	}{
+		{
+			name:       "world",
+			requested:  routingv8.WorldRegion(),
+			calculated: routingv8.WorldRegion(),
+		},
+		{
+			name:       "unchanged bounding box",
+			requested:  box,
+			calculated: box,
+		},
+		{
+			name:       "unchanged bounding box at the equator",
+			requested:  routingv8.BoundingBoxRegion(routingv8.BoundingBox{North: 4, South: 0, East: 4, West: 0}),
+			calculated: routingv8.BoundingBoxRegion(routingv8.BoundingBox{North: 4, South: 0, East: 4, West: 0}),
+		},
+		{
+			name:      "shrunk bounding box",
+			requested: box,
+			calculated: routingv8.BoundingBoxRegion(
+				routingv8.BoundingBox{North: 2, South: 1, East: 2, West: 1},
+			),
+			expectWarning:       true,
+			outsideOrigins:      []int{1},
+			outsideDestinations: []int{1},
+		},
+		{
+			name:                "points outside polygon",
+			requested:           polygon,
+			calculated:          polygon,
+			expectWarning:       true,
+			outsideOrigins:      []int{1},
+			outsideDestinations: []int{1},
+		},
+		{
+			name:       "no region in response",
+			requested:  polygon,
+			calculated: routingv8.RegionDefinition{},
+		},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			body := routingv8.CalculateMatrixBody{Origins: origins, RegionDefinition: tt.requested}
+			warning := body.RegionWarning(&routingv8.CalculateMatrixResponse{RegionDefinition: tt.calculated})
+			if !tt.expectWarning {
+				assert.Assert(t, warning == nil)
+				return
+			}
+			assert.Assert(t, warning != nil)
+			assert.DeepEqual(t, tt.outsideOrigins, warning.OutsideOrigins)
+			assert.DeepEqual(t, tt.outsideDestinations, warning.OutsideDestinations)
+		})
+	}
+}
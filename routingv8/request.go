@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"strconv"
+	"time"
 )
 
 const (
@@ -22,11 +23,11 @@ type CalculateMatrixBody struct {
 	// See https://developer.here.com/documentation/matrix-routing-api/8.6.0/dev_guide/topics/modes/modes.html
 	// for guidance on the matrix limitations.
 	Destinations []*GeoWaypoint `json:"destinations"`
-	// DepartureTime of departure for all origins. Default to now.
-	DepartureTime string `json:"departureTime,omitempty"`
+	// DepartureTime of departure for all origins, see DepartureTime for the traffic applied. Defaults to now.
+	DepartureTime DepartureTime `json:"departureTime,omitempty"`
 	// RegionDefinition of where the matrix should be calculated.
 	RegionDefinition RegionDefinition `json:"regionDefinition"`
-	// Profile to use for route calculation in the matrix.
+	// Profile to use for route calculation in the matrix. Requires a world region definition.
 	Profile Profile `json:"profile,omitempty"`
 	// RoutingMode optimization.
 	RoutingMode RoutingMode `json:"routingMode,omitempty"`
@@ -40,7 +41,7 @@ type CalculateMatrixBody struct {
 
 type CalculateMatrixRequest struct {
 	// Async flag requires the Client to poll the calculation results and finally requesting to download
-	// the calculation results.
+	// the calculation results. See MatrixService.CalculateMatrixAndWait.
 	Async Async
 	// Body to pass to request to Here Maps API
 	Body *CalculateMatrixBody
@@ -50,6 +51,66 @@ type RoutesRequest struct {
 	Origin        GeoWaypoint
 	Destination   GeoWaypoint
 	TransportMode TransportMode
+	// RoutingMode optimizes the routes for the fastest or the shortest route. Defaults to RoutingModeFast.
+	RoutingMode RoutingMode
+	// Vias are waypoints to pass between origin and destination, in order.
+	Vias []Via
+	// DepartureTime from the origin, see DepartureTime for the traffic applied. Defaults to now. Routes support
+	// traffic, DepartureTimePreferTraffic departs now.
+	DepartureTime DepartureTime
+	// ArrivalTime at the destination, for routes calculated backwards from the arrival. Can only be combined with
+	// a departure time now.
+	ArrivalTime time.Time
+	// Truck dimensions, weights and loads, in centimeters and kilograms, for routes with legal truck
+	// restrictions applied. Requires TransportModeTruck.
+	Truck *Truck
+	// Taxi privileges. Requires TransportModeTaxi.
+	Taxi *Taxi
+	// EV parameters, for routes with energy consumption and charging stops of electric vehicles.
+	EV *EV
+	// Avoid features, areas and segments.
+	Avoid *Avoid
+	// Return are the attributes to include in the routes. Defaults to DefaultReturn.
+	Return []ReturnAttribute
+	// Spans are the attributes of the spans to include in the sections. Requires the polyline to be returned.
+	// No spans are returned when empty.
+	Spans []SpanAttribute
+	// Lang is the language of the instructions in the response as a BCP 47 language tag, e.g. "en-US".
+	// Defaults to "en-US".
+	Lang string
+	// Units of the distances in the instructions. Defaults to UnitsMetric.
+	Units Units
+	// Currency of toll prices as an ISO 4217 code, e.g. "USD". Only sent when tolls are returned.
+	// Defaults to DefaultCurrency.
+	Currency string
+	// Alternatives is the number of alternative routes to request, up to MaxAlternatives.
+	// Defaults to DefaultAlternatives when zero, use NoAlternatives to request only the best route.
+	Alternatives int
+	// MaxAlternativeOverlap drops alternatives sharing more than the given share, between 0 and 1, of their
+	// geometry with a better route. Zero keeps all alternatives.
+	MaxAlternativeOverlap float64
+}
+
+// Via is an intermediate waypoint of a route.
+type Via struct {
+	// Location of the waypoint.
+	Location GeoWaypoint
+	// StopDuration is the time spent at the waypoint, rounded to seconds.
+	StopDuration time.Duration
+	// PassThrough vias only shape the route and do not split it into legs.
+	PassThrough bool
+}
+
+// String returns the via in the format of the via query parameter.
+func (v Via) String() string {
+	s := fmt.Sprintf("%v,%v", v.Location.Lat, v.Location.Long)
+	if seconds := int64(v.StopDuration / time.Second); seconds > 0 {
+		s += "!stopDuration=" + strconv.FormatInt(seconds, 10)
+	}
+	if v.PassThrough {
+		s += "!passThrough=true"
+	}
+	return s
 }
 
 type GeoWaypoint struct {
@@ -58,13 +119,12 @@ type GeoWaypoint struct {
 	Elv  float64 `json:"elv,omitempty"`
 }
 
-// DepartureTimeAny enforces non time-aware routing.
-const DepartureTimeAny = "any"
-
+// Profile is a predefined set of transport mode and routing options of a matrix calculation. Profiles can only be
+// used with world region definitions.
 type Profile int
 
 const (
-	ProfileUnspecified = iota
+	ProfileUnspecified Profile = iota
 	// ProfileCarFast - Car with fast routing mode.
 	ProfileCarFast
 	// ProfileCarShort - Car with short routing mode.
@@ -111,7 +171,7 @@ func (p *Profile) UnmarshalString(value string) error {
 	case "bicycle":
 		*p = ProfileBicycle
 	default:
-		return fmt.Errorf("invalid profile")
+		return fmt.Errorf("invalid profile: %q", value)
 	}
 	return nil
 }
@@ -174,7 +234,7 @@ func (r *RegionType) UnmarshalString(value string) error {
 	case "autoCircle":
 		*r = RegionTypeAutoCircle
 	default:
-		return fmt.Errorf("invalid region type")
+		return fmt.Errorf("invalid region type: %q", value)
 	}
 	return nil
 }
@@ -191,20 +251,25 @@ func (r *RegionType) UnmarshalJSON(b []byte) error {
 	return r.UnmarshalString(value)
 }
 
+// RegionDefinition of a matrix calculation. Only the fields of the Type are used, see WorldRegion, CircleRegion,
+// BoundingBoxRegion, PolygonRegion and AutoCircleRegion.
 type RegionDefinition struct {
 	Type RegionType `json:"type"`
 	// Circle
 	CircleCenter *GeoWaypoint `json:"center,omitempty"`
 	CircleRadius int          `json:"radius,omitempty"`
 	// BoundingBox
-	BoundingBoxNorth int `json:"north,omitempty"`
-	BoundingBoxEast  int `json:"east,omitempty"`
-	BoundingBoxSouth int `json:"south,omitempty"`
-	BoundingBoxWest  int `json:"west,omitempty"`
+	BoundingBoxNorth float64 `json:"north,omitempty"`
+	BoundingBoxEast  float64 `json:"east,omitempty"`
+	BoundingBoxSouth float64 `json:"south,omitempty"`
+	BoundingBoxWest  float64 `json:"west,omitempty"`
 	// Polygon
 	PolygonOuter []*GeoWaypoint `json:"outer,omitempty"`
 	// AutoCircle
 	AutoCircleMargin int `json:"margin,omitempty"`
+	// AutoCircleMaxRadius limits the radius of the auto circle in meters. The API clamps larger circles to the
+	// maximum radius, see CalculateMatrixResponse.RegionWarning.
+	AutoCircleMaxRadius int `json:"maxRadius,omitempty"`
 }
 
 type Async bool
@@ -237,8 +302,37 @@ func (m *MatrixAttribute) String() string {
 	}
 }
 
+func (m *MatrixAttribute) UnmarshalString(value string) error {
+	switch value {
+	case "travelTimes":
+		*m = MatrixAttributeTravelTimes
+	case "distances":
+		*m = MatrixAttributeDistances
+	default:
+		return fmt.Errorf("invalid matrix attribute: %q", value)
+	}
+	return nil
+}
+
+// MatrixAttributes select the matrices to calculate, travel times, distances or both.
+// The API calculates travel times when no attributes are requested.
 type MatrixAttributes []MatrixAttribute
 
+// Validate checks that the attributes are known and not repeated.
+func (m MatrixAttributes) Validate() error {
+	seen := make(map[MatrixAttribute]bool, len(m))
+	for _, attr := range m {
+		if name := attr.String(); name == invalid || name == unspecified {
+			return fmt.Errorf("invalid matrix attribute: %d", int(attr))
+		}
+		if seen[attr] {
+			return fmt.Errorf("invalid matrix attributes: %s repeated", attr.String())
+		}
+		seen[attr] = true
+	}
+	return nil
+}
+
 func (m *MatrixAttributes) MarshalJSON() ([]byte, error) {
 	attributes := make([]string, 0, len(*m))
 	for _, attr := range *m {
@@ -251,6 +345,113 @@ func (m *MatrixAttributes) MarshalJSON() ([]byte, error) {
 	return b, nil
 }
 
+func (m *MatrixAttributes) UnmarshalJSON(b []byte) error {
+	var values []string
+	if err := json.Unmarshal(b, &values); err != nil {
+		return err
+	}
+	attributes := make(MatrixAttributes, len(values))
+	for i, value := range values {
+		if err := attributes[i].UnmarshalString(value); err != nil {
+			return err
+		}
+	}
+	*m = attributes
+	return nil
+}
+
+// ReturnAttribute is an attribute to include in the routes of a RoutesRequest.
+type ReturnAttribute int
+
+const (
+	ReturnUnspecified ReturnAttribute = iota
+	ReturnPolyline
+	ReturnActions
+	ReturnInstructions
+	ReturnSummary
+	ReturnTravelSummary
+	ReturnElevation
+	ReturnTolls
+	ReturnIncidents
+	ReturnRouteHandle
+	ReturnPassthrough
+	ReturnTypicalDuration
+	ReturnTurnByTurnActions
+	ReturnRouteLabels
+	ReturnRoutingZones
+)
+
+func (r ReturnAttribute) String() string {
+	switch r {
+	case ReturnUnspecified:
+		return unspecified
+	case ReturnPolyline:
+		return "polyline"
+	case ReturnActions:
+		return "actions"
+	case ReturnInstructions:
+		return "instructions"
+	case ReturnSummary:
+		return "summary"
+	case ReturnTravelSummary:
+		return "travelSummary"
+	case ReturnElevation:
+		return "elevation"
+	case ReturnTolls:
+		return "tolls"
+	case ReturnIncidents:
+		return "incidents"
+	case ReturnRouteHandle:
+		return "routeHandle"
+	case ReturnPassthrough:
+		return "passthrough"
+	case ReturnTypicalDuration:
+		return "typicalDuration"
+	case ReturnTurnByTurnActions:
+		return "turnByTurnActions"
+	case ReturnRouteLabels:
+		return "routeLabels"
+	case ReturnRoutingZones:
+		return "routingZones"
+	default:
+		return invalid
+	}
+}
+
+// Units of measurement of the instructions in a routes response.
+type Units int
+
+const (
+	UnitsUnspecified Units = iota
+	UnitsMetric
+	UnitsImperial
+)
+
+func (u Units) String() string {
+	switch u {
+	case UnitsUnspecified:
+		return unspecified
+	case UnitsMetric:
+		return "metric"
+	case UnitsImperial:
+		return "imperial"
+	default:
+		return invalid
+	}
+}
+
+// DefaultReturn are the attributes returned when RoutesRequest.Return is empty.
+var DefaultReturn = []ReturnAttribute{
+	ReturnSummary,
+	ReturnPolyline,
+	ReturnElevation,
+	ReturnActions,
+	ReturnInstructions,
+	ReturnTravelSummary,
+	ReturnTolls,
+	ReturnIncidents,
+}
+
 type RoutingMode int
 
 const (
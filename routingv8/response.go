@@ -2,15 +2,20 @@ package routingv8
 
 import (
 	"encoding/json"
+	"fmt"
 	"time"
 )
 
 type ErrorCodes []ErrorCode
 
 func (e *ErrorCodes) UnmarshalJSON(b []byte) error {
-	errorCodes := make([]int, 0)
+	var errorCodes []int
 	if err := json.Unmarshal(b, &errorCodes); err != nil {
-		return err
+		return fmt.Errorf("unmarshal error codes: %w", err)
+	}
+	if errorCodes == nil {
+		*e = nil
+		return nil
 	}
 	codes := make([]ErrorCode, 0, len(errorCodes))
 	for _, errorCode := range errorCodes {
@@ -54,6 +59,21 @@ type CalculateMatrixResponse struct {
 	Matrix MatrixResponse `json:"matrix"`
 	// RegionDefinition to be used to calculate matrix.
 	RegionDefinition RegionDefinition `json:"regionDefinition"`
+	// Notices about the calculation, such as origins or destinations moved into the region.
+	Notices []Notice `json:"notices,omitempty"`
+	// RegionWarning is set by the MatrixService when the matrix was calculated in another region than requested.
+	RegionWarning *RegionWarning `json:"-"`
+}
+
+// NoticesWithCode returns the notices of the matrix calculation with the given code.
+func (r *CalculateMatrixResponse) NoticesWithCode(code string) []Notice {
+	var notices []Notice
+	for _, notice := range r.Notices {
+		if notice.Code == code {
+			notices = append(notices, notice)
+		}
+	}
+	return notices
 }
 
 // RoutesResponse contains the possible routes.
@@ -86,6 +106,7 @@ type Section struct {
 	Summary       Summary      `json:"summary"`
 	TravelSummary Summary      `json:"travelSummary"`
 	Polyline      string       `json:"polyline"`
+	Actions       []Action     `json:"actions"`
 	Spans         []Span       `json:"spans"`
 	Notices       []Notice     `json:"notices"`
 	Language      string       `json:"language"`
@@ -93,6 +114,44 @@ type Section struct {
 	Incidents     []Incident   `json:"incidents"`
 	Tolls         []Toll       `json:"tolls"`
 	TollSystems   []TollSystem `json:"tollSystems"`
+	// PostActions are the actions to perform after arriving, such as charging an electric vehicle.
+	PostActions []PostAction `json:"postActions,omitempty"`
+	// IntermediateStops of transit sections between boarding and alighting.
+	IntermediateStops []IntermediateStop `json:"intermediateStops,omitempty"`
+	// Agency operating the section, for ferry, car shuttle train and transit sections.
+	Agency *Agency `json:"agency,omitempty"`
+	// RoutingZones the section passes through, such as environmental zones. Requires ReturnRoutingZones.
+	RoutingZones []RoutingZone `json:"routingZones,omitempty"`
+}
+
+// RoutingZone is a zone with special traffic regulations.
+type RoutingZone struct {
+	// Type of the zone, e.g. "environmental".
+	Type string `json:"type"`
+	// Name of the zone, e.g. "Umweltzone Berlin".
+	Name string `json:"name"`
+	// Code of the zone.
+	Code string `json:"code"`
+}
+
+// Action is a maneuver to perform along a section.
+type Action struct {
+	// Action is the type of the maneuver, e.g. depart, turn or arrive.
+	Action string `json:"action"`
+	// Duration of the maneuver in seconds.
+	Duration int32 `json:"duration"`
+	// Length of the maneuver in meters.
+	Length int32 `json:"length"`
+	// Instruction is the human-readable instruction, in the requested language.
+	Instruction string `json:"instruction"`
+	// Offset is the index of the polyline point where the maneuver starts.
+	Offset int `json:"offset"`
+	// Direction of the maneuver, e.g. left, right or middle.
+	Direction string `json:"direction,omitempty"`
+	// Severity of the turn, e.g. light, quite or heavy.
+	Severity string `json:"severity,omitempty"`
+	// Exit is the number of the exit to take in a roundabout, counted from the entry.
+	Exit int `json:"exit,omitempty"`
 }
 
 type Toll struct {
@@ -103,12 +162,12 @@ type Toll struct {
 	TollCollectionLocations []TollCollectionLocation `json:"tollCollectionLocations"`
 }
 type Fare struct {
-	ID             string   `json:"id"`
-	Name           string   `json:"name"`
-	Price          Price    `json:"price"`
-	ConvertedPrice Price    `json:"convertedPrice"`
-	Reason         string   `json:"reason"`
-	PaymentMethods []string `json:"paymentMethods"`
+	ID             string          `json:"id"`
+	Name           string          `json:"name"`
+	Price          Price           `json:"price"`
+	ConvertedPrice Price           `json:"convertedPrice"`
+	Reason         string          `json:"reason"`
+	PaymentMethods []PaymentMethod `json:"paymentMethods"`
 }
 type Price struct {
 	Type     string  `json:"type"`
@@ -129,11 +188,71 @@ type Notice struct {
 	Title    string         `json:"title"`
 	Code     string         `json:"code"`
 	Severity string         `json:"severity"`
-	Details  []NoticeDetail `jsopn:"details"`
+	Details  []NoticeDetail `json:"details"`
 }
 
 type Transport struct {
 	Mode string `json:"mode"`
+	// Name of the line, such as a ferry line, car shuttle train or transit line, if known.
+	Name string `json:"name,omitempty"`
+	// Category of transit lines, e.g. "Bus" or "Regional train".
+	Category string `json:"category,omitempty"`
+	// Headsign is the destination displayed on the transit vehicle.
+	Headsign string `json:"headsign,omitempty"`
+	// ShortName of the transit line, e.g. "S1".
+	ShortName string `json:"shortName,omitempty"`
+	// LongName of the transit line.
+	LongName string `json:"longName,omitempty"`
+}
+
+// PlaceTypeChargingStation is the type of charging station places.
+const PlaceTypeChargingStation = "chargingStation"
+
+// ChargingStationAttributes describe the charging point used at a charging station.
+type ChargingStationAttributes struct {
+	// Power in kW.
+	Power float64 `json:"power"`
+	// Current in A.
+	Current float64 `json:"current"`
+	// Voltage in V.
+	Voltage float64 `json:"voltage"`
+	// SupplyType, e.g. "dc".
+	SupplyType string `json:"supplyType"`
+	// ConnectorType, e.g. "iec62196Type2Combo".
+	ConnectorType string `json:"connectorType,omitempty"`
+}
+
+const (
+	// PostActionChargingSetup is the action of setting up charging.
+	PostActionChargingSetup = "chargingSetup"
+	// PostActionCharging is the action of charging.
+	PostActionCharging = "charging"
+)
+
+// PostAction is an action to perform after arriving at the end of a section.
+type PostAction struct {
+	// Action, e.g. PostActionCharging.
+	Action string `json:"action"`
+	// Duration of the action in seconds.
+	Duration int32 `json:"duration"`
+	// ConsumablePower in kW for charging actions.
+	ConsumablePower float64 `json:"consumablePower,omitempty"`
+	// ArrivalCharge in kWh for charging actions.
+	ArrivalCharge float64 `json:"arrivalCharge,omitempty"`
+	// TargetCharge in kWh for charging actions.
+	TargetCharge float64 `json:"targetCharge,omitempty"`
+}
+
+// IntermediateStop is a stop of a transit vehicle between boarding and alighting.
+type IntermediateStop struct {
+	Departure RoutePlace `json:"departure"`
+}
+
+// Agency operating a ferry, car shuttle train or transit line.
+type Agency struct {
+	ID      string `json:"id"`
+	Name    string `json:"name"`
+	Website string `json:"website,omitempty"`
 }
 type NoticeDetail struct {
 	Type           string `json:"type"`
@@ -152,16 +271,51 @@ type Incident struct {
 type RoutePlace struct {
 	Time  time.Time `json:"time"`
 	Place Place     `json:"place"`
+	// Charge of the battery of an electric vehicle at the place in kWh.
+	Charge float64 `json:"charge,omitempty"`
 }
 
 type Span struct {
-	Offset     int     `json:"offset"`
-	Length     int     `json:"length"`
-	Duration   int     `json:"duration"`
-	SpeedLimit float64 `json:"speedLimit,omitempty"`
-	// MaxSpeed   float64 `json:"maxSpeed,omitempty"`
-	// Incidents  []int   `json:"incidents,omitempty"`
-	// Notices    []int   `json:"notices,omitempty"`
+	Offset   int `json:"offset"`
+	Length   int `json:"length"`
+	Duration int `json:"duration"`
+	// SpeedLimit is the legal speed limit of the span, see Speed.IsUnlimited for spans without limit.
+	SpeedLimit Speed `json:"speedLimit,omitempty"`
+	// MaxSpeed is the maximum speed allowed for the vehicle on the span.
+	MaxSpeed Speed `json:"maxSpeed,omitempty"`
+	// RoutingZones are the indices of the routing zones of the section the span is in.
+	RoutingZones []int `json:"routingZones,omitempty"`
+	// TruckAttributes of the roads of the span relevant to trucks, e.g. "noThroughTraffic".
+	TruckAttributes []string `json:"truckAttributes,omitempty"`
+	// Notices are the indices of the notices of the section which apply to the span.
+	Notices []int `json:"notices,omitempty"`
+	// Incidents are the indices of the incidents of the section which apply to the span.
+	Incidents []int `json:"incidents,omitempty"`
+	// Names of the road of the span, in the available languages.
+	Names []LocalizedString `json:"names,omitempty"`
+	// RouteNumbers of the road of the span, e.g. "E6".
+	RouteNumbers []LocalizedRouteNumber `json:"routeNumbers,omitempty"`
+	// SegmentID is the identifier of the map segment of the span, prefixed with "+" or "-" for the direction of
+	// travel.
+	SegmentID string `json:"segmentId,omitempty"`
+	// FunctionalClass of the road of the span, from 1 for the most important roads to 5.
+	FunctionalClass int `json:"functionalClass,omitempty"`
+	// CountryCode of the span as an ISO 3166-1 alpha-3 code.
+	CountryCode string `json:"countryCode,omitempty"`
+}
+
+// LocalizedString is a text in a language.
+type LocalizedString struct {
+	Value    string `json:"value"`
+	Language string `json:"language,omitempty"`
+}
+
+// LocalizedRouteNumber is a route number in a language.
+type LocalizedRouteNumber struct {
+	Value string `json:"value"`
+	// Direction of the route, e.g. "north".
+	Direction string `json:"direction,omitempty"`
+	Language  string `json:"language,omitempty"`
 }
 
 // Place with lat and long info on where the place is.
@@ -172,6 +326,17 @@ type Place struct {
 	Location GeoWaypoint `json:"location"`
 	// OriginalLocation in lat and long
 	OriginalLocation GeoWaypoint `json:"originalLocation"`
+	// ID of the place, e.g. of a transit station or charging station.
+	ID string `json:"id,omitempty"`
+	// Name of the place, e.g. of a transit station.
+	Name string `json:"name,omitempty"`
+	// Platform of a transit station to board or alight at.
+	Platform string `json:"platform,omitempty"`
+	// Attributes of a charging station.
+	Attributes *ChargingStationAttributes `json:"attributes,omitempty"`
+	// Waypoint is the index of the requested waypoint the place corresponds to, where the origin is 0, the vias
+	// follow in order and the destination is last. Nil for places which are not requested waypoints.
+	Waypoint *int `json:"waypoint,omitempty"`
 }
 
 // Summary contains the duration and length info.
@@ -182,6 +347,8 @@ type Summary struct {
 	Length int32 `json:"length"`
 	// BaseDuration is the duration without dynamic traffic information
 	BaseDuration int32 `json:"baseDuration"`
+	// Consumption of energy in kWh, for electric vehicles.
+	Consumption float64 `json:"consumption,omitempty"`
 }
 
 // HereErrorResponse is returned when an error is returned from the Here Maps API.
@@ -196,4 +363,19 @@ type HereErrorResponse struct {
 	Cause string `json:"cause"`
 	// Action Suggested to fix error
 	Action string `json:"action"`
+	// CorrelationID of the request
+	CorrelationID string `json:"correlationId"`
+	// ErrorDescription of errors returned by the authentication gateway
+	ErrorDescription string `json:"error_description"`
+}
+
+func (r *HereErrorResponse) Error() string {
+	return fmt.Sprintf(
+		"Title: %v, Status: %d, Code: %v, Cause: %v, Action: %v",
+		r.Title,
+		r.Status,
+		r.Code,
+		r.Cause,
+		r.Action,
+	)
 }
@@ -0,0 +1,73 @@
+package routingv8
+
+// TruckRestriction is a legal restriction along a route relevant for trucks, such as a weight-limited bridge or
+// a segment without through traffic.
+type TruckRestriction struct {
+	// SectionID of the section with the restriction.
+	SectionID string
+	// Offset is the index of the polyline point where the restriction starts, -1 if the restriction is not
+	// located on a span.
+	Offset int
+	// Length of the restricted span in meters.
+	Length int
+	// Code of the notice or the name of the truck attribute, e.g. "violatedVehicleRestriction".
+	Code string
+	// Severity of the notice, empty for truck attributes.
+	Severity string
+	// Details of the notice.
+	Details []NoticeDetail
+}
+
+// TruckRestrictions aggregates the notices with restriction details and the truck attributes of the spans of the
+// route into a single report, e.g. for reviewing routes before dispatch. Notices referenced by spans are reported
+// at the location of each span. Request SpanTruckAttributes, SpanNotices and SpanLength to get the
+// locations of the restrictions.
+func TruckRestrictions(route *Route) []TruckRestriction {
+	var result []TruckRestriction
+	for _, section := range route.Sections {
+		located := make(map[int]bool)
+		for _, span := range section.Spans {
+			for _, attribute := range span.TruckAttributes {
+				result = append(result, TruckRestriction{
+					SectionID: section.ID,
+					Offset:    span.Offset,
+					Length:    span.Length,
+					Code:      attribute,
+				})
+			}
+			for _, i := range span.Notices {
+				if i < 0 || i >= len(section.Notices) || !isRestrictionNotice(section.Notices[i]) {
+					continue
+				}
+				located[i] = true
+				result = append(result, newNoticeRestriction(section.ID, span.Offset, span.Length, section.Notices[i]))
+			}
+		}
+		for i, notice := range section.Notices {
+			if !located[i] && isRestrictionNotice(notice) {
+				result = append(result, newNoticeRestriction(section.ID, -1, 0, notice))
+			}
+		}
+	}
+	return result
+}
+
+func newNoticeRestriction(sectionID string, offset, length int, notice Notice) TruckRestriction {
+	return TruckRestriction{
+		SectionID: sectionID,
+		Offset:    offset,
+		Length:    length,
+		Code:      notice.Code,
+		Severity:  notice.Severity,
+		Details:   notice.Details,
+	}
+}
+
+func isRestrictionNotice(notice Notice) bool {
+	for _, detail := range notice.Details {
+		if detail.Type == "restriction" {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,28 @@
+package routingv8_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"go.einride.tech/here/routingv8"
+	"go.einride.tech/here/routingv8/routingv8test"
+	"gotest.tools/v3/assert"
+)
+
+func TestTruckRestrictions(t *testing.T) {
+	t.Parallel()
+	var resp routingv8.RoutesResponse
+	assert.NilError(t, json.Unmarshal([]byte(routingv8test.RoutesResponseJSON), &resp))
+	route := resp.Routes[0]
+	section := &route.Sections[0]
+	section.Spans[1].Notices = []int{0}
+	section.Spans[1].TruckAttributes = []string{"noThroughTraffic"}
+	section.Notices = append(section.Notices, routingv8.Notice{Code: "unrelated"})
+	restrictions := routingv8.TruckRestrictions(&route)
+	assert.Equal(t, 2, len(restrictions))
+	assert.Equal(t, "noThroughTraffic", restrictions[0].Code)
+	assert.Equal(t, 691, restrictions[0].Length)
+	assert.Equal(t, "violatedVehicleRestriction", restrictions[1].Code)
+	assert.Equal(t, 2, restrictions[1].Offset)
+	assert.Equal(t, 12000, restrictions[1].Details[0].MaxGrossWeight)
+}
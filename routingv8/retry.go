@@ -0,0 +1,14 @@
+package routingv8
+
+import "go.einride.tech/here/internal/hereapi"
+
+// RetryPolicy configures how failed requests are retried. Errors classified by IsRetryable are retried, other
+// errors are returned immediately. Only requests with idempotent methods are retried, unless RetryNonIdempotent is
+// set.
+type RetryPolicy = hereapi.RetryPolicy
+
+// Attempt describes a single failed attempt of a request.
+type Attempt = hereapi.Attempt
+
+// RetryError is returned when a request failed after more than one attempt, or when the retries were cancelled.
+type RetryError = hereapi.RetryError
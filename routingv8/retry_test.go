@@ -0,0 +1,158 @@
+package routingv8_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"go.einride.tech/here/routingv8"
+	"gotest.tools/v3/assert"
+)
+
+func TestClient_RetryPolicy(t *testing.T) {
+	t.Parallel()
+	newClient := func(statusCodes ...int) *routingv8.Client {
+		var i int
+		client := routingv8.NewClient(httpClientFunc(func(req *http.Request) (*http.Response, error) {
+			statusCode := statusCodes[i]
+			i++
+			if statusCode == 0 {
+				return nil, errors.New("connection reset")
+			}
+			body := `{"routes":[]}`
+			if statusCode != http.StatusOK {
+				body = `{"title":"error","status":` + strconv.Itoa(statusCode) + `}`
+			}
+			return &http.Response{StatusCode: statusCode, Body: io.NopCloser(strings.NewReader(body))}, nil
+		}))
+		client.RetryPolicy = &routingv8.RetryPolicy{MaxAttempts: 3}
+		return client
+	}
+	req := &routingv8.RoutesRequest{TransportMode: routingv8.TransportModeCar}
+
+	t.Run("success after retries", func(t *testing.T) {
+		t.Parallel()
+		client := newClient(http.StatusServiceUnavailable, 0, http.StatusOK)
+		_, err := client.Routing.Routes(context.Background(), req)
+		assert.NilError(t, err)
+	})
+	t.Run("retries exhausted", func(t *testing.T) {
+		t.Parallel()
+		client := newClient(0, http.StatusTooManyRequests, http.StatusInternalServerError)
+		_, err := client.Routing.Routes(context.Background(), req)
+		var retryErr *routingv8.RetryError
+		assert.Assert(t, errors.As(err, &retryErr), err)
+		assert.Equal(t, 3, len(retryErr.Attempts))
+		assert.Equal(t, 0, retryErr.Attempts[0].StatusCode)
+		assert.Equal(t, http.StatusTooManyRequests, retryErr.Attempts[1].StatusCode)
+		assert.Equal(t, http.StatusInternalServerError, retryErr.Attempts[2].StatusCode)
+	})
+	t.Run("permanent error", func(t *testing.T) {
+		t.Parallel()
+		client := newClient(http.StatusBadRequest, http.StatusOK)
+		_, err := client.Routing.Routes(context.Background(), req)
+		var retryErr *routingv8.RetryError
+		assert.Assert(t, err != nil)
+		assert.Assert(t, !errors.As(err, &retryErr), err)
+	})
+}
+
+// fakeClock is a Clock which fires timers immediately and records the requested durations.
+type fakeClock struct {
+	mu    sync.Mutex
+	now   time.Time
+	waits []time.Duration
+}
+
+func (f *fakeClock) Now() time.Time {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.now
+}
+
+func (f *fakeClock) NewTimer(d time.Duration) routingv8.Timer {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.now = f.now.Add(d)
+	f.waits = append(f.waits, d)
+	c := make(chan time.Time, 1)
+	c <- f.now
+	return fakeTimer(c)
+}
+
+// fakeTimer is a Timer which has already fired.
+type fakeTimer chan time.Time
+
+func (t fakeTimer) C() <-chan time.Time { return t }
+func (t fakeTimer) Stop() bool          { return false }
+
+func TestClient_RetryPolicy_Clock(t *testing.T) {
+	t.Parallel()
+	client := routingv8.NewClient(httpClientFunc(func(req *http.Request) (*http.Response, error) {
+		return nil, errors.New("connection refused")
+	}))
+	clock := &fakeClock{}
+	client.Clock = clock
+	client.RetryPolicy = &routingv8.RetryPolicy{MaxAttempts: 3, Backoff: time.Hour}
+	_, err := client.Routing.Routes(
+		context.Background(),
+		&routingv8.RoutesRequest{TransportMode: routingv8.TransportModeCar},
+	)
+	assert.ErrorContains(t, err, "failed after 3 attempts")
+	assert.DeepEqual(t, []time.Duration{time.Hour, 2 * time.Hour}, clock.waits)
+}
+
+func TestClient_RetryPolicy_StopsTimerOnCancel(t *testing.T) {
+	t.Parallel()
+	ctx, cancel := context.WithCancel(context.Background())
+	client := routingv8.NewClient(httpClientFunc(func(req *http.Request) (*http.Response, error) {
+		cancel()
+		return nil, errors.New("connection refused")
+	}))
+	clock := &stoppedClock{}
+	client.Clock = clock
+	client.RetryPolicy = &routingv8.RetryPolicy{MaxAttempts: 3, Backoff: 15 * time.Minute}
+	_, err := client.Routing.Routes(ctx, &routingv8.RoutesRequest{TransportMode: routingv8.TransportModeCar})
+	assert.ErrorContains(t, err, "connection refused")
+	assert.Equal(t, 1, clock.timers)
+	assert.Equal(t, 1, clock.stopped)
+}
+
+// stoppedClock is a Clock whose timers never fire and which counts the timers stopped.
+type stoppedClock struct {
+	mu      sync.Mutex
+	timers  int
+	stopped int
+}
+
+func (s *stoppedClock) Now() time.Time {
+	return time.Time{}
+}
+
+func (s *stoppedClock) NewTimer(time.Duration) routingv8.Timer {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.timers++
+	return &stoppedTimer{clock: s}
+}
+
+type stoppedTimer struct {
+	clock *stoppedClock
+}
+
+func (t *stoppedTimer) C() <-chan time.Time {
+	return nil
+}
+
+func (t *stoppedTimer) Stop() bool {
+	t.clock.mu.Lock()
+	defer t.clock.mu.Unlock()
+	t.clock.stopped++
+	return true
+}
@@ -0,0 +1,38 @@
+package routingv8
+
+import "time"
+
+// ReverseRequest returns the request of the return trip of req, from the destination to the origin through the
+// vias in reverse order. The vias keep their stop durations and pass through, since a stop is made at the same
+// place on the way back.
+//
+// Like ReverseRoute, the return trip departs after the trip of req: an arrival time of req becomes the departure
+// time of the return trip. The end of a trip with a departure time is unknown until its route is calculated, so a
+// specific departure time of req is dropped and the return trip departs now. Use ReverseRoute to depart when the
+// calculated route arrives. The other options, including time-independent departures, are copied as is.
+func ReverseRequest(req *RoutesRequest) RoutesRequest {
+	reversed := *req
+	reversed.Origin, reversed.Destination = req.Destination, req.Origin
+	if _, ok := req.DepartureTime.Time(); ok {
+		reversed.DepartureTime = DepartureTimeNow
+	}
+	if !req.ArrivalTime.IsZero() {
+		reversed.DepartureTime, reversed.ArrivalTime = DepartAt(req.ArrivalTime), time.Time{}
+	}
+	reversed.Vias = nil
+	for i := len(req.Vias) - 1; i >= 0; i-- {
+		reversed.Vias = append(reversed.Vias, req.Vias[i])
+	}
+	return reversed
+}
+
+// ReverseRoute returns the request of the return trip of the route, departing from its destination when the route
+// arrives there, through the stopover vias of the route in reverse order, see RoutesRequestFromRoute.
+func ReverseRoute(route *Route) RoutesRequest {
+	req := RoutesRequestFromRoute(route)
+	reversed := ReverseRequest(&req)
+	if len(route.Sections) > 0 {
+		reversed.DepartureTime = DepartAt(route.Sections[len(route.Sections)-1].Arrival.Time)
+	}
+	return reversed
+}
@@ -0,0 +1,102 @@
+package routingv8_test
+
+import (
+	"testing"
+	"time"
+
+	"go.einride.tech/here/routingv8"
+	"gotest.tools/v3/assert"
+)
+
+func TestReverseRequest(t *testing.T) {
+	t.Parallel()
+	departure := time.Date(2022, 6, 1, 8, 0, 0, 0, time.UTC)
+	a, b := routingv8.GeoWaypoint{Lat: 1, Long: 1}, routingv8.GeoWaypoint{Lat: 2, Long: 2}
+	request := func(departureTime routingv8.DepartureTime, arrivalTime time.Time) routingv8.RoutesRequest {
+		return routingv8.RoutesRequest{
+			Origin:      routingv8.GeoWaypoint{Lat: 0, Long: 0},
+			Destination: routingv8.GeoWaypoint{Lat: 3, Long: 3},
+			Vias: []routingv8.Via{
+				{Location: a, StopDuration: 10 * time.Minute},
+				{Location: b, PassThrough: true},
+			},
+			DepartureTime: departureTime,
+			ArrivalTime:   arrivalTime,
+			TransportMode: routingv8.TransportModeTruck,
+		}
+	}
+	reversed := func(departureTime routingv8.DepartureTime) routingv8.RoutesRequest {
+		return routingv8.RoutesRequest{
+			Origin:      routingv8.GeoWaypoint{Lat: 3, Long: 3},
+			Destination: routingv8.GeoWaypoint{Lat: 0, Long: 0},
+			Vias: []routingv8.Via{
+				{Location: b, PassThrough: true},
+				{Location: a, StopDuration: 10 * time.Minute},
+			},
+			DepartureTime: departureTime,
+			TransportMode: routingv8.TransportModeTruck,
+		}
+	}
+	for _, tt := range []struct {
+		name     string
+		req      routingv8.RoutesRequest
+		expected routingv8.RoutesRequest
+	}{
+		{
+			name:     "departure time",
+			req:      request(routingv8.DepartAt(departure), time.Time{}),
+			expected: reversed(routingv8.DepartureTimeNow),
+		},
+		{
+			name:     "arrival time",
+			req:      request(routingv8.DepartureTimeNow, departure),
+			expected: reversed(routingv8.DepartAt(departure)),
+		},
+		{
+			name:     "any departure time",
+			req:      request(routingv8.DepartureTimeAny, time.Time{}),
+			expected: reversed(routingv8.DepartureTimeAny),
+		},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			assert.DeepEqual(t, tt.expected, routingv8.ReverseRequest(&tt.req))
+			assert.Equal(t, a, tt.req.Vias[0].Location, "request is not modified")
+		})
+	}
+}
+
+func TestReverseRoute(t *testing.T) {
+	t.Parallel()
+	waypoint := func(i int) *int { return &i }
+	start := time.Date(2022, 6, 1, 8, 0, 0, 0, time.UTC)
+	route := routingv8.Route{Sections: []routingv8.Section{
+		{
+			Departure: routingv8.RoutePlace{Time: start, Place: routingv8.Place{Location: routingv8.GeoWaypoint{Lat: 0}}},
+			Arrival: routingv8.RoutePlace{
+				Time:  start.Add(time.Hour),
+				Place: routingv8.Place{Location: routingv8.GeoWaypoint{Lat: 1}, Waypoint: waypoint(1)},
+			},
+			Transport: routingv8.Transport{Mode: "car"},
+		},
+		{
+			Departure: routingv8.RoutePlace{
+				Time:  start.Add(time.Hour + 5*time.Minute),
+				Place: routingv8.Place{Location: routingv8.GeoWaypoint{Lat: 1}},
+			},
+			Arrival: routingv8.RoutePlace{
+				Time:  start.Add(2 * time.Hour),
+				Place: routingv8.Place{Location: routingv8.GeoWaypoint{Lat: 2}, Waypoint: waypoint(2)},
+			},
+			Transport: routingv8.Transport{Mode: "car"},
+		},
+	}}
+	assert.DeepEqual(t, routingv8.RoutesRequest{
+		Origin:        routingv8.GeoWaypoint{Lat: 2},
+		Destination:   routingv8.GeoWaypoint{Lat: 0},
+		Vias:          []routingv8.Via{{Location: routingv8.GeoWaypoint{Lat: 1}, StopDuration: 5 * time.Minute}},
+		DepartureTime: routingv8.DepartAt(start.Add(2 * time.Hour)),
+		TransportMode: routingv8.TransportModeCar,
+	}, routingv8.ReverseRoute(&route))
+}
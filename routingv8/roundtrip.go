@@ -0,0 +1,72 @@
+package routingv8
+
+import (
+	"fmt"
+	"math"
+
+	"go.einride.tech/here/geo"
+)
+
+const (
+	// DefaultLoopVias is the number of vias shaping a Loop by default.
+	DefaultLoopVias = 3
+	// DefaultLoopDetour is the ratio of the road distance to the straight-line distance of a Loop by default.
+	DefaultLoopDetour = 1.3
+)
+
+// RoundTripRequest returns a request for a round trip from the origin through the vias and back to the origin.
+func RoundTripRequest(origin GeoWaypoint, vias ...Via) RoutesRequest {
+	return RoutesRequest{
+		Origin:      origin,
+		Destination: origin,
+		Vias:        append([]Via(nil), vias...),
+	}
+}
+
+// Loop is a round trip of a target distance without given vias, e.g. a training run or a test drive.
+type Loop struct {
+	// Origin and destination of the loop.
+	Origin GeoWaypoint
+	// Distance is the target distance of the loop in meters.
+	Distance float64
+	// Bearing in degrees clockwise from north of the point of the loop furthest from the origin.
+	Bearing float64
+	// Vias is the number of pass-through vias shaping the loop. Defaults to DefaultLoopVias.
+	Vias int
+	// Detour is the ratio of the road distance to the straight-line distance, which depends on the road network.
+	// Defaults to DefaultLoopDetour.
+	Detour float64
+}
+
+// RoutesRequest returns a round trip request through pass-through vias evenly spaced on a circle through the origin,
+// with a circumference of the target distance divided by the detour. The distance of the route differs from the
+// target distance with the road network, and the request can be adjusted with the distance of the route.
+func (l *Loop) RoutesRequest() (RoutesRequest, error) {
+	if l.Distance <= 0 {
+		return RoutesRequest{}, fmt.Errorf("invalid loop distance: %v", l.Distance)
+	}
+	vias := DefaultLoopVias
+	if l.Vias != 0 {
+		vias = l.Vias
+	}
+	if vias < 2 {
+		return RoutesRequest{}, fmt.Errorf("invalid loop vias: %d, require at least 2", vias)
+	}
+	detour := DefaultLoopDetour
+	if l.Detour != 0 {
+		detour = l.Detour
+	}
+	if detour < 1 {
+		return RoutesRequest{}, fmt.Errorf("invalid loop detour: %v, require at least 1", detour)
+	}
+	radius := l.Distance / detour / (2 * math.Pi)
+	center := geo.Destination(l.Origin.point(), l.Bearing, radius)
+	// The origin is on the circle opposite to the bearing, seen from the center.
+	originBearing := l.Bearing + 180
+	req := RoundTripRequest(l.Origin)
+	for i := 1; i <= vias; i++ {
+		p := geo.Destination(center, originBearing+360*float64(i)/float64(vias+1), radius)
+		req.Vias = append(req.Vias, Via{Location: GeoWaypoint{Lat: p.Lat, Long: p.Lng}, PassThrough: true})
+	}
+	return req, nil
+}
@@ -0,0 +1,67 @@
+package routingv8_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"go.einride.tech/here/geo"
+	"go.einride.tech/here/routingv8"
+	"gotest.tools/v3/assert"
+)
+
+func TestRoundTripRequest(t *testing.T) {
+	t.Parallel()
+	origin := routingv8.GeoWaypoint{Lat: 57.7, Long: 11.9}
+	vias := []routingv8.Via{{Location: routingv8.GeoWaypoint{Lat: 58, Long: 12}, StopDuration: time.Minute}}
+	req := routingv8.RoundTripRequest(origin, vias...)
+	assert.DeepEqual(t, routingv8.RoutesRequest{Origin: origin, Destination: origin, Vias: vias}, req)
+	req.Vias[0].StopDuration = 0
+	assert.Equal(t, time.Minute, vias[0].StopDuration, "vias are copied")
+}
+
+func TestLoop_RoutesRequest(t *testing.T) {
+	t.Parallel()
+	origin := routingv8.GeoWaypoint{Lat: 57.7, Long: 11.9}
+	loop := routingv8.Loop{Origin: origin, Distance: 10000, Bearing: 90}
+	req, err := loop.RoutesRequest()
+	assert.NilError(t, err)
+	assert.Equal(t, origin, req.Origin)
+	assert.Equal(t, origin, req.Destination)
+	assert.Equal(t, routingv8.DefaultLoopVias, len(req.Vias))
+	point := func(w routingv8.GeoWaypoint) geo.Point { return geo.Point{Lat: w.Lat, Lng: w.Long} }
+	diameter := 10000 / routingv8.DefaultLoopDetour / math.Pi
+	far := req.Vias[1].Location
+	assert.Assert(t, math.Abs(geo.Distance(point(origin), point(far))-diameter) < 1)
+	assert.Assert(t, math.Abs(geo.Bearing(point(origin), point(far))-90) < 0.1)
+	for _, via := range req.Vias {
+		assert.Assert(t, via.PassThrough)
+	}
+	// The loop goes clockwise: first north of the line to the far point, then south.
+	assert.Assert(t, req.Vias[0].Location.Lat > origin.Lat)
+	assert.Assert(t, req.Vias[2].Location.Lat < origin.Lat)
+}
+
+func TestLoop_RoutesRequest_Invalid(t *testing.T) {
+	t.Parallel()
+	for _, tt := range []struct {
+		name string
+		loop routingv8.Loop
+		err  string
+	}{
+		{name: "distance", loop: routingv8.Loop{}, err: "invalid loop distance: 0"},
+		{name: "vias", loop: routingv8.Loop{Distance: 1000, Vias: 1}, err: "invalid loop vias: 1, require at least 2"},
+		{
+			name: "detour",
+			loop: routingv8.Loop{Distance: 1000, Detour: 0.5},
+			err:  "invalid loop detour: 0.5, require at least 1",
+		},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			_, err := tt.loop.RoutesRequest()
+			assert.Error(t, err, tt.err)
+		})
+	}
+}
@@ -5,6 +5,9 @@ import (
 	"fmt"
 	"net/http"
 	"net/url"
+	"strconv"
+	"strings"
+	"time"
 )
 
 // Routes returns all possible routes between origin and destination.
@@ -14,32 +17,186 @@ func (s *RoutingService) Routes(
 	ctx context.Context,
 	req *RoutesRequest,
 ) (_ *RoutesResponse, err error) {
+	values, err := req.query()
+	if err != nil {
+		return nil, &invalidInputError{err: err}
+	}
+	u, err := s.URL.Parse("routes")
+	if err != nil {
+		return nil, err
+	}
+
+	r, err := s.Client.NewRequest(ctx, u, http.MethodGet, values.Encode(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create get request: %v", err)
+	}
+	var resp RoutesResponse
+	if err := s.Client.Do(r, &resp); err != nil {
+		return nil, err
+	}
+	if s.Client.ValidateResponses {
+		if err := resp.Validate(); err != nil {
+			return nil, err
+		}
+	}
+	if req.MaxAlternativeOverlap > 0 {
+		if resp.Routes, err = DistinctRoutes(resp.Routes, req.MaxAlternativeOverlap); err != nil {
+			return nil, err
+		}
+	}
+	return &resp, nil
+}
+
+// query returns the query parameters of the request, or an error if the request is invalid.
+func (req *RoutesRequest) query() (url.Values, error) {
 	tm := req.TransportMode.String()
 	if tm == invalid || tm == unspecified {
 		return nil, fmt.Errorf("invalid transportmode")
 	}
+	rm := req.RoutingMode.String()
+	if rm == invalid {
+		return nil, fmt.Errorf("invalid routingmode")
+	}
+	if req.Alternatives > MaxAlternatives {
+		return nil, fmt.Errorf("invalid alternatives: %d", req.Alternatives)
+	}
+	if err := req.DepartureTime.Validate(); err != nil {
+		return nil, err
+	}
+	departureTime := req.DepartureTime.resolve(false)
+	if !departureTime.IsNow() && !req.ArrivalTime.IsZero() {
+		if departureTime.IsAny() {
+			return nil, fmt.Errorf("invalid time: any departure time combined with arrival time")
+		}
+		return nil, fmt.Errorf("invalid time: both departure and arrival time set")
+	}
+	if req.Currency != "" && !isCurrencyCode(req.Currency) {
+		return nil, fmt.Errorf("invalid currency: %q", req.Currency)
+	}
 
-	u, err := s.URL.Parse("routes")
+	attributes := req.Return
+	if len(attributes) == 0 {
+		attributes = DefaultReturn
+	}
+	returnValue, err := returnQuery(attributes)
 	if err != nil {
 		return nil, err
 	}
 
 	values := make(url.Values)
-	values.Add("return", "summary,polyline,elevation,actions,instructions,travelSummary,tolls,incidents")
+	values.Add("return", returnValue)
 	values.Add("transportMode", tm)
+	if rm != unspecified {
+		values.Add("routingMode", rm)
+	}
 	values.Add("origin", fmt.Sprintf("%v,%v", req.Origin.Lat, req.Origin.Long))
 	values.Add("destination", fmt.Sprintf("%v,%v", req.Destination.Lat, req.Destination.Long))
-	values.Add("spans", "length,duration,maxSpeed,speedLimit,incidents,notices")
-	values.Add("alternatives", "6")
-	values.Add("currency", "EUR")
+	for _, via := range req.Vias {
+		values.Add("via", via.String())
+	}
+	if req.Truck != nil {
+		if req.TransportMode != TransportModeTruck {
+			return nil, fmt.Errorf("invalid truck: requires transport mode truck, got %s", tm)
+		}
+		if err := req.Truck.addQuery(values); err != nil {
+			return nil, err
+		}
+	}
+	if req.Taxi != nil {
+		if req.TransportMode != TransportModeTaxi {
+			return nil, fmt.Errorf("invalid taxi: requires transport mode taxi, got %s", tm)
+		}
+		req.Taxi.addQuery(values)
+	}
+	if req.EV != nil {
+		if err := req.EV.addQuery(values); err != nil {
+			return nil, err
+		}
+	}
+	if req.Avoid != nil {
+		if err := req.Avoid.addQuery(values); err != nil {
+			return nil, err
+		}
+	}
+	switch {
+	case !departureTime.IsNow():
+		values.Add("departureTime", string(departureTime))
+	case !req.ArrivalTime.IsZero():
+		values.Add("arrivalTime", req.ArrivalTime.Format(time.RFC3339))
+	}
+	if len(req.Spans) > 0 {
+		if !hasReturnAttribute(attributes, ReturnPolyline) {
+			return nil, fmt.Errorf("invalid spans: require the polyline")
+		}
+		spans, err := spansQuery(req.Spans)
+		if err != nil {
+			return nil, err
+		}
+		values.Add("spans", spans)
+	}
+	switch {
+	case req.Alternatives == 0:
+		values.Add("alternatives", strconv.Itoa(DefaultAlternatives))
+	case req.Alternatives > 0:
+		values.Add("alternatives", strconv.Itoa(req.Alternatives))
+	}
+	if hasReturnAttribute(attributes, ReturnTolls) {
+		currency := req.Currency
+		if currency == "" {
+			currency = DefaultCurrency
+		}
+		values.Add("currency", currency)
+	}
+	if req.Lang != "" {
+		values.Add("lang", req.Lang)
+	}
+	switch units := req.Units.String(); units {
+	case unspecified:
+	case invalid:
+		return nil, fmt.Errorf("invalid units: %d", int(req.Units))
+	default:
+		values.Add("units", units)
+	}
+	return values, nil
+}
 
-	r, err := s.Client.NewRequest(ctx, u, http.MethodGet, values.Encode(), nil)
-	if err != nil {
-		return nil, fmt.Errorf("unable to create get request: %v", err)
+// DefaultCurrency is the currency of toll prices when a routes request has no currency.
+const DefaultCurrency = "EUR"
+
+// isCurrencyCode reports whether code has the form of an ISO 4217 currency code.
+func isCurrencyCode(code string) bool {
+	if len(code) != 3 {
+		return false
 	}
-	var resp RoutesResponse
-	if err := s.Client.Do(r, &resp); err != nil {
-		return nil, err
+	for _, r := range code {
+		if r < 'A' || r > 'Z' {
+			return false
+		}
 	}
-	return &resp, nil
+	return true
+}
+
+// returnQuery returns the value of the return query parameter for the attributes.
+func returnQuery(attributes []ReturnAttribute) (string, error) {
+	names := make([]string, 0, len(attributes))
+	for _, attribute := range attributes {
+		name := attribute.String()
+		if name == invalid || name == unspecified {
+			return "", fmt.Errorf("invalid return attribute: %d", int(attribute))
+		}
+		names = append(names, name)
+	}
+	if hasReturnAttribute(attributes, ReturnInstructions) && !hasReturnAttribute(attributes, ReturnActions) {
+		return "", fmt.Errorf("invalid return attributes: instructions require actions")
+	}
+	return strings.Join(names, ","), nil
+}
+
+func hasReturnAttribute(attributes []ReturnAttribute, attribute ReturnAttribute) bool {
+	for _, a := range attributes {
+		if a == attribute {
+			return true
+		}
+	}
+	return false
 }
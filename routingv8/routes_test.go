@@ -6,7 +6,10 @@ import (
 	"encoding/json"
 	"io"
 	"net/http"
+	"net/url"
+	"strings"
 	"testing"
+	"time"
 
 	"go.einride.tech/here/routingv8"
 	"gotest.tools/v3/assert"
@@ -56,15 +59,19 @@ func TestRoutingervice_Routes(t *testing.T) {
 					{
 						ID:   "section-1",
 						Type: "veicle",
-						Departure: routingv8.Place{
-							Type:             "place",
-							Location:         origin,
-							OriginalLocation: origin,
+						Departure: routingv8.RoutePlace{
+							Place: routingv8.Place{
+								Type:             "place",
+								Location:         origin,
+								OriginalLocation: origin,
+							},
 						},
-						Arrival: routingv8.Place{
-							Type:             "place",
-							Location:         destination,
-							OriginalLocation: destination,
+						Arrival: routingv8.RoutePlace{
+							Place: routingv8.Place{
+								Type:             "place",
+								Location:         destination,
+								OriginalLocation: destination,
+							},
 						},
 						Summary: routingv8.Summary{
 							Duration:     243,
@@ -88,3 +95,235 @@ func TestRoutingervice_Routes(t *testing.T) {
 	assert.NilError(t, err)
 	assert.DeepEqual(t, &exp, got)
 }
+
+func TestRoutingService_Routes_Return(t *testing.T) {
+	t.Parallel()
+	var query url.Values
+	client := routingv8.NewClient(httpClientFunc(func(req *http.Request) (*http.Response, error) {
+		query = req.URL.Query()
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(`{}`))}, nil
+	}))
+	_, err := client.Routing.Routes(context.Background(), &routingv8.RoutesRequest{
+		TransportMode: routingv8.TransportModeCar,
+		Return:        []routingv8.ReturnAttribute{routingv8.ReturnSummary, routingv8.ReturnTolls},
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, "summary,tolls", query.Get("return"))
+	assert.Equal(t, "", query.Get("spans"))
+	assert.Equal(t, "EUR", query.Get("currency"))
+	_, err = client.Routing.Routes(context.Background(), &routingv8.RoutesRequest{
+		TransportMode: routingv8.TransportModeCar,
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, "summary,polyline,elevation,actions,instructions,travelSummary,tolls,incidents", query.Get("return"))
+	assert.Equal(t, "", query.Get("spans"))
+	_, err = client.Routing.Routes(context.Background(), &routingv8.RoutesRequest{
+		TransportMode: routingv8.TransportModeTruck,
+		Spans: []routingv8.SpanAttribute{
+			routingv8.SpanNames,
+			routingv8.SpanRouteNumbers,
+			routingv8.SpanSegmentID,
+			routingv8.SpanFunctionalClass,
+			routingv8.SpanCountryCode,
+			routingv8.SpanTruckAttributes,
+		},
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, "names,routeNumbers,segmentId,functionalClass,countryCode,truckAttributes", query.Get("spans"))
+	_, err = client.Routing.Routes(context.Background(), &routingv8.RoutesRequest{
+		TransportMode: routingv8.TransportModeCar,
+		Return:        []routingv8.ReturnAttribute{routingv8.ReturnSummary},
+		Spans:         []routingv8.SpanAttribute{routingv8.SpanLength},
+	})
+	assert.Error(t, err, "invalid spans: require the polyline")
+	_, err = client.Routing.Routes(context.Background(), &routingv8.RoutesRequest{
+		TransportMode: routingv8.TransportModeCar,
+		Spans:         []routingv8.SpanAttribute{routingv8.SpanUnspecified},
+	})
+	assert.Error(t, err, "invalid span attribute: 0")
+	_, err = client.Routing.Routes(context.Background(), &routingv8.RoutesRequest{
+		TransportMode: routingv8.TransportModeCar,
+		Return:        []routingv8.ReturnAttribute{routingv8.ReturnTolls},
+		Currency:      "USD",
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, "USD", query.Get("currency"))
+	_, err = client.Routing.Routes(context.Background(), &routingv8.RoutesRequest{
+		TransportMode: routingv8.TransportModeCar,
+		Return:        []routingv8.ReturnAttribute{routingv8.ReturnSummary},
+		Currency:      "USD",
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, "", query.Get("currency"))
+	_, err = client.Routing.Routes(context.Background(), &routingv8.RoutesRequest{
+		TransportMode: routingv8.TransportModeCar,
+		Currency:      "usd",
+	})
+	assert.Error(t, err, `invalid currency: "usd"`)
+	_, err = client.Routing.Routes(context.Background(), &routingv8.RoutesRequest{
+		TransportMode: routingv8.TransportModeCar,
+		Return:        []routingv8.ReturnAttribute{routingv8.ReturnInstructions},
+	})
+	assert.Error(t, err, "invalid return attributes: instructions require actions")
+}
+
+func TestRoutingService_Routes_RoutingMode(t *testing.T) {
+	t.Parallel()
+	var query url.Values
+	client := routingv8.NewClient(httpClientFunc(func(req *http.Request) (*http.Response, error) {
+		query = req.URL.Query()
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(`{}`))}, nil
+	}))
+	_, err := client.Routing.Routes(context.Background(), &routingv8.RoutesRequest{
+		TransportMode: routingv8.TransportModeCar,
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, "", query.Get("routingMode"))
+	_, err = client.Routing.Routes(context.Background(), &routingv8.RoutesRequest{
+		TransportMode: routingv8.TransportModeCar,
+		RoutingMode:   routingv8.RoutingModeShort,
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, "short", query.Get("routingMode"))
+	_, err = client.Routing.Routes(context.Background(), &routingv8.RoutesRequest{
+		TransportMode: routingv8.TransportModeCar,
+		RoutingMode:   routingv8.RoutingMode(42),
+	})
+	assert.Error(t, err, "invalid routingmode")
+}
+
+func TestRoutingService_Routes_LangUnits(t *testing.T) {
+	t.Parallel()
+	var query url.Values
+	client := routingv8.NewClient(httpClientFunc(func(req *http.Request) (*http.Response, error) {
+		query = req.URL.Query()
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(`{}`))}, nil
+	}))
+	_, err := client.Routing.Routes(context.Background(), &routingv8.RoutesRequest{
+		TransportMode: routingv8.TransportModeCar,
+		Lang:          "en-GB",
+		Units:         routingv8.UnitsImperial,
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, "en-GB", query.Get("lang"))
+	assert.Equal(t, "imperial", query.Get("units"))
+	_, err = client.Routing.Routes(context.Background(), &routingv8.RoutesRequest{
+		TransportMode: routingv8.TransportModeCar,
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, "", query.Get("lang"))
+	assert.Equal(t, "", query.Get("units"))
+	_, err = client.Routing.Routes(context.Background(), &routingv8.RoutesRequest{
+		TransportMode: routingv8.TransportModeCar,
+		Units:         routingv8.Units(7),
+	})
+	assert.Error(t, err, "invalid units: 7")
+}
+
+func TestRoutingService_Routes_Time(t *testing.T) {
+	t.Parallel()
+	var query url.Values
+	client := routingv8.NewClient(httpClientFunc(func(req *http.Request) (*http.Response, error) {
+		query = req.URL.Query()
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(`{}`))}, nil
+	}))
+	arrival := time.Date(2022, 6, 1, 17, 0, 0, 0, time.FixedZone("", 2*60*60))
+	_, err := client.Routing.Routes(context.Background(), &routingv8.RoutesRequest{
+		TransportMode: routingv8.TransportModeCar,
+		ArrivalTime:   arrival,
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, "2022-06-01T17:00:00+02:00", query.Get("arrivalTime"))
+	assert.Equal(t, "", query.Get("departureTime"))
+	_, err = client.Routing.Routes(context.Background(), &routingv8.RoutesRequest{
+		TransportMode: routingv8.TransportModeCar,
+		DepartureTime: routingv8.DepartureTimeAny,
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, "any", query.Get("departureTime"))
+	_, err = client.Routing.Routes(context.Background(), &routingv8.RoutesRequest{
+		TransportMode: routingv8.TransportModeCar,
+		DepartureTime: routingv8.DepartAt(arrival.Add(-time.Hour)),
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, "2022-06-01T16:00:00+02:00", query.Get("departureTime"))
+	// Routes support traffic, so preferring traffic departs now.
+	_, err = client.Routing.Routes(context.Background(), &routingv8.RoutesRequest{
+		TransportMode: routingv8.TransportModeCar,
+		DepartureTime: routingv8.DepartureTimePreferTraffic,
+		ArrivalTime:   arrival,
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, "", query.Get("departureTime"))
+	assert.Equal(t, "2022-06-01T17:00:00+02:00", query.Get("arrivalTime"))
+	_, err = client.Routing.Routes(context.Background(), &routingv8.RoutesRequest{
+		TransportMode: routingv8.TransportModeCar,
+		DepartureTime: routingv8.DepartAt(arrival.Add(-time.Hour)),
+		ArrivalTime:   arrival,
+	})
+	assert.Error(t, err, "invalid time: both departure and arrival time set")
+	_, err = client.Routing.Routes(context.Background(), &routingv8.RoutesRequest{
+		TransportMode: routingv8.TransportModeCar,
+		DepartureTime: routingv8.DepartureTimeAny,
+		ArrivalTime:   arrival,
+	})
+	assert.Error(t, err, "invalid time: any departure time combined with arrival time")
+	_, err = client.Routing.Routes(context.Background(), &routingv8.RoutesRequest{
+		TransportMode: routingv8.TransportModeCar,
+		DepartureTime: "tomorrow",
+	})
+	assert.Error(t, err, `invalid departure time: "tomorrow"`)
+}
+
+func TestRoutingService_Routes_Avoid(t *testing.T) {
+	t.Parallel()
+	var query url.Values
+	client := routingv8.NewClient(httpClientFunc(func(req *http.Request) (*http.Response, error) {
+		query = req.URL.Query()
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(`{}`))}, nil
+	}))
+	_, err := client.Routing.Routes(context.Background(), &routingv8.RoutesRequest{
+		TransportMode: routingv8.TransportModeTruck,
+		Avoid: &routingv8.Avoid{
+			Features: []routingv8.AvoidFeature{routingv8.AvoidFeatureTunnel, routingv8.AvoidFeatureFerry},
+			Areas: []routingv8.BoundingBox{
+				{North: 52.626, South: 52.416, East: 13.628, West: 13.082},
+				{North: 48.2, South: 48.1, East: 11.7, West: 11.5},
+			},
+			Segments: []string{"here:cm:segment:76771992#+", "here:cm:segment:76771993"},
+		},
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, "tunnel,ferry", query.Get("avoid[features]"))
+	assert.Equal(t, "bbox:13.082,52.416,13.628,52.626|bbox:11.5,48.1,11.7,48.2", query.Get("avoid[areas]"))
+	assert.Equal(t, "here:cm:segment:76771992#+,here:cm:segment:76771993", query.Get("avoid[segments]"))
+}
+
+func TestRoutingService_Routes_Truck(t *testing.T) {
+	t.Parallel()
+	var query url.Values
+	client := routingv8.NewClient(httpClientFunc(func(req *http.Request) (*http.Response, error) {
+		query = req.URL.Query()
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(`{}`))}, nil
+	}))
+	truck := routingv8.HazmatTruck(
+		routingv8.Truck{GrossWeight: 40000, Height: 400, AxleCount: 5},
+		routingv8.ShippedHazardousGoodsFlammable,
+	)
+	_, err := client.Routing.Routes(context.Background(), &routingv8.RoutesRequest{
+		TransportMode: routingv8.TransportModeTruck,
+		Truck:         &truck,
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, "40000", query.Get("vehicle[grossWeight]"))
+	assert.Equal(t, "400", query.Get("vehicle[height]"))
+	assert.Equal(t, "5", query.Get("vehicle[axleCount]"))
+	assert.Equal(t, "", query.Get("vehicle[width]"))
+	assert.Equal(t, "flammable", query.Get("vehicle[shippedHazardousGoods]"))
+	assert.Equal(t, "D", query.Get("vehicle[tunnelCategory]"))
+	_, err = client.Routing.Routes(context.Background(), &routingv8.RoutesRequest{
+		TransportMode: routingv8.TransportModeCar,
+		Truck:         &truck,
+	})
+	assert.Error(t, err, "invalid truck: requires transport mode truck, got car")
+}
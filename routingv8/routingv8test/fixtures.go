@@ -0,0 +1,250 @@
+// Package routingv8test provides synthetic HERE Routing API v8 responses for testing integrations. The responses are
+// written by hand after the examples of the API documentation, and are not recorded from the API.
+package routingv8test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"go.einride.tech/here/routingv8"
+)
+
+// RoutesResponseJSON is a truck route response with actions, spans, notices, incidents and tolls.
+const RoutesResponseJSON = `{
+  "routes": [
+    {
+      "id": "c5a1e6c4-1f2a-4c8e-9f0e-5b8d1c3e2a10",
+      "sections": [
+        {
+          "id": "0b6e5b3d-6f4c-4d2b-8d5a-6c7b9e1f2a31",
+          "type": "vehicle",
+          "actions": [
+            {
+              "action": "depart",
+              "duration": 32,
+              "length": 166,
+              "instruction": "Head northwest on Mainzer Landstraße. Go for 166 m.",
+              "offset": 0
+            },
+            {
+              "action": "turn",
+              "duration": 61,
+              "length": 345,
+              "instruction": "Turn right onto Taunusanlage. Go for 345 m.",
+              "offset": 2,
+              "direction": "right",
+              "severity": "quite"
+            },
+            {
+              "action": "arrive",
+              "duration": 0,
+              "length": 0,
+              "instruction": "Arrive at Taunusanlage.",
+              "offset": 3
+            }
+          ],
+          "departure": {
+            "time": "2022-06-01T10:00:00+02:00",
+            "place": {
+              "type": "place",
+              "location": {"lat": 50.10228, "lng": 8.69821},
+              "originalLocation": {"lat": 50.1023, "lng": 8.6981}
+            }
+          },
+          "arrival": {
+            "time": "2022-06-01T10:01:33+02:00",
+            "place": {
+              "type": "place",
+              "location": {"lat": 50.09878, "lng": 8.68752},
+              "originalLocation": {"lat": 50.0988, "lng": 8.6876}
+            }
+          },
+          "summary": {"duration": 93, "length": 857, "baseDuration": 80},
+          "travelSummary": {"duration": 93, "length": 857, "baseDuration": 80},
+          "polyline": "BFoz5xJ67i1B1B7PzIhaxL7Y",
+          "spans": [
+            {"offset": 0, "length": 166, "duration": 32, "speedLimit": 13.8888893, "maxSpeed": 13.8888893},
+            {"offset": 2, "length": 691, "duration": 61, "speedLimit": 69.4444427, "maxSpeed": 22.2222233}
+          ],
+          "notices": [
+            {
+              "title": "Violated vehicle restriction.",
+              "code": "violatedVehicleRestriction",
+              "severity": "critical",
+              "details": [
+                {"type": "restriction", "cause": "Gross weight exceeds limit.", "maxGrossWeight": 12000}
+              ]
+            }
+          ],
+          "language": "en-us",
+          "transport": {"mode": "truck"},
+          "incidents": [
+            {
+              "type": "construction",
+              "criticality": "minor",
+              "validFrom": "2022-05-30T06:00:00Z",
+              "validUntil": "2022-06-10T18:00:00Z",
+              "description": "Road works between Taunusanlage and Mainzer Landstraße."
+            }
+          ],
+          "tolls": [
+            {
+              "countryCode": "DEU",
+              "tollSystemRef": 0,
+              "tollSystem": "TOLL COLLECT",
+              "fares": [
+                {
+                  "id": "f2c8b1a4-3e5d-4f6a-9b7c-8d1e2f3a4b5c",
+                  "name": "A5",
+                  "price": {"type": "value", "currency": "EUR", "value": 3.4},
+                  "convertedPrice": {"type": "value", "currency": "SEK", "value": 35.6},
+                  "reason": "toll",
+                  "paymentMethods": ["cash", "bankCard", "transponder"]
+                }
+              ],
+              "tollCollectionLocations": [
+                {"name": "Frankfurt West", "location": {"lat": 50.10063, "lng": 8.6915}}
+              ]
+            }
+          ],
+          "tollSystems": [{"id": 0, "name": "TOLL COLLECT", "languageCode": "de"}]
+        }
+      ]
+    }
+  ]
+}`
+
+// EVRoutesResponseJSON is an electric vehicle route response with a charging stop between two sections.
+const EVRoutesResponseJSON = `{
+  "routes": [
+    {
+      "id": "7d2f4a1b-8c3e-4b5a-9d6f-1e2a3b4c5d6e",
+      "sections": [
+        {
+          "id": "1a2b3c4d-5e6f-4a7b-8c9d-0e1f2a3b4c5d",
+          "type": "vehicle",
+          "departure": {
+            "time": "2022-06-01T08:00:00+02:00",
+            "place": {"type": "place", "location": {"lat": 57.707752, "lng": 11.949767}},
+            "charge": 48
+          },
+          "arrival": {
+            "time": "2022-06-01T10:05:00+02:00",
+            "place": {
+              "type": "chargingStation",
+              "id": "here:pds:place:276u0vhj-b0bace6448ae4b0fbc1b5c0a1e8c4f2a",
+              "name": "IONITY Jönköping",
+              "location": {"lat": 57.782614, "lng": 14.161788},
+              "attributes": {"power": 350, "current": 500, "voltage": 700, "supplyType": "dc"}
+            },
+            "charge": 9.6
+          },
+          "summary": {"duration": 7500, "length": 148000, "baseDuration": 7300, "consumption": 38.4},
+          "postActions": [
+            {"action": "chargingSetup", "duration": 300},
+            {"action": "charging", "duration": 1620, "consumablePower": 350, "arrivalCharge": 9.6, "targetCharge": 60}
+          ],
+          "transport": {"mode": "car"}
+        },
+        {
+          "id": "2b3c4d5e-6f7a-4b8c-9d0e-1f2a3b4c5d6e",
+          "type": "vehicle",
+          "departure": {
+            "time": "2022-06-01T10:37:00+02:00",
+            "place": {"type": "chargingStation", "location": {"lat": 57.782614, "lng": 14.161788}},
+            "charge": 60
+          },
+          "arrival": {
+            "time": "2022-06-01T13:40:00+02:00",
+            "place": {"type": "place", "location": {"lat": 59.337492, "lng": 18.063672}},
+            "charge": 12
+          },
+          "summary": {"duration": 10980, "length": 322000, "baseDuration": 10500, "consumption": 48},
+          "transport": {"mode": "car"}
+        }
+      ]
+    }
+  ]
+}`
+
+// CalculateMatrixResponseJSON is a 2x2 matrix response with one failed route and a notice about an origin
+// outside of the region.
+const CalculateMatrixResponseJSON = `{
+  "matrixId": "1c8a3f4e-2b5d-4e6f-8a9b-0c1d2e3f4a5b",
+  "matrix": {
+    "numOrigins": 2,
+    "numDestinations": 2,
+    "travelTimes": [0, 17840, 17910, 0],
+    "distances": [0, 470230, 471120, 0],
+    "errorCodes": [0, 0, 0, 3]
+  },
+  "regionDefinition": {"type": "world"},
+  "notices": [
+    {
+      "title": "Origin 1 is outside of the region and was moved to the closest location inside it.",
+      "code": "originOutsideRegion",
+      "severity": "info"
+    }
+  ]
+}`
+
+// ErrorResponseJSON is an error response for a malformed request.
+const ErrorResponseJSON = `{
+  "title": "Malformed request",
+  "status": 400,
+  "code": "E605001",
+  "cause": "Error while parsing request: 'transportMode' is required",
+  "action": "",
+  "correlationId": "4199533b-6290-41db-8d79-edf4f4019a74"
+}`
+
+// Fixture is a synthetic response and the type it decodes into.
+type Fixture struct {
+	// Name of the fixture.
+	Name string
+	// JSON of the response.
+	JSON string
+	// New returns a pointer to a new value of the type of the response.
+	New func() interface{}
+}
+
+// Fixtures returns all synthetic responses.
+func Fixtures() []Fixture {
+	return []Fixture{
+		{
+			Name: "routes",
+			JSON: RoutesResponseJSON,
+			New:  func() interface{} { return &routingv8.RoutesResponse{} },
+		},
+		{
+			Name: "ev routes",
+			JSON: EVRoutesResponseJSON,
+			New:  func() interface{} { return &routingv8.RoutesResponse{} },
+		},
+		{
+			Name: "calculate matrix",
+			JSON: CalculateMatrixResponseJSON,
+			New:  func() interface{} { return &routingv8.CalculateMatrixResponse{} },
+		},
+		{
+			Name: "error",
+			JSON: ErrorResponseJSON,
+			New:  func() interface{} { return &routingv8.HereErrorResponse{} },
+		},
+	}
+}
+
+// CheckDecode decodes all fixtures and fails the test if any of them can not be decoded, or has fields which are not
+// decoded into the type of the fixture. Downstream projects can call it from their own tests to verify an upgrade
+// of this library.
+func CheckDecode(t testing.TB) {
+	t.Helper()
+	for _, fixture := range Fixtures() {
+		decoder := json.NewDecoder(strings.NewReader(fixture.JSON))
+		decoder.DisallowUnknownFields()
+		if err := decoder.Decode(fixture.New()); err != nil {
+			t.Errorf("decode fixture %s: %v", fixture.Name, err)
+		}
+	}
+}
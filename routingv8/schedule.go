@@ -0,0 +1,85 @@
+package routingv8
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// ScheduledStop is a stop of a multi-leg plan with an optional planned departure.
+type ScheduledStop struct {
+	// Location of the stop.
+	Location GeoWaypoint
+	// Departure from the stop. If zero, the next leg departs on arrival at the stop, or now for the first stop.
+	Departure time.Time
+}
+
+// PlannedLeg is a leg of a multi-leg plan, calculated for its own departure time.
+type PlannedLeg struct {
+	// Route of the leg.
+	Route Route
+	// Departure from the first stop of the leg.
+	Departure time.Time
+	// Arrival at the last stop of the leg.
+	Arrival time.Time
+}
+
+// ScheduledRoutes calculates a route for each pair of consecutive stops, departing at the planned departure of
+// each stop. Unlike vias with a stop duration, this supports stops of any length, such as overnight stops, since
+// traffic is predicted for the actual departure of every leg. Other parameters of the legs are taken from
+// template, whose origin, destination, vias, departure and arrival times are ignored.
+func (s *RoutingService) ScheduledRoutes(
+	ctx context.Context,
+	template *RoutesRequest,
+	stops []ScheduledStop,
+) (_ []PlannedLeg, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("scheduled routes: %w", err)
+		}
+	}()
+	if len(stops) < 2 {
+		return nil, fmt.Errorf("at least 2 stops required, got %d", len(stops))
+	}
+	legs := make([]PlannedLeg, 0, len(stops)-1)
+	var arrival time.Time
+	for i := 0; i < len(stops)-1; i++ {
+		departure := stops[i].Departure
+		switch {
+		case departure.IsZero() && i > 0:
+			departure = arrival
+		case !departure.IsZero() && i > 0 && departure.Before(arrival):
+			return nil, fmt.Errorf("stop %d: departure %v before arrival %v", i, departure, arrival)
+		}
+		req := *template
+		req.Origin = stops[i].Location
+		req.Destination = stops[i+1].Location
+		req.Vias = nil
+		req.Alternatives = NoAlternatives
+		req.DepartureTime = DepartAt(departure)
+		req.ArrivalTime = time.Time{}
+		resp, err := s.Routes(ctx, &req)
+		if err != nil {
+			return nil, fmt.Errorf("leg %d: %w", i, err)
+		}
+		if len(resp.Routes) == 0 || len(resp.Routes[0].Sections) == 0 {
+			return nil, fmt.Errorf("leg %d: no route", i)
+		}
+		route := resp.Routes[0]
+		leg := PlannedLeg{Route: route, Departure: route.Sections[0].Departure.Time}
+		if leg.Departure.IsZero() {
+			leg.Departure = departure
+		}
+		leg.Arrival = route.Sections[len(route.Sections)-1].Arrival.Time
+		if leg.Arrival.IsZero() && !leg.Departure.IsZero() {
+			var duration int32
+			for _, section := range route.Sections {
+				duration += section.Summary.Duration
+			}
+			leg.Arrival = leg.Departure.Add(time.Duration(duration) * time.Second)
+		}
+		legs = append(legs, leg)
+		arrival = leg.Arrival
+	}
+	return legs, nil
+}
@@ -0,0 +1,67 @@
+package routingv8_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"go.einride.tech/here/routingv8"
+	"gotest.tools/v3/assert"
+)
+
+func TestRoutingService_ScheduledRoutes(t *testing.T) {
+	t.Parallel()
+	var departures []string
+	client := routingv8.NewClient(httpClientFunc(func(req *http.Request) (*http.Response, error) {
+		departure, err := time.Parse(time.RFC3339, req.URL.Query().Get("departureTime"))
+		if err != nil {
+			return nil, err
+		}
+		departures = append(departures, req.URL.Query().Get("departureTime"))
+		b, err := json.Marshal(routingv8.RoutesResponse{
+			Routes: []routingv8.Route{{
+				Sections: []routingv8.Section{{
+					Departure: routingv8.RoutePlace{Time: departure},
+					Arrival:   routingv8.RoutePlace{Time: departure.Add(2 * time.Hour)},
+				}},
+			}},
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(string(b)))}, nil
+	}))
+	start := time.Date(2022, 6, 1, 8, 0, 0, 0, time.UTC)
+	legs, err := client.Routing.ScheduledRoutes(
+		context.Background(),
+		&routingv8.RoutesRequest{TransportMode: routingv8.TransportModeTruck},
+		[]routingv8.ScheduledStop{
+			{Location: routingv8.GeoWaypoint{Lat: 57.7, Long: 11.9}, Departure: start},
+			{Location: routingv8.GeoWaypoint{Lat: 58.4, Long: 15.6}, Departure: start.Add(24 * time.Hour)},
+			{Location: routingv8.GeoWaypoint{Lat: 59.3, Long: 18.1}},
+			{Location: routingv8.GeoWaypoint{Lat: 59.9, Long: 17.6}},
+		},
+	)
+	assert.NilError(t, err)
+	assert.Equal(t, 3, len(legs))
+	assert.DeepEqual(
+		t,
+		[]string{"2022-06-01T08:00:00Z", "2022-06-02T08:00:00Z", "2022-06-02T10:00:00Z"},
+		departures,
+	)
+	assert.Equal(t, start.Add(28*time.Hour), legs[2].Arrival)
+	_, err = client.Routing.ScheduledRoutes(
+		context.Background(),
+		&routingv8.RoutesRequest{TransportMode: routingv8.TransportModeTruck},
+		[]routingv8.ScheduledStop{
+			{Location: routingv8.GeoWaypoint{Lat: 57.7, Long: 11.9}, Departure: start},
+			{Location: routingv8.GeoWaypoint{Lat: 58.4, Long: 15.6}, Departure: start.Add(time.Hour)},
+			{Location: routingv8.GeoWaypoint{Lat: 59.3, Long: 18.1}},
+		},
+	)
+	assert.ErrorContains(t, err, "stop 1: departure")
+}
@@ -0,0 +1,48 @@
+package routingv8
+
+// HasOriginalLocation reports whether the response included the original location of the place, which is only
+// the case when the requested location was matched to a different location on the road network.
+func (p Place) HasOriginalLocation() bool {
+	return p.OriginalLocation != GeoWaypoint{}
+}
+
+// SnapDistance returns the distance in meters between the requested location and the location on the road network
+// the place was matched to. The distance is zero if the location was not moved.
+func (p Place) SnapDistance() float64 {
+	if !p.HasOriginalLocation() {
+		return 0
+	}
+	return distance(p.OriginalLocation, p.Location)
+}
+
+// SnappedPlace is a departure or arrival place of a section which was matched to a distant location.
+type SnappedPlace struct {
+	// SectionID of the section the place belongs to.
+	SectionID string
+	// Arrival is true for the arrival place of the section and false for the departure place.
+	Arrival bool
+	// Place that was matched.
+	Place Place
+	// Distance in meters between the requested and the matched location.
+	Distance float64
+}
+
+// SnappedPlaces returns the departure and arrival places of the route that were matched more than minDistance
+// meters away from the requested location.
+func SnappedPlaces(route *Route, minDistance float64) []SnappedPlace {
+	var result []SnappedPlace
+	for _, section := range route.Sections {
+		if d := section.Departure.Place.SnapDistance(); d > minDistance {
+			result = append(result, SnappedPlace{SectionID: section.ID, Place: section.Departure.Place, Distance: d})
+		}
+		if d := section.Arrival.Place.SnapDistance(); d > minDistance {
+			result = append(result, SnappedPlace{
+				SectionID: section.ID,
+				Arrival:   true,
+				Place:     section.Arrival.Place,
+				Distance:  d,
+			})
+		}
+	}
+	return result
+}
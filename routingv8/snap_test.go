@@ -0,0 +1,38 @@
+package routingv8_test
+
+import (
+	"testing"
+
+	"go.einride.tech/here/routingv8"
+	"gotest.tools/v3/assert"
+)
+
+func TestSnappedPlaces(t *testing.T) {
+	t.Parallel()
+	route := routingv8.Route{
+		Sections: []routingv8.Section{
+			{
+				ID: "section",
+				Departure: routingv8.RoutePlace{
+					Place: routingv8.Place{
+						Location:         routingv8.GeoWaypoint{Lat: 57.7, Long: 11.9},
+						OriginalLocation: routingv8.GeoWaypoint{Lat: 57.7, Long: 11.9001},
+					},
+				},
+				Arrival: routingv8.RoutePlace{
+					Place: routingv8.Place{
+						Location:         routingv8.GeoWaypoint{Lat: 57.7, Long: 11.95},
+						OriginalLocation: routingv8.GeoWaypoint{Lat: 57.705, Long: 11.95},
+					},
+				},
+			},
+		},
+	}
+	assert.Assert(t, !routingv8.Place{}.HasOriginalLocation())
+	assert.Equal(t, 0.0, routingv8.Place{Location: routingv8.GeoWaypoint{Lat: 1, Long: 1}}.SnapDistance())
+	snapped := routingv8.SnappedPlaces(&route, 100)
+	assert.Equal(t, 1, len(snapped))
+	assert.Assert(t, snapped[0].Arrival)
+	assert.Equal(t, "section", snapped[0].SectionID)
+	assert.Assert(t, snapped[0].Distance > 550 && snapped[0].Distance < 560)
+}
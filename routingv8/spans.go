@@ -0,0 +1,74 @@
+package routingv8
+
+import (
+	"fmt"
+	"strings"
+)
+
+// SpanAttribute is an attribute of the spans of the sections in a routes response.
+type SpanAttribute int
+
+const (
+	SpanUnspecified SpanAttribute = iota
+	SpanLength
+	SpanDuration
+	SpanMaxSpeed
+	SpanSpeedLimit
+	SpanIncidents
+	SpanNotices
+	SpanNames
+	SpanRouteNumbers
+	SpanSegmentID
+	SpanFunctionalClass
+	SpanCountryCode
+	SpanTruckAttributes
+	SpanRoutingZones
+)
+
+func (s SpanAttribute) String() string {
+	switch s {
+	case SpanUnspecified:
+		return unspecified
+	case SpanLength:
+		return "length"
+	case SpanDuration:
+		return "duration"
+	case SpanMaxSpeed:
+		return "maxSpeed"
+	case SpanSpeedLimit:
+		return "speedLimit"
+	case SpanIncidents:
+		return "incidents"
+	case SpanNotices:
+		return "notices"
+	case SpanNames:
+		return "names"
+	case SpanRouteNumbers:
+		return "routeNumbers"
+	case SpanSegmentID:
+		return "segmentId"
+	case SpanFunctionalClass:
+		return "functionalClass"
+	case SpanCountryCode:
+		return "countryCode"
+	case SpanTruckAttributes:
+		return "truckAttributes"
+	case SpanRoutingZones:
+		return "routingZones"
+	default:
+		return invalid
+	}
+}
+
+// spansQuery returns the value of the spans query parameter for the attributes.
+func spansQuery(attributes []SpanAttribute) (string, error) {
+	names := make([]string, 0, len(attributes))
+	for _, attribute := range attributes {
+		name := attribute.String()
+		if name == invalid || name == unspecified {
+			return "", fmt.Errorf("invalid span attribute: %d", int(attribute))
+		}
+		names = append(names, name)
+	}
+	return strings.Join(names, ","), nil
+}
@@ -0,0 +1,35 @@
+package routingv8
+
+// Speed in meters per second, as used by spans.
+type Speed float64
+
+const (
+	// SpeedUnknown is the value of spans where no speed information is available.
+	SpeedUnknown Speed = 0
+	// SpeedUnlimited is the sentinel value returned for spans without a speed limit, such as parts of the German
+	// autobahn. It corresponds to 250 km/h.
+	SpeedUnlimited Speed = 250 / 3.6
+)
+
+// speedTolerance accounts for the rounding of sentinel values in responses.
+const speedTolerance = 0.05
+
+// IsUnlimited reports whether the speed is the sentinel value for no speed limit.
+func (s Speed) IsUnlimited() bool {
+	return s >= SpeedUnlimited-speedTolerance
+}
+
+// IsKnown reports whether the speed carries any information.
+func (s Speed) IsKnown() bool {
+	return s > SpeedUnknown
+}
+
+// KilometersPerHour returns the speed in km/h.
+func (s Speed) KilometersPerHour() float64 {
+	return float64(s) * 3.6
+}
+
+// MilesPerHour returns the speed in mph.
+func (s Speed) MilesPerHour() float64 {
+	return float64(s) * 3600 / 1609.344
+}
@@ -0,0 +1,20 @@
+package routingv8_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"go.einride.tech/here/routingv8"
+	"gotest.tools/v3/assert"
+)
+
+func TestSpeed(t *testing.T) {
+	t.Parallel()
+	var span routingv8.Span
+	assert.NilError(t, json.Unmarshal([]byte(`{"speedLimit":69.4444427,"maxSpeed":27.7777786}`), &span))
+	assert.Assert(t, span.SpeedLimit.IsUnlimited())
+	assert.Assert(t, !span.MaxSpeed.IsUnlimited())
+	assert.Assert(t, span.MaxSpeed.IsKnown())
+	assert.Equal(t, 100, int(span.MaxSpeed.KilometersPerHour()+0.5))
+	assert.Assert(t, !routingv8.SpeedUnknown.IsKnown())
+}
@@ -0,0 +1,41 @@
+package routingv8
+
+import (
+	"fmt"
+	"time"
+)
+
+// StopTime is the expected arrival at and departure from a stop of a route.
+type StopTime struct {
+	// Leg arriving at the stop.
+	Leg int
+	// Location of the stop.
+	Location GeoWaypoint
+	// Arrival is the expected arrival time.
+	Arrival time.Time
+	// Departure is the expected departure time, after the service at the stop.
+	Departure time.Time
+}
+
+// StopTimes computes the expected arrival and departure times at the stops of the route, the ends of its legs,
+// when leaving the origin at departure. serviceDurations are the times spent at each stop, in order, including the
+// destination. Stops without a service duration are left immediately.
+func StopTimes(route *Route, departure time.Time, serviceDurations []time.Duration) ([]StopTime, error) {
+	legs := route.Legs()
+	if len(serviceDurations) > len(legs) {
+		return nil, fmt.Errorf("stop times: %d service durations for %d stops", len(serviceDurations), len(legs))
+	}
+	result := make([]StopTime, 0, len(legs))
+	t := departure
+	for i, leg := range legs {
+		stop := StopTime{Leg: i, Location: leg.Arrival.Place.Location}
+		stop.Arrival = t.Add(time.Duration(leg.Summary.Duration) * time.Second)
+		stop.Departure = stop.Arrival
+		if i < len(serviceDurations) {
+			stop.Departure = stop.Arrival.Add(serviceDurations[i])
+		}
+		result = append(result, stop)
+		t = stop.Departure
+	}
+	return result, nil
+}
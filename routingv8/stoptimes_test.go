@@ -0,0 +1,36 @@
+package routingv8_test
+
+import (
+	"testing"
+	"time"
+
+	"go.einride.tech/here/routingv8"
+	"gotest.tools/v3/assert"
+)
+
+func TestStopTimes(t *testing.T) {
+	t.Parallel()
+	waypoint := func(i int) *int { return &i }
+	route := routingv8.Route{
+		Sections: []routingv8.Section{
+			{
+				Arrival: routingv8.RoutePlace{Place: routingv8.Place{Waypoint: waypoint(1)}},
+				Summary: routingv8.Summary{Duration: 3600},
+			},
+			{
+				Arrival: routingv8.RoutePlace{Place: routingv8.Place{Waypoint: waypoint(2)}},
+				Summary: routingv8.Summary{Duration: 1800},
+			},
+		},
+	}
+	departure := time.Date(2022, 6, 1, 8, 0, 0, 0, time.UTC)
+	stops, err := routingv8.StopTimes(&route, departure, []time.Duration{20 * time.Minute})
+	assert.NilError(t, err)
+	assert.Equal(t, 2, len(stops))
+	assert.Equal(t, departure.Add(time.Hour), stops[0].Arrival)
+	assert.Equal(t, departure.Add(80*time.Minute), stops[0].Departure)
+	assert.Equal(t, departure.Add(110*time.Minute), stops[1].Arrival)
+	assert.Equal(t, stops[1].Arrival, stops[1].Departure)
+	_, err = routingv8.StopTimes(&route, departure, make([]time.Duration, 3))
+	assert.Error(t, err, "stop times: 3 service durations for 2 stops")
+}
@@ -0,0 +1,16 @@
+package routingv8
+
+import "net/url"
+
+// Taxi describes the privileges of a taxi.
+type Taxi struct {
+	// AllowDriveThroughTaxiRoads allows driving through roads reserved for taxis.
+	AllowDriveThroughTaxiRoads bool
+}
+
+// addQuery adds the taxi parameters to the query of a routes request.
+func (t *Taxi) addQuery(values url.Values) {
+	if t.AllowDriveThroughTaxiRoads {
+		values.Add("taxi[allowDriveThroughTaxiRoads]", "true")
+	}
+}
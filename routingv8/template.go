@@ -0,0 +1,49 @@
+package routingv8
+
+import "time"
+
+// RoutesRequestFromRoute returns a request for the route, with the origin, destination and stopover vias of the
+// route, so that the route can be requested again, e.g. to refresh its travel times.
+//
+// The waypoints are the requested locations when returned in the original locations of the places, and otherwise the
+// locations the route was matched to. The stop durations of the vias are the time between the arrival at and the
+// departure from the via. The transport mode is the mode of the first section driven with a transport mode of the
+// request. Pass-through vias, departure time and other options are not part of a response and must be set by the
+// caller.
+func RoutesRequestFromRoute(route *Route) RoutesRequest {
+	var req RoutesRequest
+	legs := route.Legs()
+	if len(legs) == 0 {
+		return req
+	}
+	req.Origin = requestedLocation(legs[0].Departure.Place)
+	req.Destination = requestedLocation(legs[len(legs)-1].Arrival.Place)
+	for i, leg := range legs[:len(legs)-1] {
+		via := Via{Location: requestedLocation(leg.Arrival.Place)}
+		arrival, departure := leg.Arrival.Time, legs[i+1].Departure.Time
+		if !arrival.IsZero() && !departure.IsZero() && departure.After(arrival) {
+			via.StopDuration = departure.Sub(arrival).Round(time.Second)
+		}
+		req.Vias = append(req.Vias, via)
+	}
+	req.TransportMode = sectionsTransportMode(route.Sections)
+	return req
+}
+
+func requestedLocation(place Place) GeoWaypoint {
+	if place.OriginalLocation != (GeoWaypoint{}) {
+		return place.OriginalLocation
+	}
+	return place.Location
+}
+
+func sectionsTransportMode(sections []Section) TransportMode {
+	for _, section := range sections {
+		for mode := TransportModeCar; mode <= TransportModeScooter; mode++ {
+			if mode.String() == section.Transport.Mode {
+				return mode
+			}
+		}
+	}
+	return TransportModeUnspecified
+}
@@ -0,0 +1,57 @@
+package routingv8_test
+
+import (
+	"testing"
+	"time"
+
+	"go.einride.tech/here/routingv8"
+	"gotest.tools/v3/assert"
+)
+
+func TestRoutesRequestFromRoute(t *testing.T) {
+	t.Parallel()
+	waypoint := func(i int) *int { return &i }
+	start := time.Date(2022, 6, 1, 10, 0, 0, 0, time.UTC)
+	route := routingv8.Route{
+		Sections: []routingv8.Section{
+			{
+				Departure: routingv8.RoutePlace{Time: start, Place: routingv8.Place{
+					Location:         routingv8.GeoWaypoint{Lat: 57.7001, Long: 11.9001},
+					OriginalLocation: routingv8.GeoWaypoint{Lat: 57.7, Long: 11.9},
+					Waypoint:         waypoint(0),
+				}},
+				Arrival: routingv8.RoutePlace{Time: start.Add(time.Hour), Place: routingv8.Place{
+					Location: routingv8.GeoWaypoint{Lat: 58.0, Long: 12.0},
+					Waypoint: waypoint(1),
+				}},
+				Transport: routingv8.Transport{Mode: "truck"},
+			},
+			{
+				Departure: routingv8.RoutePlace{
+					Time:  start.Add(time.Hour + 15*time.Minute),
+					Place: routingv8.Place{Location: routingv8.GeoWaypoint{Lat: 58.0, Long: 12.0}},
+				},
+				Arrival: routingv8.RoutePlace{Time: start.Add(2 * time.Hour), Place: routingv8.Place{
+					Location: routingv8.GeoWaypoint{Lat: 58.5, Long: 12.5},
+				}},
+				Transport: routingv8.Transport{Mode: "ferry"},
+			},
+			{
+				Departure: routingv8.RoutePlace{Place: routingv8.Place{Location: routingv8.GeoWaypoint{Lat: 58.5, Long: 12.5}}},
+				Arrival: routingv8.RoutePlace{Place: routingv8.Place{
+					Location: routingv8.GeoWaypoint{Lat: 59.0, Long: 13.0},
+					Waypoint: waypoint(2),
+				}},
+				Transport: routingv8.Transport{Mode: "truck"},
+			},
+		},
+	}
+	req := routingv8.RoutesRequestFromRoute(&route)
+	assert.Equal(t, routingv8.GeoWaypoint{Lat: 57.7, Long: 11.9}, req.Origin)
+	assert.Equal(t, routingv8.GeoWaypoint{Lat: 59.0, Long: 13.0}, req.Destination)
+	assert.DeepEqual(t, []routingv8.Via{
+		{Location: routingv8.GeoWaypoint{Lat: 58.0, Long: 12.0}, StopDuration: 15 * time.Minute},
+	}, req.Vias)
+	assert.Equal(t, routingv8.TransportModeTruck, req.TransportMode)
+	assert.DeepEqual(t, routingv8.RoutesRequest{}, routingv8.RoutesRequestFromRoute(&routingv8.Route{}))
+}
@@ -0,0 +1,83 @@
+package routingv8
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// TimeLayouts are the layouts accepted when decoding timestamps of responses, in order of preference.
+// Timestamps without an offset are decoded as UTC.
+var TimeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04Z07:00",
+	"2006-01-02T15:04:05Z0700",
+	"2006-01-02T15:04Z0700",
+	"2006-01-02T15:04:05",
+	"2006-01-02T15:04",
+}
+
+// parseTime decodes the JSON timestamp of a field using the first matching layout of TimeLayouts.
+// A missing or null timestamp decodes to the zero time.
+func parseTime(field string, b json.RawMessage) (time.Time, error) {
+	if len(b) == 0 || string(b) == "null" {
+		return time.Time{}, nil
+	}
+	var value string
+	if err := json.Unmarshal(b, &value); err != nil {
+		return time.Time{}, fmt.Errorf("invalid time in field %s: %v", field, err)
+	}
+	if value == "" {
+		return time.Time{}, nil
+	}
+	for _, layout := range TimeLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid time in field %s: %q", field, value)
+}
+
+// UnmarshalJSON implements json.Unmarshaler with lenient decoding of the time, see TimeLayouts.
+func (p *RoutePlace) UnmarshalJSON(b []byte) error {
+	type routePlace RoutePlace
+	var raw struct {
+		routePlace
+		Time json.RawMessage `json:"time"`
+	}
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return fmt.Errorf("unmarshal route place: %v", err)
+	}
+	t, err := parseTime("time", raw.Time)
+	if err != nil {
+		return fmt.Errorf("unmarshal route place: %v", err)
+	}
+	*p = RoutePlace(raw.routePlace)
+	p.Time = t
+	return nil
+}
+
+// UnmarshalJSON implements json.Unmarshaler with lenient decoding of the validity, see TimeLayouts.
+func (i *Incident) UnmarshalJSON(b []byte) error {
+	type incident Incident
+	var raw struct {
+		incident
+		ValidFrom  json.RawMessage `json:"validFrom"`
+		ValidUntil json.RawMessage `json:"validUntil"`
+	}
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return fmt.Errorf("unmarshal incident: %v", err)
+	}
+	validFrom, err := parseTime("validFrom", raw.ValidFrom)
+	if err != nil {
+		return fmt.Errorf("unmarshal incident: %v", err)
+	}
+	validUntil, err := parseTime("validUntil", raw.ValidUntil)
+	if err != nil {
+		return fmt.Errorf("unmarshal incident: %v", err)
+	}
+	*i = Incident(raw.incident)
+	i.ValidFrom = validFrom
+	i.ValidUntil = validUntil
+	return nil
+}
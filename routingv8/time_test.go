@@ -0,0 +1,44 @@
+package routingv8_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"go.einride.tech/here/routingv8"
+	"gotest.tools/v3/assert"
+)
+
+func TestRoutePlace_UnmarshalJSON(t *testing.T) {
+	t.Parallel()
+	expected := time.Date(2022, 6, 1, 10, 0, 0, 0, time.FixedZone("", 2*60*60))
+	for _, tt := range []string{
+		`"2022-06-01T10:00:00+02:00"`,
+		`"2022-06-01T10:00+02:00"`,
+		`"2022-06-01T10:00:00+0200"`,
+		`"2022-06-01T10:00+0200"`,
+	} {
+		tt := tt
+		t.Run(tt, func(t *testing.T) {
+			t.Parallel()
+			var place routingv8.RoutePlace
+			assert.NilError(t, json.Unmarshal([]byte(`{"time":`+tt+`,"place":{"type":"place"}}`), &place))
+			assert.Assert(t, expected.Equal(place.Time))
+			assert.Equal(t, "place", place.Place.Type)
+		})
+	}
+}
+
+func TestRoutePlace_UnmarshalJSON_NoOffset(t *testing.T) {
+	t.Parallel()
+	var place routingv8.RoutePlace
+	assert.NilError(t, json.Unmarshal([]byte(`{"time":"2022-06-01T10:00"}`), &place))
+	assert.Equal(t, time.Date(2022, 6, 1, 10, 0, 0, 0, time.UTC), place.Time)
+}
+
+func TestIncident_UnmarshalJSON_Invalid(t *testing.T) {
+	t.Parallel()
+	var incident routingv8.Incident
+	err := json.Unmarshal([]byte(`{"validFrom":"2022-05-30T06:00:00Z","validUntil":"tomorrow"}`), &incident)
+	assert.ErrorContains(t, err, `invalid time in field validUntil: "tomorrow"`)
+}
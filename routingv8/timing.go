@@ -0,0 +1,138 @@
+package routingv8
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptrace"
+	"sync"
+	"time"
+)
+
+// RequestIDHeader is the header carrying the ID of a request assigned by HERE, for support cases.
+const RequestIDHeader = "X-Request-Id"
+
+// RequestTiming is the timing breakdown of an attempt of an API request, to attribute latency to the network or
+// to the processing by HERE. Phases which did not occur, e.g. DNS lookups of reused connections, are zero.
+type RequestTiming struct {
+	// Method of the request.
+	Method string
+	// Path of the request URL.
+	Path string
+	// StatusCode of the response, zero if no response was received.
+	StatusCode int
+	// RequestID assigned by HERE, from the X-Request-Id header of the response.
+	RequestID string
+	// ServerTiming is the raw Server-Timing header of the response, with processing hints of the server.
+	ServerTiming string
+	// ReusedConnection is true if the request was sent on a previously used connection.
+	ReusedConnection bool
+	// DNS is the duration of the DNS lookup.
+	DNS time.Duration
+	// Connect is the duration of establishing the TCP connection.
+	Connect time.Duration
+	// TLSHandshake is the duration of the TLS handshake.
+	TLSHandshake time.Duration
+	// TimeToFirstByte is the duration from the request being written to the first byte of the response, which
+	// is mostly the processing time of HERE.
+	TimeToFirstByte time.Duration
+	// Total is the duration from sending the request until the response has been read.
+	Total time.Duration
+}
+
+func (t RequestTiming) String() string {
+	return fmt.Sprintf(
+		"%s %s: status=%d dns=%v connect=%v tls=%v ttfb=%v total=%v reused=%t",
+		t.Method,
+		t.Path,
+		t.StatusCode,
+		t.DNS,
+		t.Connect,
+		t.TLSHandshake,
+		t.TimeToFirstByte,
+		t.Total,
+		t.ReusedConnection,
+	)
+}
+
+// requestTracer measures the phases of a request with httptrace.
+type requestTracer struct {
+	mu                            sync.Mutex
+	clock                         Clock
+	start, dnsStart, connectStart time.Time
+	tlsStart, wroteRequest        time.Time
+	timing                        RequestTiming
+}
+
+// traceRequest returns the request with a context tracing it on the clock, and a tracer to read the timing from.
+func traceRequest(req *http.Request, clock Clock) (*http.Request, *requestTracer) {
+	t := &requestTracer{clock: clock, start: clock.Now()}
+	t.timing.Method = req.Method
+	t.timing.Path = req.URL.Path
+	trace := &httptrace.ClientTrace{
+		DNSStart: func(httptrace.DNSStartInfo) {
+			t.set(func() { t.dnsStart = t.clock.Now() })
+		},
+		DNSDone: func(httptrace.DNSDoneInfo) {
+			t.set(func() { t.timing.DNS = t.clock.Now().Sub(t.dnsStart) })
+		},
+		ConnectStart: func(string, string) {
+			t.set(func() { t.connectStart = t.clock.Now() })
+		},
+		ConnectDone: func(string, string, error) {
+			t.set(func() { t.timing.Connect = t.clock.Now().Sub(t.connectStart) })
+		},
+		TLSHandshakeStart: func() {
+			t.set(func() { t.tlsStart = t.clock.Now() })
+		},
+		TLSHandshakeDone: func(tls.ConnectionState, error) {
+			t.set(func() { t.timing.TLSHandshake = t.clock.Now().Sub(t.tlsStart) })
+		},
+		GotConn: func(info httptrace.GotConnInfo) {
+			t.set(func() { t.timing.ReusedConnection = info.Reused })
+		},
+		WroteRequest: func(httptrace.WroteRequestInfo) {
+			t.set(func() { t.wroteRequest = t.clock.Now() })
+		},
+		GotFirstResponseByte: func() {
+			t.set(func() {
+				if !t.wroteRequest.IsZero() {
+					t.timing.TimeToFirstByte = t.clock.Now().Sub(t.wroteRequest)
+				}
+			})
+		},
+	}
+	return req.WithContext(httptrace.WithClientTrace(req.Context(), trace)), t
+}
+
+func (t *requestTracer) set(f func()) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	f()
+}
+
+// done returns the timing of the request, completed with the response, which is nil if none was received.
+func (t *requestTracer) done(resp *http.Response) RequestTiming {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	timing := t.timing
+	timing.Total = t.clock.Now().Sub(t.start)
+	if resp != nil {
+		timing.StatusCode = resp.StatusCode
+		timing.RequestID = resp.Header.Get(RequestIDHeader)
+		timing.ServerTiming = resp.Header.Get("Server-Timing")
+	}
+	return timing
+}
+
+// observeTiming passes the timing to the TimingObserver of the Client, and writes it to the debug dump.
+func (c *Client) observeTiming(ctx context.Context, dump io.Writer, timing RequestTiming) {
+	if c.TimingObserver != nil {
+		c.TimingObserver(ctx, timing)
+	}
+	if dump != nil {
+		_, _ = fmt.Fprintf(dump, "timing: %v\n\n", timing)
+	}
+}
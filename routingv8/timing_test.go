@@ -0,0 +1,89 @@
+package routingv8_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"go.einride.tech/here/routingv8"
+	"gotest.tools/v3/assert"
+)
+
+func TestClient_TimingObserver(t *testing.T) {
+	t.Parallel()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(routingv8.RequestIDHeader, "r1")
+		w.Header().Set("Server-Timing", "app;dur=12")
+		_, _ = w.Write([]byte(`{"routes":[]}`))
+	}))
+	defer server.Close()
+	client := routingv8.NewClient(server.Client())
+	var timings []routingv8.RequestTiming
+	client.TimingObserver = func(ctx context.Context, timing routingv8.RequestTiming) {
+		timings = append(timings, timing)
+	}
+	client.Routing.URL, _ = url.Parse(server.URL + "/v8/")
+	var dump bytes.Buffer
+	ctx := routingv8.WithDebugDump(context.Background(), &dump)
+	for i := 0; i < 2; i++ {
+		_, err := client.Routing.Routes(ctx, &routingv8.RoutesRequest{
+			Origin:        routingv8.GeoWaypoint{Lat: 57.707752, Long: 11.949767},
+			Destination:   routingv8.GeoWaypoint{Lat: 59.337492, Long: 18.063672},
+			TransportMode: routingv8.TransportModeCar,
+		})
+		assert.NilError(t, err)
+	}
+	assert.Equal(t, 2, len(timings))
+	first, second := timings[0], timings[1]
+	assert.Equal(t, http.MethodGet, first.Method)
+	assert.Equal(t, "/v8/routes", first.Path)
+	assert.Equal(t, http.StatusOK, first.StatusCode)
+	assert.Equal(t, "r1", first.RequestID)
+	assert.Equal(t, "app;dur=12", first.ServerTiming)
+	assert.Assert(t, !first.ReusedConnection)
+	assert.Assert(t, first.Connect > 0)
+	assert.Assert(t, first.TimeToFirstByte > 0)
+	assert.Assert(t, first.Total >= first.TimeToFirstByte)
+	assert.Assert(t, second.ReusedConnection)
+	assert.Equal(t, int64(0), int64(second.Connect))
+	assert.Assert(t, strings.Contains(dump.String(), "timing: GET /v8/routes: status=200"))
+}
+
+func TestClient_TimingObserver_Clock(t *testing.T) {
+	t.Parallel()
+	client := routingv8.NewClient(httpClientFunc(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(`{"routes":[]}`))}, nil
+	}))
+	client.Clock = &steppingClock{step: time.Second}
+	var timing routingv8.RequestTiming
+	client.TimingObserver = func(ctx context.Context, t routingv8.RequestTiming) {
+		timing = t
+	}
+	_, err := client.Routing.Routes(context.Background(), &routingv8.RoutesRequest{
+		Origin:        routingv8.GeoWaypoint{Lat: 57.707752, Long: 11.949767},
+		Destination:   routingv8.GeoWaypoint{Lat: 59.337492, Long: 18.063672},
+		TransportMode: routingv8.TransportModeCar,
+	})
+	assert.NilError(t, err)
+	// The clock is read when the request is sent and when the response has been read.
+	assert.Equal(t, time.Second, timing.Total)
+}
+
+// steppingClock is a Clock which advances by the step every time it is read.
+type steppingClock struct {
+	fakeClock
+	step time.Duration
+}
+
+func (s *steppingClock) Now() time.Time {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.now = s.now.Add(s.step)
+	return s.now
+}
@@ -0,0 +1,51 @@
+package routingv8
+
+import "time"
+
+// SectionTypeTransit is the type of sections on public transit.
+const SectionTypeTransit = "transit"
+
+// TransitStep is a ride on a transit line, from boarding to alighting.
+type TransitStep struct {
+	// SectionID of the transit section.
+	SectionID string
+	// Line is the short name of the line, or its name if it has no short name.
+	Line string
+	// Headsign displayed on the vehicle.
+	Headsign string
+	// Boarding place, including its platform if known.
+	Boarding Place
+	// BoardingTime is the departure time from the boarding place.
+	BoardingTime time.Time
+	// Alighting place, including its platform if known.
+	Alighting Place
+	// AlightingTime is the arrival time at the alighting place.
+	AlightingTime time.Time
+	// IntermediateStops is the number of stops between boarding and alighting.
+	IntermediateStops int
+}
+
+// TransitSteps returns the transit rides of the route, in order.
+func (r *Route) TransitSteps() []TransitStep {
+	var result []TransitStep
+	for _, section := range r.Sections {
+		if section.Type != SectionTypeTransit || section.IsFerry() || section.IsCarShuttleTrain() {
+			continue
+		}
+		line := section.Transport.ShortName
+		if line == "" {
+			line = section.Transport.Name
+		}
+		result = append(result, TransitStep{
+			SectionID:         section.ID,
+			Line:              line,
+			Headsign:          section.Transport.Headsign,
+			Boarding:          section.Departure.Place,
+			BoardingTime:      section.Departure.Time,
+			Alighting:         section.Arrival.Place,
+			AlightingTime:     section.Arrival.Time,
+			IntermediateStops: len(section.IntermediateStops),
+		})
+	}
+	return result
+}
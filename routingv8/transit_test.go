@@ -0,0 +1,46 @@
+package routingv8_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"go.einride.tech/here/routingv8"
+	"gotest.tools/v3/assert"
+)
+
+func TestRoute_TransitSteps(t *testing.T) {
+	t.Parallel()
+	var route routingv8.Route
+	assert.NilError(t, json.Unmarshal([]byte(`{
+  "sections": [
+    {"id": "walk", "type": "pedestrian", "transport": {"mode": "pedestrian"}},
+    {
+      "id": "tram",
+      "type": "transit",
+      "departure": {
+        "time": "2022-06-01T08:04:00+02:00",
+        "place": {"type": "station", "id": "740015578", "name": "Centralstationen", "platform": "C"}
+      },
+      "arrival": {
+        "time": "2022-06-01T08:15:00+02:00",
+        "place": {"type": "station", "name": "Chalmers", "platform": "A"}
+      },
+      "transport": {"mode": "lightRail", "name": "6", "shortName": "6", "headsign": "Länsmansgården"},
+      "intermediateStops": [
+        {"departure": {"time": "2022-06-01T08:07:00+02:00", "place": {"type": "station", "name": "Brunnsparken"}}},
+        {"departure": {"time": "2022-06-01T08:11:00+02:00", "place": {"type": "station", "name": "Valand"}}}
+      ],
+      "agency": {"id": "vt", "name": "Västtrafik"}
+    }
+  ]
+}`), &route))
+	steps := route.TransitSteps()
+	assert.Equal(t, 1, len(steps))
+	assert.Equal(t, "6", steps[0].Line)
+	assert.Equal(t, "Länsmansgården", steps[0].Headsign)
+	assert.Equal(t, "C", steps[0].Boarding.Platform)
+	assert.Equal(t, "Centralstationen", steps[0].Boarding.Name)
+	assert.Equal(t, "Chalmers", steps[0].Alighting.Name)
+	assert.Equal(t, 2, steps[0].IntermediateStops)
+	assert.Equal(t, 11, int(steps[0].AlightingTime.Sub(steps[0].BoardingTime).Minutes()))
+}
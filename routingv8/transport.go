@@ -0,0 +1,133 @@
+package routingv8
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// TransportConfig configures an HTTP transport for locked-down networks.
+type TransportConfig struct {
+	// Proxy configuration. Defaults to the proxy of the environment.
+	Proxy *ProxyConfig
+	// TLSConfig used for connections, e.g. created by NewTLSConfig. Defaults to the system configuration.
+	TLSConfig *tls.Config
+}
+
+// ProxyConfig configures the proxies used for requests.
+type ProxyConfig struct {
+	// URL of the proxy used for all hosts without an override. User info of the URL is used to authenticate with
+	// the proxy. If nil, requests are sent directly unless there is an override for the host.
+	URL *url.URL
+	// Hosts overrides the proxy per host name.
+	Hosts map[string]*url.URL
+	// NoProxy lists the hosts which are never proxied, with the semantics of the NO_PROXY environment variable in
+	// golang.org/x/net/http/httpproxy: "*" matches all hosts, "example.com" matches the host and its subdomains,
+	// ".example.com" and "*.example.com" only its subdomains. IP addresses and CIDR ranges match IP hosts, and an
+	// entry with a port, e.g. "localhost:8080", only matches requests to that port.
+	NoProxy []string
+}
+
+// ProxyFunc returns a function that selects the proxy of a request, for use as http.Transport.Proxy.
+func (p *ProxyConfig) ProxyFunc() func(*http.Request) (*url.URL, error) {
+	return func(req *http.Request) (*url.URL, error) {
+		host := req.URL.Hostname()
+		if p.bypass(host, requestPort(req.URL)) {
+			return nil, nil
+		}
+		if proxy, ok := p.Hosts[host]; ok {
+			return proxy, nil
+		}
+		return p.URL, nil
+	}
+}
+
+// requestPort returns the port of the URL, or the default port of its scheme.
+func requestPort(u *url.URL) string {
+	if port := u.Port(); port != "" {
+		return port
+	}
+	switch u.Scheme {
+	case "https":
+		return "443"
+	case "http":
+		return "80"
+	}
+	return ""
+}
+
+func (p *ProxyConfig) bypass(host, port string) bool {
+	host = strings.ToLower(host)
+	ip := net.ParseIP(host)
+	for _, entry := range p.NoProxy {
+		entry = strings.ToLower(strings.TrimSpace(entry))
+		switch entry {
+		case "":
+			continue
+		case "*":
+			return true
+		}
+		if _, network, err := net.ParseCIDR(entry); err == nil {
+			if ip != nil && network.Contains(ip) {
+				return true
+			}
+			continue
+		}
+		entryHost, entryPort, err := net.SplitHostPort(entry)
+		if err != nil {
+			entryHost, entryPort = entry, ""
+		}
+		if entryPort != "" && entryPort != port {
+			continue
+		}
+		if entryIP := net.ParseIP(entryHost); entryIP != nil {
+			if ip != nil && entryIP.Equal(ip) {
+				return true
+			}
+			continue
+		}
+		entryHost = strings.TrimPrefix(entryHost, "*")
+		if strings.HasPrefix(entryHost, ".") {
+			if strings.HasSuffix(host, entryHost) {
+				return true
+			}
+		} else if host == entryHost || strings.HasSuffix(host, "."+entryHost) {
+			return true
+		}
+	}
+	return false
+}
+
+// NewTransport returns a copy of http.DefaultTransport with the given configuration applied.
+// The transport can be passed as next to NewAPIKeyHTTPClient.
+func NewTransport(config TransportConfig) *http.Transport {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if config.Proxy != nil {
+		transport.Proxy = config.Proxy.ProxyFunc()
+	}
+	if config.TLSConfig != nil {
+		transport.TLSClientConfig = config.TLSConfig.Clone()
+	}
+	return transport
+}
+
+// NewTLSConfig returns a TLS configuration which trusts the PEM encoded certificates in rootCAs in addition to the
+// system certificate pool, and presents the given client certificates for mutual TLS.
+func NewTLSConfig(rootCAs []byte, certificates ...tls.Certificate) (*tls.Config, error) {
+	pool, err := x509.SystemCertPool()
+	if err != nil || pool == nil {
+		pool = x509.NewCertPool()
+	}
+	if len(rootCAs) > 0 && !pool.AppendCertsFromPEM(rootCAs) {
+		return nil, fmt.Errorf("new TLS config: no certificates found in root CAs")
+	}
+	return &tls.Config{
+		MinVersion:   tls.VersionTLS12,
+		RootCAs:      pool,
+		Certificates: certificates,
+	}, nil
+}
@@ -0,0 +1,99 @@
+package routingv8_test
+
+import (
+	"context"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"go.einride.tech/here/routingv8"
+	"gotest.tools/v3/assert"
+)
+
+func TestProxyConfig_ProxyFunc(t *testing.T) {
+	t.Parallel()
+	corporate := &url.URL{Scheme: "http", User: url.UserPassword("user", "secret"), Host: "proxy.corp:3128"}
+	matrix := &url.URL{Scheme: "http", Host: "matrix-proxy.corp:3128"}
+	config := routingv8.ProxyConfig{
+		URL:   corporate,
+		Hosts: map[string]*url.URL{"matrix.router.hereapi.com": matrix},
+		NoProxy: []string{
+			".internal",
+			"localhost:8080",
+			"hereapi.local",
+			"*.cdn.example",
+			"10.0.0.0/8",
+			"::1",
+			"tiles.example:443",
+		},
+	}
+	for _, tt := range []struct {
+		url      string
+		expected *url.URL
+	}{
+		{url: "https://router.hereapi.com/v8/routes", expected: corporate},
+		{url: "https://matrix.router.hereapi.com/v8/matrix", expected: matrix},
+		{url: "https://gateway.internal/v8/routes", expected: nil},
+		{url: "https://internal/v8/routes", expected: corporate},
+		{url: "http://localhost:8080/v8/routes", expected: nil},
+		{url: "http://localhost:9090/v8/routes", expected: corporate},
+		{url: "https://hereapi.local/v8/routes", expected: nil},
+		{url: "https://router.hereapi.local/v8/routes", expected: nil},
+		{url: "https://a.cdn.example/tile.png", expected: nil},
+		{url: "https://cdn.example/tile.png", expected: corporate},
+		{url: "http://10.1.2.3/v8/routes", expected: nil},
+		{url: "http://11.1.2.3/v8/routes", expected: corporate},
+		{url: "http://[::1]:8080/v8/routes", expected: nil},
+		{url: "https://tiles.example/tile.png", expected: nil},
+		{url: "http://tiles.example/tile.png", expected: corporate},
+	} {
+		tt := tt
+		t.Run(tt.url, func(t *testing.T) {
+			t.Parallel()
+			req, err := http.NewRequest(http.MethodGet, tt.url, nil)
+			assert.NilError(t, err)
+			proxy, err := config.ProxyFunc()(req)
+			assert.NilError(t, err)
+			assert.Equal(t, tt.expected, proxy)
+		})
+	}
+}
+
+func TestNewTransport(t *testing.T) {
+	t.Parallel()
+	proxy := &url.URL{Scheme: "http", Host: "proxy.corp:3128"}
+	transport := routingv8.NewTransport(routingv8.TransportConfig{Proxy: &routingv8.ProxyConfig{URL: proxy}})
+	req, err := http.NewRequest(http.MethodGet, "https://router.hereapi.com/v8/routes", nil)
+	assert.NilError(t, err)
+	actual, err := transport.Proxy(req)
+	assert.NilError(t, err)
+	assert.Equal(t, proxy, actual)
+}
+
+func TestNewTransport_TLS(t *testing.T) {
+	t.Parallel()
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"routes":[]}`))
+	}))
+	defer server.Close()
+	rootCAs := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	tlsConfig, err := routingv8.NewTLSConfig(rootCAs)
+	assert.NilError(t, err)
+	client := routingv8.NewClient(&http.Client{
+		Transport: routingv8.NewTransport(routingv8.TransportConfig{TLSConfig: tlsConfig}),
+	})
+	client.Routing.URL, err = url.Parse(server.URL + "/v8/")
+	assert.NilError(t, err)
+	_, err = client.Routing.Routes(context.Background(), &routingv8.RoutesRequest{
+		TransportMode: routingv8.TransportModeCar,
+	})
+	assert.NilError(t, err)
+}
+
+func TestNewTLSConfig_InvalidRootCAs(t *testing.T) {
+	t.Parallel()
+	_, err := routingv8.NewTLSConfig([]byte("not a certificate"))
+	assert.ErrorContains(t, err, "no certificates")
+}
@@ -0,0 +1,50 @@
+package routingv8
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+// addQuery adds the truck parameters to the query of a routes request. Zero values are omitted.
+func (t *Truck) addQuery(values url.Values) error {
+	for _, p := range []struct {
+		name  string
+		value int
+	}{
+		{name: "grossWeight", value: t.GrossWeight},
+		{name: "weightPerAxle", value: t.WeightPerAxle},
+		{name: "height", value: t.Height},
+		{name: "width", value: t.Width},
+		{name: "length", value: t.Length},
+		{name: "axleCount", value: t.AxleCount},
+		{name: "trailerCount", value: t.TrailerCount},
+	} {
+		if p.value < 0 {
+			return fmt.Errorf("invalid truck %s: %d", p.name, p.value)
+		}
+		if p.value > 0 {
+			values.Add("vehicle["+p.name+"]", strconv.Itoa(p.value))
+		}
+	}
+	if len(t.ShippedHazardousGoods) > 0 {
+		goods := make([]string, 0, len(t.ShippedHazardousGoods))
+		for i := range t.ShippedHazardousGoods {
+			name := t.ShippedHazardousGoods[i].String()
+			if name == invalid || name == unspecified {
+				return fmt.Errorf("invalid truck shipped hazardous goods: %d", int(t.ShippedHazardousGoods[i]))
+			}
+			goods = append(goods, name)
+		}
+		values.Add("vehicle[shippedHazardousGoods]", strings.Join(goods, ","))
+	}
+	if t.TunnelCategory != TunnelCategoryUnspecified {
+		category := t.TunnelCategory.String()
+		if category == invalid {
+			return fmt.Errorf("invalid truck tunnel category: %d", int(t.TunnelCategory))
+		}
+		values.Add("vehicle[tunnelCategory]", category)
+	}
+	return nil
+}
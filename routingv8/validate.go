@@ -0,0 +1,116 @@
+package routingv8
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Inconsistency is a violated invariant of a decoded response.
+type Inconsistency struct {
+	// Field of the response that is inconsistent, e.g. "matrix.travelTimes".
+	Field string
+	// Reason the field is inconsistent.
+	Reason string
+}
+
+func (i Inconsistency) String() string {
+	return i.Field + ": " + i.Reason
+}
+
+// ValidationError is returned for responses with inconsistencies when Client.ValidateResponses is enabled.
+type ValidationError struct {
+	Inconsistencies []Inconsistency
+}
+
+func (e *ValidationError) Error() string {
+	inconsistencies := make([]string, 0, len(e.Inconsistencies))
+	for _, inconsistency := range e.Inconsistencies {
+		inconsistencies = append(inconsistencies, inconsistency.String())
+	}
+	return "inconsistent response: " + strings.Join(inconsistencies, "; ")
+}
+
+// validationError returns a *ValidationError with the inconsistencies, nil if there are none.
+func validationError(inconsistencies []Inconsistency) error {
+	if len(inconsistencies) == 0 {
+		return nil
+	}
+	return &ValidationError{Inconsistencies: inconsistencies}
+}
+
+// Validate checks that the sizes of the matrix attributes match the number of origins and destinations.
+// The returned error is a *ValidationError.
+func (m *MatrixResponse) Validate() error {
+	var result []Inconsistency
+	if m.NumOrigins < 0 || m.NumDestinations < 0 {
+		result = append(result, Inconsistency{
+			Field:  "matrix",
+			Reason: fmt.Sprintf("negative dimensions %dx%d", m.NumOrigins, m.NumDestinations),
+		})
+		return validationError(result)
+	}
+	size := m.NumOrigins * m.NumDestinations
+	for _, attribute := range []struct {
+		field string
+		n     int
+		ok    bool
+	}{
+		{field: "matrix.travelTimes", n: len(m.TravelTimes), ok: m.TravelTimes == nil},
+		{field: "matrix.distances", n: len(m.Distances), ok: m.Distances == nil},
+		{field: "matrix.errorCodes", n: len(m.ErrorCodes), ok: m.ErrorCodes == nil},
+	} {
+		if !attribute.ok && attribute.n != size {
+			result = append(result, Inconsistency{
+				Field:  attribute.field,
+				Reason: fmt.Sprintf("got %d values for %dx%d matrix", attribute.n, m.NumOrigins, m.NumDestinations),
+			})
+		}
+	}
+	for i, travelTime := range m.TravelTimes {
+		if travelTime < 0 {
+			result = append(result, Inconsistency{
+				Field:  fmt.Sprintf("matrix.travelTimes[%d]", i),
+				Reason: fmt.Sprintf("negative travel time %d", travelTime),
+			})
+		}
+	}
+	for i, distance := range m.Distances {
+		if distance < 0 {
+			result = append(result, Inconsistency{
+				Field:  fmt.Sprintf("matrix.distances[%d]", i),
+				Reason: fmt.Sprintf("negative distance %d", distance),
+			})
+		}
+	}
+	return validationError(result)
+}
+
+// Validate checks that all routes have sections with non-negative summaries, which do not arrive before they
+// depart. The returned error is a *ValidationError.
+func (r *RoutesResponse) Validate() error {
+	var result []Inconsistency
+	for i, route := range r.Routes {
+		if len(route.Sections) == 0 {
+			result = append(result, Inconsistency{Field: fmt.Sprintf("routes[%d].sections", i), Reason: "empty"})
+		}
+		for j, section := range route.Sections {
+			field := fmt.Sprintf("routes[%d].sections[%d]", i, j)
+			if section.Summary.Length < 0 || section.Summary.Duration < 0 {
+				result = append(result, Inconsistency{
+					Field: field + ".summary",
+					Reason: fmt.Sprintf(
+						"negative length %d or duration %d", section.Summary.Length, section.Summary.Duration,
+					),
+				})
+			}
+			departure, arrival := section.Departure.Time, section.Arrival.Time
+			if !departure.IsZero() && !arrival.IsZero() && arrival.Before(departure) {
+				result = append(result, Inconsistency{
+					Field:  field + ".arrival.time",
+					Reason: fmt.Sprintf("arrival %v before departure %v", arrival, departure),
+				})
+			}
+		}
+	}
+	return validationError(result)
+}
@@ -0,0 +1,57 @@
+package routingv8_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"go.einride.tech/here/routingv8"
+	"gotest.tools/v3/assert"
+)
+
+func TestMatrixService_CalculateMatrix_ValidateResponses(t *testing.T) {
+	t.Parallel()
+	client := routingv8.NewClient(&ClientMock{
+		responseStatus: 200,
+		responseBody: routingv8.CalculateMatrixResponse{
+			Matrix: routingv8.MatrixResponse{
+				NumOrigins:      2,
+				NumDestinations: 2,
+				TravelTimes:     []int32{0, 10, 20},
+				Distances:       []int32{0, 100, 200, 0},
+			},
+			RegionDefinition: routingv8.RegionDefinition{Type: routingv8.RegionTypeWorld},
+		},
+	})
+	client.ValidateResponses = true
+	_, err := client.Matrix.CalculateMatrix(context.Background(), &routingv8.CalculateMatrixRequest{
+		Body: &routingv8.CalculateMatrixBody{
+			RegionDefinition: routingv8.RegionDefinition{Type: routingv8.RegionTypeWorld},
+			Profile:          routingv8.ProfileTruckFast,
+		},
+	})
+	var validationErr *routingv8.ValidationError
+	assert.Assert(t, errors.As(err, &validationErr))
+	assert.DeepEqual(
+		t,
+		[]routingv8.Inconsistency{{Field: "matrix.travelTimes", Reason: "got 3 values for 2x2 matrix"}},
+		validationErr.Inconsistencies,
+	)
+}
+
+func TestRoutesResponse_Validate(t *testing.T) {
+	t.Parallel()
+	resp := routingv8.RoutesResponse{
+		Routes: []routingv8.Route{
+			{ID: "empty"},
+			{ID: "negative", Sections: []routingv8.Section{{Summary: routingv8.Summary{Length: -1}}}},
+		},
+	}
+	assert.Error(
+		t,
+		resp.Validate(),
+		"inconsistent response: routes[0].sections: empty; "+
+			"routes[1].sections[0].summary: negative length -1 or duration 0",
+	)
+	assert.NilError(t, (&routingv8.RoutesResponse{}).Validate())
+}
@@ -0,0 +1,81 @@
+package routingv8
+
+import (
+	"fmt"
+	"strings"
+
+	"go.einride.tech/here/internal/useragent"
+)
+
+// Version of the library, sent in the User-Agent header of all requests.
+const Version = useragent.Version
+
+// Feature is a request parameter or capability supported by the library.
+type Feature string
+
+const (
+	// FeatureRoutingMode is support for RoutesRequest.RoutingMode.
+	FeatureRoutingMode Feature = "routingMode"
+	// FeatureVias is support for RoutesRequest.Vias.
+	FeatureVias Feature = "vias"
+	// FeatureArrivalTime is support for RoutesRequest.ArrivalTime and DepartureTimeAny of routes.
+	FeatureArrivalTime Feature = "arrivalTime"
+	// FeatureReturnAttributes is support for RoutesRequest.Return.
+	FeatureReturnAttributes Feature = "returnAttributes"
+	// FeatureSpans is support for RoutesRequest.Spans.
+	FeatureSpans Feature = "spans"
+	// FeatureTruck is support for RoutesRequest.Truck.
+	FeatureTruck Feature = "truck"
+	// FeatureTaxi is support for RoutesRequest.Taxi.
+	FeatureTaxi Feature = "taxi"
+	// FeatureEV is support for RoutesRequest.EV.
+	FeatureEV Feature = "ev"
+	// FeatureAvoid is support for RoutesRequest.Avoid.
+	FeatureAvoid Feature = "avoid"
+	// FeatureUnits is support for RoutesRequest.Units.
+	FeatureUnits Feature = "units"
+	// FeatureCurrency is support for RoutesRequest.Currency.
+	FeatureCurrency Feature = "currency"
+	// FeatureAsyncMatrix is support for asynchronous matrix calculations, see MatrixService.SubmitMatrix.
+	FeatureAsyncMatrix Feature = "asyncMatrix"
+	// FeatureRetries is support for Client.RetryPolicy.
+	FeatureRetries Feature = "retries"
+)
+
+// SupportedFeatures returns the features supported by this version of the library.
+func SupportedFeatures() []Feature {
+	return []Feature{
+		FeatureRoutingMode,
+		FeatureVias,
+		FeatureArrivalTime,
+		FeatureReturnAttributes,
+		FeatureSpans,
+		FeatureTruck,
+		FeatureTaxi,
+		FeatureEV,
+		FeatureAvoid,
+		FeatureUnits,
+		FeatureCurrency,
+		FeatureAsyncMatrix,
+		FeatureRetries,
+	}
+}
+
+// RequireFeatures returns an error listing the features not supported by this version of the library, which allows
+// failing fast when a configuration requires a newer version than the one deployed.
+func RequireFeatures(features ...Feature) error {
+	supported := make(map[Feature]bool)
+	for _, feature := range SupportedFeatures() {
+		supported[feature] = true
+	}
+	var missing []string
+	for _, feature := range features {
+		if !supported[feature] {
+			missing = append(missing, string(feature))
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("unsupported features in version %s: %s", Version, strings.Join(missing, ", "))
+	}
+	return nil
+}
@@ -0,0 +1,35 @@
+package routingv8_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"go.einride.tech/here/routingv8"
+	"gotest.tools/v3/assert"
+)
+
+func TestRequireFeatures(t *testing.T) {
+	t.Parallel()
+	assert.NilError(t, routingv8.RequireFeatures(routingv8.SupportedFeatures()...))
+	assert.NilError(t, routingv8.RequireFeatures())
+	assert.NilError(t, routingv8.RequireFeatures(routingv8.FeatureAsyncMatrix))
+	err := routingv8.RequireFeatures(routingv8.FeatureTruck, "teleport", "hover")
+	assert.Error(t, err, "unsupported features in version "+routingv8.Version+": teleport, hover")
+}
+
+func TestClient_UserAgentVersion(t *testing.T) {
+	t.Parallel()
+	var userAgent string
+	client := routingv8.NewClient(httpClientFunc(func(req *http.Request) (*http.Response, error) {
+		userAgent = req.Header.Get("User-Agent")
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(`{}`))}, nil
+	}))
+	_, err := client.Routing.Routes(context.Background(), &routingv8.RoutesRequest{
+		TransportMode: routingv8.TransportModeCar,
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, "einride/here-go/"+routingv8.Version, userAgent)
+}
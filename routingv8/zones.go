@@ -0,0 +1,41 @@
+package routingv8
+
+// RoutingZoneTypeEnvironmental is the type of low-emission zones.
+const RoutingZoneTypeEnvironmental = "environmental"
+
+// ZoneCompliancePolicy describes the environmental zones a vehicle is allowed to enter.
+type ZoneCompliancePolicy struct {
+	// PermittedZones are the codes or names of the environmental zones the vehicle is allowed to enter.
+	PermittedZones []string
+}
+
+func (p *ZoneCompliancePolicy) permits(zone RoutingZone) bool {
+	for _, permitted := range p.PermittedZones {
+		if permitted != "" && (permitted == zone.Code || permitted == zone.Name) {
+			return true
+		}
+	}
+	return false
+}
+
+// ZoneViolation is an environmental zone entered by a route which the vehicle is not allowed in.
+type ZoneViolation struct {
+	// SectionID of the section entering the zone.
+	SectionID string
+	// Zone entered.
+	Zone RoutingZone
+}
+
+// CheckZoneCompliance returns the environmental zones entered by the route which are not permitted by the policy.
+// The route has to be requested with ReturnRoutingZones.
+func CheckZoneCompliance(route *Route, policy *ZoneCompliancePolicy) []ZoneViolation {
+	var violations []ZoneViolation
+	for _, section := range route.Sections {
+		for _, zone := range section.RoutingZones {
+			if zone.Type == RoutingZoneTypeEnvironmental && !policy.permits(zone) {
+				violations = append(violations, ZoneViolation{SectionID: section.ID, Zone: zone})
+			}
+		}
+	}
+	return violations
+}
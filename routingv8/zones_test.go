@@ -0,0 +1,40 @@
+package routingv8_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"go.einride.tech/here/routingv8"
+	"gotest.tools/v3/assert"
+)
+
+func TestCheckZoneCompliance(t *testing.T) {
+	t.Parallel()
+	var route routingv8.Route
+	assert.NilError(t, json.Unmarshal([]byte(`{
+  "sections": [
+    {
+      "id": "berlin",
+      "routingZones": [
+        {"type": "environmental", "name": "Umweltzone Berlin", "code": "DEBE01"},
+        {"type": "vignette", "name": "Vignette"}
+      ],
+      "spans": [{"offset": 0, "routingZones": [0]}]
+    },
+    {
+      "id": "potsdam",
+      "routingZones": [{"type": "environmental", "name": "Umweltzone Potsdam", "code": "DEBB01"}]
+    }
+  ]
+}`), &route))
+	assert.DeepEqual(t, []int{0}, route.Sections[0].Spans[0].RoutingZones)
+	violations := routingv8.CheckZoneCompliance(&route, &routingv8.ZoneCompliancePolicy{
+		PermittedZones: []string{"DEBE01"},
+	})
+	assert.DeepEqual(t, []routingv8.ZoneViolation{
+		{
+			SectionID: "potsdam",
+			Zone:      routingv8.RoutingZone{Type: "environmental", Name: "Umweltzone Potsdam", Code: "DEBB01"},
+		},
+	}, violations)
+}
@@ -0,0 +1,61 @@
+package here
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"regexp"
+	"strings"
+)
+
+// Scrubber redacts personal data, such as coordinates and addresses, from a string before it leaves the client in
+// logs, metric labels or cache keys.
+type Scrubber func(s string) string
+
+// Redacted replaces personal data removed by scrubbers.
+const Redacted = "REDACTED"
+
+// ChainScrubbers returns a scrubber applying the scrubbers in order.
+func ChainScrubbers(scrubbers ...Scrubber) Scrubber {
+	return func(s string) string {
+		for _, scrub := range scrubbers {
+			s = scrub(s)
+		}
+		return s
+	}
+}
+
+// scrubCoordinatePattern matches coordinates, also with URL encoded commas.
+var scrubCoordinatePattern = regexp.MustCompile(`-?\d{1,3}(?:\.\d+)?(?:,|%2[Cc])-?\d{1,3}(?:\.\d+)?`)
+
+// scrubJSONCoordinatePattern matches the values of JSON coordinate fields, e.g. of waypoints in request bodies.
+var scrubJSONCoordinatePattern = regexp.MustCompile(
+	`("(?:lat|lng|lon|latitude|longitude)"\s*:\s*)-?\d{1,3}(?:\.\d+)?(?:[eE][-+]?\d+)?`,
+)
+
+// ScrubCoordinates replaces all coordinates of the form lat,lng in s, e.g. in URLs, with Redacted. The values of
+// the JSON fields "lat", "lng", "lon", "latitude" and "longitude", e.g. in request and response bodies of debug
+// dumps, are replaced with the string Redacted.
+func ScrubCoordinates(s string) string {
+	s = scrubJSONCoordinatePattern.ReplaceAllString(s, `${1}"`+Redacted+`"`)
+	return scrubCoordinatePattern.ReplaceAllString(s, Redacted)
+}
+
+// ScrubQueryParameters returns a scrubber replacing the values of the query parameters with Redacted, e.g. "q" and
+// "qq" for free-form and structured addresses of geocoding requests.
+func ScrubQueryParameters(names ...string) Scrubber {
+	quoted := make([]string, 0, len(names))
+	for _, name := range names {
+		quoted = append(quoted, regexp.QuoteMeta(name))
+	}
+	pattern := regexp.MustCompile(`([?&](?:` + strings.Join(quoted, "|") + `)=)[^&\s#]*`)
+	return func(s string) string {
+		return pattern.ReplaceAllString(s, "${1}"+Redacted)
+	}
+}
+
+// ScrubHash replaces s with its SHA-256 hash. Unlike other scrubbers, it keeps distinct strings distinct, which is
+// required for cache keys.
+func ScrubHash(s string) string {
+	sum := sha256.Sum256([]byte(s))
+	return hex.EncodeToString(sum[:])
+}
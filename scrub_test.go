@@ -0,0 +1,107 @@
+package here_test
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"go.einride.tech/here"
+	"go.einride.tech/here/routingv8"
+	"gotest.tools/v3/assert"
+)
+
+func TestScrubbers(t *testing.T) {
+	t.Parallel()
+	scrub := here.ChainScrubbers(here.ScrubCoordinates, here.ScrubQueryParameters("q", "qq"))
+	assert.Equal(
+		t,
+		"https://geocode.search.hereapi.com/v1/geocode?at=REDACTED&q=REDACTED&qq=REDACTED&limit=1",
+		scrub("https://geocode.search.hereapi.com/v1/geocode?at=57.7%2C11.9&q=Lindholmspiren+3&qq=city%3DG&limit=1"),
+	)
+	assert.Equal(t, "origin=REDACTED&destination=REDACTED", scrub("origin=57.707752,11.949767&destination=-33,151"))
+	assert.Equal(
+		t,
+		`{"origins":[{"lat":"REDACTED","lng":"REDACTED"}],"center":{"lat" : "REDACTED", "lng":"REDACTED"}}`,
+		scrub(`{"origins":[{"lat":57.707752,"lng":11.949767}],"center":{"lat" : -33, "lng":1.5e2}}`),
+	)
+	assert.Equal(t, 64, len(here.ScrubHash("key")))
+	assert.Assert(t, here.ScrubHash("a") != here.ScrubHash("b"))
+}
+
+func TestConditionalRoundTripper_KeyScrubber(t *testing.T) {
+	t.Parallel()
+	cache := here.NewMemoryResponseCache()
+	transport := here.NewConditionalRoundTripper(cache, roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			ProtoMajor: 1,
+			ProtoMinor: 1,
+			Header:     http.Header{"Etag": []string{`"v1"`}},
+			Body:       io.NopCloser(strings.NewReader(`{}`)),
+		}, nil
+	}))
+	transport.KeyScrubber = here.ScrubHash
+	client := &http.Client{Transport: transport}
+	const u = "https://revgeocode.search.hereapi.com/v1/revgeocode?at=57.7%2C11.9"
+	resp, err := client.Get(u)
+	assert.NilError(t, err)
+	assert.NilError(t, resp.Body.Close())
+	_, ok := cache.Get(u)
+	assert.Assert(t, !ok)
+	_, ok = cache.Get(here.ScrubHash(u))
+	assert.Assert(t, ok)
+}
+
+func TestUsageRecorder_TagScrubber(t *testing.T) {
+	t.Parallel()
+	recorder := here.NewUsageRecorder(roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(`{}`))}, nil
+	}))
+	recorder.TagScrubber = func(tag string) string {
+		return strings.SplitN(tag, "/", 2)[0]
+	}
+	client := &http.Client{Transport: recorder}
+	ctx := here.WithUsageTag(context.Background(), "team-a/user@example.com")
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://router.hereapi.com/v8/routes", nil)
+	assert.NilError(t, err)
+	resp, err := client.Do(req)
+	assert.NilError(t, err)
+	assert.NilError(t, resp.Body.Close())
+	report := recorder.Flush()
+	assert.Equal(t, 1, len(report.Records))
+	assert.Equal(t, "team-a", report.Records[0].Tag)
+}
+
+func TestScrubCoordinates_MatrixDebugDump(t *testing.T) {
+	t.Parallel()
+	client := routingv8.NewClient(httpClientFunc(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{
+			StatusCode: http.StatusOK,
+			ProtoMajor: 1,
+			ProtoMinor: 1,
+			Body: io.NopCloser(strings.NewReader(
+				`{"matrixId":"m1","matrix":{"numOrigins":1,"numDestinations":1,"travelTimes":[3600]},` +
+					`"regionDefinition":{"type":"circle","center":{"lat":57.71,"lng":11.95},"radius":10000}}`,
+			)),
+		}, nil
+	}))
+	client.DebugScrubber = here.ScrubCoordinates
+	var dump bytes.Buffer
+	ctx := routingv8.WithDebugDump(context.Background(), &dump)
+	_, err := client.Matrix.CalculateMatrix(ctx, &routingv8.CalculateMatrixRequest{
+		Body: &routingv8.CalculateMatrixBody{
+			Origins:          []*routingv8.GeoWaypoint{{Lat: 57.707752, Long: 11.949767}},
+			Destinations:     []*routingv8.GeoWaypoint{{Lat: 59.337492, Long: 18.063672}},
+			RegionDefinition: routingv8.CircleRegion(routingv8.GeoWaypoint{Lat: 57.71, Long: 11.95}, 10000),
+		},
+	})
+	assert.NilError(t, err)
+	for _, coordinate := range []string{"57.707752", "11.949767", "59.337492", "18.063672", "57.71", "11.95"} {
+		assert.Assert(t, !strings.Contains(dump.String(), coordinate), dump.String())
+	}
+	assert.Assert(t, strings.Contains(dump.String(), `"origins":[{"lat":"REDACTED","lng":"REDACTED"}]`))
+	assert.Assert(t, strings.Contains(dump.String(), `"travelTimes":[3600]`))
+}
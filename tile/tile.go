@@ -0,0 +1,129 @@
+// Package tile converts between WGS84 coordinates and the Web Mercator tiles used by HERE map tiles and quadkeys.
+package tile
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// MaxZoom is the maximum supported zoom level.
+const MaxZoom = 30
+
+// maxLatitude is the latitude where the Web Mercator projection is cut off.
+const maxLatitude = 85.05112877980659
+
+// Tile is a map tile at a zoom level, with X increasing eastwards and Y increasing southwards.
+type Tile struct {
+	Zoom int
+	X    int
+	Y    int
+}
+
+// Bounds of a tile in WGS84 degrees.
+type Bounds struct {
+	North float64
+	South float64
+	East  float64
+	West  float64
+}
+
+// FromLatLng returns the tile containing the coordinate at the zoom level.
+func FromLatLng(lat, lng float64, zoom int) Tile {
+	n := float64(uint64(1) << uint(zoom))
+	lat = math.Max(-maxLatitude, math.Min(maxLatitude, lat))
+	latRad := lat * math.Pi / 180
+	x := (lng + 180) / 360 * n
+	y := (1 - math.Log(math.Tan(latRad)+1/math.Cos(latRad))/math.Pi) / 2 * n
+	return Tile{Zoom: zoom, X: clamp(int(math.Floor(x)), int(n)-1), Y: clamp(int(math.Floor(y)), int(n)-1)}
+}
+
+func clamp(v, max int) int {
+	if v < 0 {
+		return 0
+	}
+	if v > max {
+		return max
+	}
+	return v
+}
+
+// Bounds returns the bounds of the tile.
+func (t Tile) Bounds() Bounds {
+	return Bounds{
+		North: tileLatitude(t.Y, t.Zoom),
+		South: tileLatitude(t.Y+1, t.Zoom),
+		West:  tileLongitude(t.X, t.Zoom),
+		East:  tileLongitude(t.X+1, t.Zoom),
+	}
+}
+
+// Center returns the coordinate at the center of the tile.
+func (t Tile) Center() (lat, lng float64) {
+	n := math.Pow(2, float64(t.Zoom))
+	lng = (float64(t.X)+0.5)/n*360 - 180
+	lat = math.Atan(math.Sinh(math.Pi*(1-2*(float64(t.Y)+0.5)/n))) * 180 / math.Pi
+	return lat, lng
+}
+
+func tileLongitude(x, zoom int) float64 {
+	return float64(x)/math.Pow(2, float64(zoom))*360 - 180
+}
+
+func tileLatitude(y, zoom int) float64 {
+	return math.Atan(math.Sinh(math.Pi*(1-2*float64(y)/math.Pow(2, float64(zoom))))) * 180 / math.Pi
+}
+
+// Quadkey returns the quadkey of the tile, with one digit per zoom level.
+func (t Tile) Quadkey() string {
+	var b strings.Builder
+	b.Grow(t.Zoom)
+	for i := t.Zoom; i > 0; i-- {
+		digit := byte('0')
+		mask := 1 << uint(i-1)
+		if t.X&mask != 0 {
+			digit++
+		}
+		if t.Y&mask != 0 {
+			digit += 2
+		}
+		b.WriteByte(digit)
+	}
+	return b.String()
+}
+
+// FromQuadkey parses a quadkey into a tile.
+func FromQuadkey(quadkey string) (Tile, error) {
+	if len(quadkey) > MaxZoom {
+		return Tile{}, fmt.Errorf("parse quadkey %q: zoom level exceeds %d", quadkey, MaxZoom)
+	}
+	t := Tile{Zoom: len(quadkey)}
+	for i := 0; i < len(quadkey); i++ {
+		mask := 1 << uint(len(quadkey)-i-1)
+		switch quadkey[i] {
+		case '0':
+		case '1':
+			t.X |= mask
+		case '2':
+			t.Y |= mask
+		case '3':
+			t.X |= mask
+			t.Y |= mask
+		default:
+			return Tile{}, fmt.Errorf("parse quadkey %q: invalid digit %q", quadkey, quadkey[i])
+		}
+	}
+	return t, nil
+}
+
+// Parent returns the tile at the zoom level above containing the tile.
+func (t Tile) Parent() Tile {
+	if t.Zoom == 0 {
+		return t
+	}
+	return Tile{Zoom: t.Zoom - 1, X: t.X >> 1, Y: t.Y >> 1}
+}
+
+func (t Tile) String() string {
+	return fmt.Sprintf("%d/%d/%d", t.Zoom, t.X, t.Y)
+}
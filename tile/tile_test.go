@@ -0,0 +1,36 @@
+package tile_test
+
+import (
+	"testing"
+
+	"go.einride.tech/here/tile"
+	"gotest.tools/v3/assert"
+)
+
+func TestFromLatLng(t *testing.T) {
+	t.Parallel()
+	// Einride Gothenburg.
+	actual := tile.FromLatLng(57.707752, 11.949767, 12)
+	assert.Equal(t, tile.Tile{Zoom: 12, X: 2183, Y: 1239}, actual)
+	bounds := actual.Bounds()
+	assert.Assert(t, bounds.South <= 57.707752 && 57.707752 <= bounds.North)
+	assert.Assert(t, bounds.West <= 11.949767 && 11.949767 <= bounds.East)
+	lat, lng := actual.Center()
+	assert.Equal(t, actual, tile.FromLatLng(lat, lng, 12))
+	assert.Equal(t, tile.Tile{Zoom: 0}, tile.FromLatLng(90, 180, 0))
+}
+
+func TestQuadkey(t *testing.T) {
+	t.Parallel()
+	actual := tile.Tile{Zoom: 3, X: 3, Y: 5}
+	assert.Equal(t, "213", actual.Quadkey())
+	parsed, err := tile.FromQuadkey("213")
+	assert.NilError(t, err)
+	assert.Equal(t, actual, parsed)
+	assert.Equal(t, "21", actual.Parent().Quadkey())
+	_, err = tile.FromQuadkey("214")
+	assert.ErrorContains(t, err, "invalid digit")
+	root, err := tile.FromQuadkey("")
+	assert.NilError(t, err)
+	assert.Equal(t, tile.Tile{}, root)
+}
@@ -0,0 +1,108 @@
+package trafficv7
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+type TrafficRequest struct {
+	// Area to query traffic in.
+	Area Area
+	// LocationReferencing is the format of the locations of the results. Defaults to LocationReferencingShape.
+	LocationReferencing LocationReferencing
+}
+
+func (r *TrafficRequest) QueryString() (string, error) {
+	in, err := r.Area.query()
+	if err != nil {
+		return "", err
+	}
+	values := make(url.Values)
+	values.Add("in", in)
+	switch lr := r.LocationReferencing.String(); lr {
+	case unspecified:
+		values.Add("locationReferencing", LocationReferencingShape.String())
+	case invalid:
+		return "", fmt.Errorf("invalid location referencing: %d", int(r.LocationReferencing))
+	default:
+		values.Add("locationReferencing", lr)
+	}
+	return values.Encode(), nil
+}
+
+// FlowResponse contains the current traffic flow of the locations in the requested area.
+type FlowResponse struct {
+	// SourceUpdated is when the traffic data was last updated.
+	SourceUpdated time.Time    `json:"sourceUpdated"`
+	Results       []FlowResult `json:"results"`
+}
+
+// FlowResult is the current traffic flow of a location.
+type FlowResult struct {
+	Location    Location    `json:"location"`
+	CurrentFlow CurrentFlow `json:"currentFlow"`
+}
+
+// IncidentsResponse contains the traffic incidents in the requested area.
+type IncidentsResponse struct {
+	// SourceUpdated is when the traffic data was last updated.
+	SourceUpdated time.Time        `json:"sourceUpdated"`
+	Results       []IncidentResult `json:"results"`
+}
+
+// IncidentResult is a traffic incident at a location.
+type IncidentResult struct {
+	Location        Location        `json:"location"`
+	IncidentDetails IncidentDetails `json:"incidentDetails"`
+}
+
+// Flow returns the current traffic flow in an area.
+// See https://developer.here.com/documentation/traffic-api/dev_guide/topics/use-cases/flow-area.html
+// for details about other parameters.
+func (s *TrafficService) Flow(ctx context.Context, req *TrafficRequest) (_ *FlowResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("flow: %w", err)
+		}
+	}()
+	var resp FlowResponse
+	if err := s.get(ctx, "flow", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+// Incidents returns the traffic incidents in an area.
+// See https://developer.here.com/documentation/traffic-api/dev_guide/topics/use-cases/incidents-area.html
+// for details about other parameters.
+func (s *TrafficService) Incidents(ctx context.Context, req *TrafficRequest) (_ *IncidentsResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("incidents: %w", err)
+		}
+	}()
+	var resp IncidentsResponse
+	if err := s.get(ctx, "incidents", req, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (s *TrafficService) get(ctx context.Context, path string, req *TrafficRequest, v interface{}) error {
+	query, err := req.QueryString()
+	if err != nil {
+		return err
+	}
+	u, err := s.URL.Parse(path)
+	if err != nil {
+		return err
+	}
+	r, err := s.Client.NewRequest(ctx, u, http.MethodGet, query)
+	if err != nil {
+		return err
+	}
+	return s.Client.Do(r, v)
+}
@@ -0,0 +1,164 @@
+package trafficv7_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"go.einride.tech/here/trafficv7"
+	"gotest.tools/v3/assert"
+)
+
+type httpClientFunc func(*http.Request) (*http.Response, error)
+
+func (f httpClientFunc) Do(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestTrafficService_Flow(t *testing.T) {
+	t.Parallel()
+	var requested *url.URL
+	client := trafficv7.NewClient(httpClientFunc(func(req *http.Request) (*http.Response, error) {
+		requested = req.URL
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(`{
+			"sourceUpdated": "2023-01-01T12:00:00Z",
+			"results": [{
+				"location": {
+					"description": "E6",
+					"length": 120.5,
+					"shape": {"links": [{"points": [{"lat": 57.7, "lng": 11.9}, {"lat": 57.71, "lng": 11.91}], "length": 120.5}]}
+				},
+				"currentFlow": {
+					"speed": 8.3,
+					"speedUncapped": 8.3,
+					"freeFlow": 22.2,
+					"jamFactor": 8.5,
+					"confidence": 0.98,
+					"traversability": "open"
+				}
+			}]
+		}`))}, nil
+	}))
+	resp, err := client.Traffic.Flow(context.Background(), &trafficv7.TrafficRequest{
+		Area: trafficv7.Area{BoundingBox: &trafficv7.BoundingBox{West: 11.9, South: 57.6, East: 12.1, North: 57.8}},
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, "/v7/flow", requested.Path)
+	assert.Equal(t, "bbox:11.9,57.6,12.1,57.8", requested.Query().Get("in"))
+	assert.Equal(t, "shape", requested.Query().Get("locationReferencing"))
+	assert.Equal(t, 1, len(resp.Results))
+	flow := resp.Results[0].CurrentFlow
+	assert.Equal(t, trafficv7.JamFactor(8.5), flow.JamFactor)
+	assert.Equal(t, trafficv7.CongestionQueuing, flow.JamFactor.Congestion())
+	assert.Equal(t, 2, len(resp.Results[0].Location.Shape.Links[0].Points))
+}
+
+func TestTrafficService_Incidents(t *testing.T) {
+	t.Parallel()
+	var requested *url.URL
+	client := trafficv7.NewClient(httpClientFunc(func(req *http.Request) (*http.Response, error) {
+		requested = req.URL
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(`{
+			"sourceUpdated": "2023-01-01T12:00:00Z",
+			"results": [{
+				"location": {"description": "E6", "length": 500},
+				"incidentDetails": {
+					"id": "1",
+					"type": "accident",
+					"criticality": "major",
+					"roadClosed": true,
+					"startTime": "2023-01-01T11:00:00Z",
+					"description": {"value": "Accident, road closed", "language": "en"}
+				}
+			}]
+		}`))}, nil
+	}))
+	resp, err := client.Traffic.Incidents(context.Background(), &trafficv7.TrafficRequest{
+		Area: trafficv7.Area{Corridor: &trafficv7.Corridor{
+			Points: []trafficv7.GeoWaypoint{{Lat: 50.1, Long: 8.6}, {Lat: 50.2, Long: 8.7}},
+			Width:  100,
+		}},
+		LocationReferencing: trafficv7.LocationReferencingNone,
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, "/v7/incidents", requested.Path)
+	assert.Assert(t, strings.HasPrefix(requested.Query().Get("in"), "corridor:"))
+	assert.Assert(t, strings.HasSuffix(requested.Query().Get("in"), ";w=100"))
+	assert.Equal(t, "none", requested.Query().Get("locationReferencing"))
+	details := resp.Results[0].IncidentDetails
+	assert.Equal(t, trafficv7.CriticalityMajor, details.Criticality)
+	assert.Assert(t, details.RoadClosed)
+	assert.Equal(t, "Accident, road closed", details.Description.Value)
+}
+
+func TestTrafficRequest_QueryString(t *testing.T) {
+	t.Parallel()
+	for _, tt := range []struct {
+		name     string
+		request  trafficv7.TrafficRequest
+		expected string
+		err      string
+	}{
+		{
+			name: "circle",
+			request: trafficv7.TrafficRequest{
+				Area: trafficv7.Area{
+					Circle: &trafficv7.Circle{Center: trafficv7.GeoWaypoint{Lat: 52.5, Long: 13.4}, Radius: 1000},
+				},
+				LocationReferencing: trafficv7.LocationReferencingOLR,
+			},
+			expected: "in=circle%3A52.5%2C13.4%3Br%3D1000&locationReferencing=olr",
+		},
+		{
+			name:    "no area",
+			request: trafficv7.TrafficRequest{},
+			err:     "invalid area: exactly one of bounding box, circle or corridor required, got 0",
+		},
+		{
+			name: "two areas",
+			request: trafficv7.TrafficRequest{Area: trafficv7.Area{
+				Circle:      &trafficv7.Circle{Radius: 1},
+				BoundingBox: &trafficv7.BoundingBox{North: 1},
+			}},
+			err: "invalid area: exactly one of bounding box, circle or corridor required, got 2",
+		},
+		{
+			name: "upside down bounding box",
+			request: trafficv7.TrafficRequest{
+				Area: trafficv7.Area{BoundingBox: &trafficv7.BoundingBox{South: 2, North: 1}},
+			},
+			err: "invalid bounding box: north 1 not above south 2",
+		},
+		{
+			name: "invalid location referencing",
+			request: trafficv7.TrafficRequest{
+				Area:                trafficv7.Area{Circle: &trafficv7.Circle{Radius: 1}},
+				LocationReferencing: 42,
+			},
+			err: "invalid location referencing: 42",
+		},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := tt.request.QueryString()
+			if tt.err != "" {
+				assert.Error(t, err, tt.err)
+				return
+			}
+			assert.NilError(t, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestJamFactor_Congestion(t *testing.T) {
+	t.Parallel()
+	assert.Equal(t, trafficv7.CongestionFree, trafficv7.JamFactor(0).Congestion())
+	assert.Equal(t, trafficv7.CongestionSlow, trafficv7.JamFactor(4).Congestion())
+	assert.Equal(t, trafficv7.CongestionQueuing, trafficv7.JamFactor(9.9).Congestion())
+	assert.Equal(t, trafficv7.CongestionClosed, trafficv7.JamFactorClosed.Congestion())
+}
@@ -0,0 +1,253 @@
+package trafficv7
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"go.einride.tech/here/flexpolyline"
+)
+
+const (
+	invalid     = "invalid"
+	unspecified = "unspecified"
+)
+
+type GeoWaypoint struct {
+	Lat  float64 `json:"lat"`
+	Long float64 `json:"lng"`
+}
+
+func (w GeoWaypoint) String() string {
+	return formatFloat(w.Lat) + "," + formatFloat(w.Long)
+}
+
+// BoundingBox is the area between two latitudes and two longitudes.
+type BoundingBox struct {
+	West  float64
+	South float64
+	East  float64
+	North float64
+}
+
+// Circle is the area within the radius in meters around the center.
+type Circle struct {
+	Center GeoWaypoint
+	Radius int
+}
+
+// Corridor is the area within the width in meters around a polyline.
+type Corridor struct {
+	Points []GeoWaypoint
+	Width  int
+}
+
+// Area to query traffic in. Exactly one of the areas has to be set.
+type Area struct {
+	BoundingBox *BoundingBox
+	Circle      *Circle
+	Corridor    *Corridor
+}
+
+// query returns the value of the in query parameter for the area.
+func (a *Area) query() (string, error) {
+	var n int
+	for _, set := range []bool{a.BoundingBox != nil, a.Circle != nil, a.Corridor != nil} {
+		if set {
+			n++
+		}
+	}
+	if n != 1 {
+		return "", fmt.Errorf("invalid area: exactly one of bounding box, circle or corridor required, got %d", n)
+	}
+	switch {
+	case a.BoundingBox != nil:
+		b := a.BoundingBox
+		if b.North <= b.South {
+			return "", fmt.Errorf("invalid bounding box: north %v not above south %v", b.North, b.South)
+		}
+		return fmt.Sprintf(
+			"bbox:%s,%s,%s,%s", formatFloat(b.West), formatFloat(b.South), formatFloat(b.East), formatFloat(b.North),
+		), nil
+	case a.Circle != nil:
+		if a.Circle.Radius <= 0 {
+			return "", fmt.Errorf("invalid circle: radius %d", a.Circle.Radius)
+		}
+		return fmt.Sprintf("circle:%v;r=%d", a.Circle.Center, a.Circle.Radius), nil
+	default:
+		c := a.Corridor
+		if len(c.Points) < 2 {
+			return "", fmt.Errorf("invalid corridor: %d points, require at least 2", len(c.Points))
+		}
+		if c.Width <= 0 {
+			return "", fmt.Errorf("invalid corridor: width %d", c.Width)
+		}
+		polyline := flexpolyline.Polyline{Precision: 5, Points: make([]flexpolyline.Point, 0, len(c.Points))}
+		for _, p := range c.Points {
+			polyline.Points = append(polyline.Points, flexpolyline.Point{Lat: p.Lat, Lng: p.Long})
+		}
+		encoded, err := flexpolyline.Encode(&polyline)
+		if err != nil {
+			return "", fmt.Errorf("invalid corridor: %v", err)
+		}
+		return fmt.Sprintf("corridor:%s;w=%d", encoded, c.Width), nil
+	}
+}
+
+// LocationReferencing is the format of the locations of flow and incident results.
+type LocationReferencing int
+
+const (
+	LocationReferencingUnspecified LocationReferencing = iota
+	// LocationReferencingShape returns the locations as coordinates.
+	LocationReferencingShape
+	// LocationReferencingOLR returns the locations as OpenLR references.
+	LocationReferencingOLR
+	// LocationReferencingTMC returns the locations as TMC references.
+	LocationReferencingTMC
+	// LocationReferencingNone returns no locations.
+	LocationReferencingNone
+)
+
+func (l LocationReferencing) String() string {
+	switch l {
+	case LocationReferencingUnspecified:
+		return unspecified
+	case LocationReferencingShape:
+		return "shape"
+	case LocationReferencingOLR:
+		return "olr"
+	case LocationReferencingTMC:
+		return "tmc"
+	case LocationReferencingNone:
+		return "none"
+	default:
+		return invalid
+	}
+}
+
+// Location of a flow or incident result.
+type Location struct {
+	// Description of the location, e.g. the name of the road.
+	Description string `json:"description"`
+	// Length of the location in meters.
+	Length float64 `json:"length"`
+	// Shape of the location, for LocationReferencingShape.
+	Shape *Shape `json:"shape,omitempty"`
+	// OLR is the OpenLR reference of the location, for LocationReferencingOLR.
+	OLR string `json:"olr,omitempty"`
+}
+
+// Shape of a location, as a sequence of links.
+type Shape struct {
+	Links []Link `json:"links"`
+}
+
+// Link is a part of the road network of a location.
+type Link struct {
+	Points []GeoWaypoint `json:"points"`
+	Length float64       `json:"length"`
+}
+
+// JamFactor is the congestion of a road between 0 for free flow and 10 for a closed road.
+type JamFactor float64
+
+// JamFactorClosed is the jam factor of closed roads.
+const JamFactorClosed JamFactor = 10
+
+// Congestion is the level of congestion of a jam factor, as used for coloring traffic maps.
+type Congestion int
+
+const (
+	CongestionUnspecified Congestion = iota
+	// CongestionFree - jam factor below 4.
+	CongestionFree
+	// CongestionSlow - jam factor from 4 to below 8.
+	CongestionSlow
+	// CongestionQueuing - jam factor from 8 to below 10.
+	CongestionQueuing
+	// CongestionClosed - jam factor 10.
+	CongestionClosed
+)
+
+func (c Congestion) String() string {
+	switch c {
+	case CongestionUnspecified:
+		return unspecified
+	case CongestionFree:
+		return "free"
+	case CongestionSlow:
+		return "slow"
+	case CongestionQueuing:
+		return "queuing"
+	case CongestionClosed:
+		return "closed"
+	default:
+		return invalid
+	}
+}
+
+// Congestion returns the level of congestion of the jam factor.
+func (j JamFactor) Congestion() Congestion {
+	switch {
+	case j >= JamFactorClosed:
+		return CongestionClosed
+	case j >= 8:
+		return CongestionQueuing
+	case j >= 4:
+		return CongestionSlow
+	default:
+		return CongestionFree
+	}
+}
+
+// CurrentFlow is the current traffic flow of a location.
+type CurrentFlow struct {
+	// Speed in meters per second, capped by the speed limit.
+	Speed float64 `json:"speed"`
+	// SpeedUncapped in meters per second, which may exceed the speed limit.
+	SpeedUncapped float64 `json:"speedUncapped"`
+	// FreeFlow is the speed in meters per second without traffic.
+	FreeFlow float64 `json:"freeFlow"`
+	// JamFactor of the location.
+	JamFactor JamFactor `json:"jamFactor"`
+	// Confidence of the flow between 0 and 1, where values above 0.7 are based on real-time data.
+	Confidence float64 `json:"confidence"`
+	// Traversability of the location, e.g. "open" or "closed".
+	Traversability string `json:"traversability"`
+}
+
+// Incident criticality values.
+const (
+	CriticalityCritical = "critical"
+	CriticalityMajor    = "major"
+	CriticalityMinor    = "minor"
+	CriticalityLow      = "low"
+)
+
+// IncidentDetails describe a traffic incident.
+type IncidentDetails struct {
+	ID         string `json:"id"`
+	OriginalID string `json:"originalId"`
+	// Type of the incident, e.g. "accident", "construction" or "roadClosure".
+	Type string `json:"type"`
+	// Criticality of the incident, e.g. CriticalityMajor.
+	Criticality string    `json:"criticality"`
+	RoadClosed  bool      `json:"roadClosed"`
+	StartTime   time.Time `json:"startTime"`
+	EndTime     time.Time `json:"endTime"`
+	// EntryTime is when the incident was entered into the system.
+	EntryTime   time.Time     `json:"entryTime"`
+	Description LocalizedText `json:"description"`
+	Summary     LocalizedText `json:"summary"`
+}
+
+// LocalizedText is a text in a language.
+type LocalizedText struct {
+	Value    string `json:"value"`
+	Language string `json:"language"`
+}
+
+func formatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
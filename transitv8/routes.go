@@ -0,0 +1,175 @@
+package transitv8
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Return attributes of a routes request.
+const (
+	ReturnPolyline      = "polyline"
+	ReturnActions       = "actions"
+	ReturnTravelSummary = "travelSummary"
+	ReturnIntermediate  = "intermediate"
+	ReturnFares         = "fares"
+)
+
+// DefaultReturn are the attributes returned when a routes request has no return attributes.
+var DefaultReturn = []string{ReturnTravelSummary, ReturnIntermediate}
+
+type RoutesRequest struct {
+	Origin      GeoWaypoint
+	Destination GeoWaypoint
+	// DepartureTime from the origin. Defaults to now. Can not be combined with ArrivalTime.
+	DepartureTime time.Time
+	// ArrivalTime at the destination, for routes calculated backwards from the arrival.
+	ArrivalTime time.Time
+	// Alternatives is the number of alternative routes to return, in addition to the best route.
+	Alternatives int
+	// MaxChanges is the maximum number of changes between transit lines. No limit if nil.
+	MaxChanges *int
+	// Modes of transit to use, e.g. ModeBus. All modes are used if empty. Can not be combined with ExcludedModes.
+	Modes []string
+	// ExcludedModes of transit not to use.
+	ExcludedModes []string
+	// PedestrianMaxDistance is the maximum walking distance in meters to and from stations.
+	PedestrianMaxDistance int
+	// Return attributes of the routes. Defaults to DefaultReturn.
+	Return []string
+	// Lang is the preferred language of the response, as a BCP 47 language code.
+	Lang string
+}
+
+func (r *RoutesRequest) QueryString() (string, error) {
+	if !r.DepartureTime.IsZero() && !r.ArrivalTime.IsZero() {
+		return "", fmt.Errorf("invalid time: both departure and arrival time set")
+	}
+	if len(r.Modes) > 0 && len(r.ExcludedModes) > 0 {
+		return "", fmt.Errorf("invalid modes: both modes and excluded modes set")
+	}
+	if r.MaxChanges != nil && *r.MaxChanges < 0 {
+		return "", fmt.Errorf("invalid max changes: %d", *r.MaxChanges)
+	}
+	values := make(url.Values)
+	values.Add("origin", r.Origin.String())
+	values.Add("destination", r.Destination.String())
+	if !r.DepartureTime.IsZero() {
+		values.Add("departureTime", r.DepartureTime.Format(time.RFC3339))
+	}
+	if !r.ArrivalTime.IsZero() {
+		values.Add("arrivalTime", r.ArrivalTime.Format(time.RFC3339))
+	}
+	if r.Alternatives > 0 {
+		values.Add("alternatives", strconv.Itoa(r.Alternatives))
+	}
+	if r.MaxChanges != nil {
+		values.Add("changes", strconv.Itoa(*r.MaxChanges))
+	}
+	switch {
+	case len(r.Modes) > 0:
+		values.Add("modes", strings.Join(r.Modes, ","))
+	case len(r.ExcludedModes) > 0:
+		excluded := make([]string, 0, len(r.ExcludedModes))
+		for _, mode := range r.ExcludedModes {
+			excluded = append(excluded, "-"+mode)
+		}
+		values.Add("modes", strings.Join(excluded, ","))
+	}
+	if r.PedestrianMaxDistance > 0 {
+		values.Add("pedestrian[maxDistance]", strconv.Itoa(r.PedestrianMaxDistance))
+	}
+	attributes := r.Return
+	if len(attributes) == 0 {
+		attributes = DefaultReturn
+	}
+	values.Add("return", strings.Join(attributes, ","))
+	if r.Lang != "" {
+		values.Add("lang", r.Lang)
+	}
+	return values.Encode(), nil
+}
+
+// RoutesResponse contains the transit routes between origin and destination.
+type RoutesResponse struct {
+	Routes []Route `json:"routes"`
+}
+
+// Route is a journey of transit sections and pedestrian sections between them.
+type Route struct {
+	ID       string    `json:"id"`
+	Sections []Section `json:"sections"`
+}
+
+// Section is a walk or a ride on a transit line.
+type Section struct {
+	ID string `json:"id"`
+	// Type of the section, SectionTypePedestrian or SectionTypeTransit.
+	Type          string         `json:"type"`
+	Departure     SectionPlace   `json:"departure"`
+	Arrival       SectionPlace   `json:"arrival"`
+	TravelSummary *TravelSummary `json:"travelSummary,omitempty"`
+	Transport     Transport      `json:"transport"`
+	// Agency operating the line of transit sections.
+	Agency *Agency `json:"agency,omitempty"`
+	// IntermediateStops of transit sections between boarding and alighting. Requires ReturnIntermediate.
+	IntermediateStops []IntermediateStop `json:"intermediateStops,omitempty"`
+	// Polyline of the section, encoded as a flexible polyline. Requires ReturnPolyline.
+	Polyline string `json:"polyline,omitempty"`
+}
+
+// IsTransit reports whether the section is a ride on a transit line.
+func (s *Section) IsTransit() bool {
+	return s.Type == SectionTypeTransit
+}
+
+// IsPedestrian reports whether the section is a walk, e.g. to access or egress a station.
+func (s *Section) IsPedestrian() bool {
+	return s.Type == SectionTypePedestrian
+}
+
+// Changes returns the number of changes between transit lines of the route.
+func (r *Route) Changes() int {
+	var rides int
+	for i := range r.Sections {
+		if r.Sections[i].IsTransit() {
+			rides++
+		}
+	}
+	if rides == 0 {
+		return 0
+	}
+	return rides - 1
+}
+
+// Routes returns public transit routes between origin and destination.
+// See https://developer.here.com/documentation/public-transit/dev_guide/routing/index.html
+// for details about other parameters.
+func (s *TransitService) Routes(ctx context.Context, req *RoutesRequest) (_ *RoutesResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("transit routes: %w", err)
+		}
+	}()
+	query, err := req.QueryString()
+	if err != nil {
+		return nil, err
+	}
+	u, err := s.URL.Parse("routes")
+	if err != nil {
+		return nil, err
+	}
+	r, err := s.Client.NewRequest(ctx, u, http.MethodGet, query)
+	if err != nil {
+		return nil, err
+	}
+	var resp RoutesResponse
+	if err := s.Client.Do(r, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
@@ -0,0 +1,226 @@
+package transitv8_test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"go.einride.tech/here/routingv8"
+	"go.einride.tech/here/transitv8"
+	"gotest.tools/v3/assert"
+)
+
+type httpClientFunc func(*http.Request) (*http.Response, error)
+
+func (f httpClientFunc) Do(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+const routesResponse = `{
+	"routes": [
+		{
+			"id": "R1",
+			"sections": [
+				{
+					"id": "R1-S1",
+					"type": "pedestrian",
+					"departure": {
+						"time": "2023-01-01T12:00:00+01:00",
+						"place": {
+							"type": "place",
+							"location": {
+								"lat": 52.53,
+								"lng": 13.38
+							}
+						}
+					},
+					"arrival": {
+						"time": "2023-01-01T12:05:00+01:00",
+						"place": {
+							"name": "Nordbahnhof",
+							"type": "station",
+							"location": {
+								"lat": 52.531,
+								"lng": 13.388
+							}
+						}
+					},
+					"transport": {
+						"mode": "pedestrian"
+					}
+				},
+				{
+					"id": "R1-S2",
+					"type": "transit",
+					"departure": {
+						"time": "2023-01-01T12:06:00+01:00",
+						"delay": 60,
+						"place": {
+							"name": "Nordbahnhof",
+							"type": "station",
+							"location": {
+								"lat": 52.531,
+								"lng": 13.388
+							},
+							"platform": "2"
+						}
+					},
+					"arrival": {
+						"time": "2023-01-01T12:15:00+01:00",
+						"place": {
+							"name": "Potsdamer Platz",
+							"type": "station",
+							"location": {
+								"lat": 52.509,
+								"lng": 13.376
+							}
+						}
+					},
+					"transport": {
+						"mode": "cityTrain",
+						"name": "S1",
+						"headsign": "Wannsee",
+						"color": "#DE4DA4"
+					},
+					"agency": {
+						"id": "a1",
+						"name": "S-Bahn Berlin GmbH"
+					},
+					"intermediateStops": [
+						{
+							"departure": {
+								"time": "2023-01-01T12:09:00+01:00",
+								"place": {
+									"name": "Friedrichstraße",
+									"type": "station",
+									"location": {
+										"lat": 52.52,
+										"lng": 13.387
+									}
+								}
+							}
+						},
+						{
+							"departure": {
+								"time": "2023-01-01T12:11:00+01:00",
+								"place": {
+									"name": "Brandenburger Tor",
+									"type": "station",
+									"location": {
+										"lat": 52.516,
+										"lng": 13.381
+									}
+								}
+							}
+						}
+					]
+				}
+			]
+		}
+	]
+}`
+
+func TestTransitService_Routes(t *testing.T) {
+	t.Parallel()
+	var requested *url.URL
+	client := transitv8.NewClient(httpClientFunc(func(req *http.Request) (*http.Response, error) {
+		requested = req.URL
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(routesResponse))}, nil
+	}))
+	maxChanges := 2
+	resp, err := client.Transit.Routes(context.Background(), &transitv8.RoutesRequest{
+		Origin:        transitv8.GeoWaypoint{Lat: 52.53, Long: 13.38},
+		Destination:   transitv8.GeoWaypoint{Lat: 52.509, Long: 13.376},
+		DepartureTime: time.Date(2023, 1, 1, 12, 0, 0, 0, time.UTC),
+		MaxChanges:    &maxChanges,
+		ExcludedModes: []string{transitv8.ModeBus, transitv8.ModeFerry},
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, "/v8/routes", requested.Path)
+	assert.DeepEqual(t, url.Values{
+		"origin":        {"52.53,13.38"},
+		"destination":   {"52.509,13.376"},
+		"departureTime": {"2023-01-01T12:00:00Z"},
+		"changes":       {"2"},
+		"modes":         {"-bus,-ferry"},
+		"return":        {"travelSummary,intermediate"},
+	}, requested.Query())
+	route := resp.Routes[0]
+	assert.Equal(t, 0, route.Changes())
+	access, ride := route.Sections[0], route.Sections[1]
+	assert.Assert(t, access.IsPedestrian())
+	assert.Equal(t, "Nordbahnhof", access.Arrival.Place.Name)
+	assert.Assert(t, ride.IsTransit())
+	assert.Equal(t, "S1", ride.Transport.Name)
+	assert.Equal(t, "Wannsee", ride.Transport.Headsign)
+	assert.Equal(t, "S-Bahn Berlin GmbH", ride.Agency.Name)
+	assert.Equal(t, "2", ride.Departure.Place.Platform)
+	assert.Equal(t, 60, ride.Departure.Delay)
+	assert.Equal(t, 2, len(ride.IntermediateStops))
+	assert.Equal(t, "Brandenburger Tor", ride.IntermediateStops[1].Departure.Place.Name)
+}
+
+func TestTransitService_Routes_Retry(t *testing.T) {
+	t.Parallel()
+	var attempts int
+	client := transitv8.NewClient(httpClientFunc(func(req *http.Request) (*http.Response, error) {
+		attempts++
+		return &http.Response{
+			StatusCode: http.StatusServiceUnavailable,
+			ProtoMajor: 1,
+			ProtoMinor: 1,
+			Body:       io.NopCloser(strings.NewReader(`{"title":"Service unavailable","status":503}`)),
+		}, nil
+	}))
+	client.RetryPolicy = &routingv8.RetryPolicy{MaxAttempts: 2, Backoff: time.Nanosecond}
+	var dump bytes.Buffer
+	ctx := routingv8.WithDebugDump(context.Background(), &dump)
+	_, err := client.Transit.Routes(ctx, &transitv8.RoutesRequest{
+		Origin:      transitv8.GeoWaypoint{Lat: 52.53, Long: 13.38},
+		Destination: transitv8.GeoWaypoint{Lat: 52.509, Long: 13.376},
+	})
+	assert.ErrorContains(t, err, "failed after 2 attempts")
+	assert.ErrorContains(t, err, "Title: Service unavailable, Status: 503")
+	assert.Equal(t, 2, attempts)
+	assert.Assert(t, routingv8.IsRetryable(err))
+	var response *transitv8.HereErrorResponse
+	assert.Assert(t, errors.As(err, &response))
+	assert.Equal(t, 2, strings.Count(dump.String(), "GET /v8/routes?"))
+}
+
+func TestRoutesRequest_QueryString(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	for _, tt := range []struct {
+		name    string
+		request transitv8.RoutesRequest
+		err     string
+	}{
+		{
+			name:    "departure and arrival",
+			request: transitv8.RoutesRequest{DepartureTime: now, ArrivalTime: now},
+			err:     "invalid time: both departure and arrival time set",
+		},
+		{
+			name: "modes and excluded modes",
+			request: transitv8.RoutesRequest{
+				Modes:         []string{transitv8.ModeSubway},
+				ExcludedModes: []string{transitv8.ModeBus},
+			},
+			err: "invalid modes: both modes and excluded modes set",
+		},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			_, err := tt.request.QueryString()
+			assert.Error(t, err, tt.err)
+		})
+	}
+}
@@ -0,0 +1,106 @@
+package transitv8
+
+import (
+	"strconv"
+	"time"
+)
+
+type GeoWaypoint struct {
+	Lat  float64 `json:"lat"`
+	Long float64 `json:"lng"`
+}
+
+func (w GeoWaypoint) String() string {
+	return strconv.FormatFloat(w.Lat, 'f', -1, 64) + "," + strconv.FormatFloat(w.Long, 'f', -1, 64)
+}
+
+// Transit modes of transport.
+const (
+	ModeHighSpeedTrain     = "highSpeedTrain"
+	ModeIntercityTrain     = "intercityTrain"
+	ModeInterRegionalTrain = "interRegionalTrain"
+	ModeRegionalTrain      = "regionalTrain"
+	ModeCityTrain          = "cityTrain"
+	ModeBus                = "bus"
+	ModeFerry              = "ferry"
+	ModeSubway             = "subway"
+	ModeLightRail          = "lightRail"
+	ModePrivateBus         = "privateBus"
+	ModeInclined           = "inclined"
+	ModeAerial             = "aerial"
+	ModeBusRapid           = "busRapid"
+	ModeMonorail           = "monorail"
+	ModeFlight             = "flight"
+)
+
+// Section types.
+const (
+	// SectionTypePedestrian is the type of walking sections, such as access to and egress from stations.
+	SectionTypePedestrian = "pedestrian"
+	// SectionTypeTransit is the type of sections on a transit line.
+	SectionTypeTransit = "transit"
+)
+
+// Place is a location of a departure or arrival, such as a station or a stop.
+type Place struct {
+	// Name of the station or stop.
+	Name string `json:"name,omitempty"`
+	// Type of the place, e.g. "place", "station" or "accessPoint".
+	Type string `json:"type"`
+	// Location of the place.
+	Location GeoWaypoint `json:"location"`
+	// ID of the station.
+	ID string `json:"id,omitempty"`
+	// Platform of the departure or arrival at the station, if known.
+	Platform string `json:"platform,omitempty"`
+}
+
+// SectionPlace is the departure or arrival of a section.
+type SectionPlace struct {
+	Time  time.Time `json:"time"`
+	Place Place     `json:"place"`
+	// Delay of the time in seconds from the timetable, if real-time information is available.
+	Delay int `json:"delay,omitempty"`
+}
+
+// Transport describes the means of transport of a section.
+type Transport struct {
+	// Mode of transport, SectionTypePedestrian or one of the transit modes, e.g. ModeBus.
+	Mode string `json:"mode"`
+	// Name of the line.
+	Name string `json:"name,omitempty"`
+	// Category of the line, e.g. "Bus" or "Regional train".
+	Category string `json:"category,omitempty"`
+	// Headsign is the destination displayed on the vehicle.
+	Headsign string `json:"headsign,omitempty"`
+	// ShortName of the line, e.g. "S1".
+	ShortName string `json:"shortName,omitempty"`
+	// LongName of the line.
+	LongName string `json:"longName,omitempty"`
+	// Color of the line, e.g. "#FF0000".
+	Color string `json:"color,omitempty"`
+	// TextColor for text on the color of the line.
+	TextColor string `json:"textColor,omitempty"`
+}
+
+// Agency operating a transit line.
+type Agency struct {
+	ID      string `json:"id"`
+	Name    string `json:"name"`
+	Website string `json:"website,omitempty"`
+}
+
+// IntermediateStop is a stop of a transit vehicle between boarding and alighting.
+type IntermediateStop struct {
+	Departure SectionPlace `json:"departure"`
+	// Duration of the ride from the previous stop in seconds.
+	Duration int `json:"duration,omitempty"`
+}
+
+// TravelSummary of a section.
+type TravelSummary struct {
+	// Duration in seconds.
+	Duration int `json:"duration"`
+	// Length in meters.
+	Length int `json:"length"`
+}
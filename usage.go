@@ -0,0 +1,206 @@
+package here
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"go.einride.tech/here/routingv8"
+)
+
+type usageTagContextKey struct{}
+
+// WithUsageTag returns a context which attributes the requests sent with it to the tag in a UsageRecorder, e.g.
+// the team or customer to charge for the requests.
+func WithUsageTag(ctx context.Context, tag string) context.Context {
+	return context.WithValue(ctx, usageTagContextKey{}, tag)
+}
+
+// UsageTagFromContext returns the usage tag of the context, empty if not set.
+func UsageTagFromContext(ctx context.Context) string {
+	tag, _ := ctx.Value(usageTagContextKey{}).(string)
+	return tag
+}
+
+// UsageRecord counts the requests of a tag to a service.
+type UsageRecord struct {
+	// Tag of the requests, empty for requests without a tag.
+	Tag string `json:"tag"`
+	// Service is the host of the requests, e.g. "router.hereapi.com".
+	Service string `json:"service"`
+	// Requests is the number of requests sent, including failed requests.
+	Requests int64 `json:"requests"`
+	// Failures is the number of requests without a response or with an error status.
+	Failures int64 `json:"failures"`
+}
+
+// UsageReport is the usage of a period. It is exported as a JSON object, for example:
+//
+//	{
+//	  "start": "2022-06-01T10:00:00Z",
+//	  "end": "2022-06-01T10:01:00Z",
+//	  "records": [
+//	    {"tag": "team-a", "service": "router.hereapi.com", "requests": 120, "failures": 2}
+//	  ]
+//	}
+//
+// Records are sorted by tag and service, periods without requests have no records.
+type UsageReport struct {
+	Start   time.Time     `json:"start"`
+	End     time.Time     `json:"end"`
+	Records []UsageRecord `json:"records"`
+}
+
+type usageKey struct {
+	tag     string
+	service string
+}
+
+// UsageRecorder counts the requests sent through it by usage tag and service.
+type UsageRecorder struct {
+	next http.RoundTripper
+
+	// TagScrubber is applied to the usage tags before they are recorded, e.g. to remove personal data from tags
+	// used as metric labels.
+	TagScrubber Scrubber
+	// Clock used for the periods of the reports. Defaults to routingv8.SystemClock.
+	Clock routingv8.Clock
+
+	mu     sync.Mutex
+	start  time.Time
+	counts map[usageKey]*UsageRecord
+}
+
+var _ http.RoundTripper = &UsageRecorder{}
+
+// NewUsageRecorder returns a round tripper which records the usage of the requests sent through it.
+// If next is nil http.DefaultTransport is used.
+func NewUsageRecorder(next http.RoundTripper) *UsageRecorder {
+	return &UsageRecorder{next: next, counts: make(map[usageKey]*UsageRecord)}
+}
+
+func (u *UsageRecorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	next := u.next
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	resp, err := next.RoundTrip(req)
+	tag := UsageTagFromContext(req.Context())
+	if u.TagScrubber != nil {
+		tag = u.TagScrubber(tag)
+	}
+	u.record(tag, req.URL.Host, err != nil || resp.StatusCode >= 400)
+	return resp, err
+}
+
+func (u *UsageRecorder) record(tag, service string, failed bool) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	if u.start.IsZero() {
+		u.start = clockOrSystem(u.Clock).Now()
+	}
+	key := usageKey{tag: tag, service: service}
+	record, ok := u.counts[key]
+	if !ok {
+		record = &UsageRecord{Tag: tag, Service: service}
+		u.counts[key] = record
+	}
+	record.Requests++
+	if failed {
+		record.Failures++
+	}
+}
+
+// Flush returns the usage since the previous flush and resets the counters. The period of the first report starts
+// at the first recorded request.
+func (u *UsageRecorder) Flush() *UsageReport {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	now := clockOrSystem(u.Clock).Now()
+	start := u.start
+	if start.IsZero() {
+		start = now
+	}
+	report := &UsageReport{Start: start, End: now, Records: make([]UsageRecord, 0, len(u.counts))}
+	for _, record := range u.counts {
+		report.Records = append(report.Records, *record)
+	}
+	sort.Slice(report.Records, func(i, j int) bool {
+		if report.Records[i].Tag != report.Records[j].Tag {
+			return report.Records[i].Tag < report.Records[j].Tag
+		}
+		return report.Records[i].Service < report.Records[j].Service
+	})
+	u.start = now
+	u.counts = make(map[usageKey]*UsageRecord)
+	return report
+}
+
+// UsageSink receives the exported usage reports.
+type UsageSink interface {
+	ExportUsage(ctx context.Context, report *UsageReport) error
+}
+
+// UsageSinkFunc adapts a function to the UsageSink interface.
+type UsageSinkFunc func(ctx context.Context, report *UsageReport) error
+
+// ExportUsage calls f(ctx, report).
+func (f UsageSinkFunc) ExportUsage(ctx context.Context, report *UsageReport) error {
+	return f(ctx, report)
+}
+
+// NewJSONUsageSink returns a UsageSink writing every report as a line of JSON to w.
+func NewJSONUsageSink(w io.Writer) UsageSink {
+	var mu sync.Mutex
+	encoder := json.NewEncoder(w)
+	return UsageSinkFunc(func(_ context.Context, report *UsageReport) error {
+		mu.Lock()
+		defer mu.Unlock()
+		return encoder.Encode(report)
+	})
+}
+
+// UsageExporter periodically flushes a UsageRecorder to a UsageSink.
+type UsageExporter struct {
+	// Recorder to flush.
+	Recorder *UsageRecorder
+	// Sink to export the reports to.
+	Sink UsageSink
+	// Interval between flushes. Defaults to one minute.
+	Interval time.Duration
+	// Clock used for the interval. Defaults to routingv8.SystemClock.
+	Clock routingv8.Clock
+}
+
+// Run exports the usage every interval until the context is done, and then exports the remaining usage.
+// Run returns the first error of the sink.
+func (e *UsageExporter) Run(ctx context.Context) error {
+	interval := e.Interval
+	if interval <= 0 {
+		interval = time.Minute
+	}
+	clock := clockOrSystem(e.Clock)
+	for {
+		timer := clock.NewTimer(interval)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return e.Sink.ExportUsage(context.Background(), e.Recorder.Flush())
+		case <-timer.C():
+			if err := e.Sink.ExportUsage(ctx, e.Recorder.Flush()); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func clockOrSystem(clock routingv8.Clock) routingv8.Clock {
+	if clock == nil {
+		return routingv8.SystemClock{}
+	}
+	return clock
+}
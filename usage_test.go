@@ -0,0 +1,124 @@
+package here_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"go.einride.tech/here"
+	"go.einride.tech/here/routingv8"
+	"gotest.tools/v3/assert"
+)
+
+func TestUsageRecorder(t *testing.T) {
+	t.Parallel()
+	recorder := here.NewUsageRecorder(roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		switch req.URL.Path {
+		case "/fail":
+			return nil, errors.New("connection reset")
+		case "/bad":
+			return &http.Response{StatusCode: http.StatusBadRequest, Body: http.NoBody}, nil
+		default:
+			return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(`{}`))}, nil
+		}
+	}))
+	client := &http.Client{Transport: recorder}
+	get := func(ctx context.Context, url string) {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+		assert.NilError(t, err)
+		if resp, err := client.Do(req); err == nil {
+			_ = resp.Body.Close()
+		}
+	}
+	teamA := here.WithUsageTag(context.Background(), "team-a")
+	assert.Equal(t, "team-a", here.UsageTagFromContext(teamA))
+	get(teamA, "https://router.hereapi.com/v8/routes")
+	get(teamA, "https://router.hereapi.com/bad")
+	get(teamA, "https://revgeocode.search.hereapi.com/v1/revgeocode")
+	get(context.Background(), "https://router.hereapi.com/fail")
+
+	var b bytes.Buffer
+	exporter := here.UsageExporter{Recorder: recorder, Sink: here.NewJSONUsageSink(&b)}
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	assert.NilError(t, exporter.Run(ctx))
+	var report here.UsageReport
+	assert.NilError(t, json.Unmarshal(b.Bytes(), &report))
+	assert.DeepEqual(t, []here.UsageRecord{
+		{Tag: "", Service: "router.hereapi.com", Requests: 1, Failures: 1},
+		{Tag: "team-a", Service: "revgeocode.search.hereapi.com", Requests: 1},
+		{Tag: "team-a", Service: "router.hereapi.com", Requests: 2, Failures: 1},
+	}, report.Records)
+	assert.Assert(t, !report.End.Before(report.Start))
+	assert.Equal(t, 0, len(recorder.Flush().Records))
+}
+
+func TestUsageExporter_Clock(t *testing.T) {
+	t.Parallel()
+	start := time.Date(2022, 6, 1, 10, 0, 0, 0, time.UTC)
+	clock := &firingClock{now: start}
+	recorder := here.NewUsageRecorder(roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody}, nil
+	}))
+	recorder.Clock = clock
+	req, err := http.NewRequest(http.MethodGet, "https://router.hereapi.com/v8/routes", nil)
+	assert.NilError(t, err)
+	_, err = recorder.RoundTrip(req)
+	assert.NilError(t, err)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var reports []*here.UsageReport
+	exporter := here.UsageExporter{
+		Recorder: recorder,
+		Clock:    clock,
+		Sink: here.UsageSinkFunc(func(_ context.Context, report *here.UsageReport) error {
+			reports = append(reports, report)
+			if len(reports) == 2 {
+				cancel()
+			}
+			return nil
+		}),
+	}
+	assert.NilError(t, exporter.Run(ctx))
+	assert.Assert(t, len(reports) >= 2)
+	assert.Equal(t, start, reports[0].Start)
+	assert.Equal(t, start.Add(time.Minute), reports[0].End)
+	assert.Equal(t, int64(1), reports[0].Records[0].Requests)
+	assert.Equal(t, start.Add(time.Minute), reports[1].Start)
+	assert.Equal(t, start.Add(2*time.Minute), reports[1].End)
+	assert.Equal(t, 0, len(reports[1].Records))
+}
+
+// firingClock is a routingv8.Clock whose timers fire immediately, advancing the time by their duration.
+type firingClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *firingClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *firingClock) NewTimer(d time.Duration) routingv8.Timer {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+	fired := make(firedTimer, 1)
+	fired <- c.now
+	return fired
+}
+
+// firedTimer is a routingv8.Timer which has already fired.
+type firedTimer chan time.Time
+
+func (t firedTimer) C() <-chan time.Time { return t }
+func (t firedTimer) Stop() bool          { return false }
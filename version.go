@@ -0,0 +1,203 @@
+package here
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+
+	"go.einride.tech/here/internal/hereapi"
+)
+
+// Service is a HERE API service accessed through a Client.
+type Service string
+
+const (
+	ServiceRouting          Service = "routing"
+	ServiceMatrix           Service = "matrix"
+	ServiceSearch           Service = "search"
+	ServiceParking          Service = "parking"
+	ServiceIsoline          Service = "isoline"
+	ServiceTraffic          Service = "traffic"
+	ServiceTransit          Service = "transit"
+	ServiceIntermodal       Service = "intermodal"
+	ServiceWaypointSequence Service = "waypointSequence"
+	ServiceGeofencing       Service = "geofencing"
+	ServiceRouteMatching    Service = "routeMatching"
+	ServiceMapImage         Service = "mapImage"
+	ServiceRasterTile       Service = "rasterTile"
+	ServiceVectorTile       Service = "vectorTile"
+)
+
+// serviceHosts are the hosts of the services. The endpoints of the search service are subdomains of its host.
+var serviceHosts = map[Service]string{
+	ServiceRouting:          "router.hereapi.com",
+	ServiceMatrix:           "matrix.router.hereapi.com",
+	ServiceSearch:           "search.hereapi.com",
+	ServiceParking:          "parking-v2.cc.api.here.com",
+	ServiceIsoline:          "isoline.router.hereapi.com",
+	ServiceTraffic:          "data.traffic.hereapi.com",
+	ServiceTransit:          "transit.router.hereapi.com",
+	ServiceIntermodal:       "intermodal.router.hereapi.com",
+	ServiceWaypointSequence: "wps.hereapi.com",
+	ServiceGeofencing:       "fleet.ls.hereapi.com",
+	ServiceRouteMatching:    "routematching.hereapi.com",
+	ServiceMapImage:         "image.maps.hereapi.com",
+	ServiceRasterTile:       "maps.hereapi.com",
+	ServiceVectorTile:       "vector.hereapi.com",
+}
+
+// apiVersions are the version path segments of the services implemented by the API clients. The parking and
+// geofencing services have no version path segment of the form "v8", and their versions can not be changed.
+var apiVersions = map[Service]string{
+	ServiceRouting:          "v8",
+	ServiceMatrix:           "v8",
+	ServiceSearch:           "v1",
+	ServiceIsoline:          "v8",
+	ServiceTraffic:          "v7",
+	ServiceTransit:          "v8",
+	ServiceIntermodal:       "v8",
+	ServiceWaypointSequence: "v8",
+	ServiceRouteMatching:    "v8",
+	ServiceMapImage:         "v3",
+	ServiceRasterTile:       "v3",
+	ServiceVectorTile:       "v2",
+}
+
+// APIVersion returns the version path segment implemented by the API client of the service, e.g. "v8", and false
+// for services without a version path segment.
+func APIVersion(service Service) (string, bool) {
+	version, ok := apiVersions[service]
+	return version, ok
+}
+
+// serviceOf returns the service of a host, preferring an exact match over the longest matching parent domain.
+func serviceOf(host string) (Service, bool) {
+	var match Service
+	var matchHost string
+	for service, serviceHost := range serviceHosts {
+		if host == serviceHost {
+			return service, true
+		}
+		if strings.HasSuffix(host, "."+serviceHost) && len(serviceHost) > len(matchHost) {
+			match, matchHost = service, serviceHost
+		}
+	}
+	return match, matchHost != ""
+}
+
+// SetAPIVersion overrides the version path segment of the service, e.g. to use "v7" for a service while the API
+// client is not yet migrated, or to try a new version without forking the call sites. Combine with compatibility
+// shims for versions which differ in their parameters, see AddCompatibilityShim.
+// The version must be set before the first use of the service.
+func (c *Client) SetAPIVersion(service Service, version string) error {
+	if _, ok := apiVersions[service]; !ok {
+		return fmt.Errorf("set API version: service %s has no version path segment", service)
+	}
+	if !strings.HasPrefix(version, "v") {
+		return fmt.Errorf("set API version: invalid version %q", version)
+	}
+	if _, err := strconv.Atoi(version[1:]); err != nil {
+		return fmt.Errorf("set API version: invalid version %q", version)
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.versions == nil {
+		c.versions = make(map[Service]string)
+	}
+	c.versions[service] = version
+	return nil
+}
+
+// apiVersion returns the version of the service used by the client.
+func (c *Client) apiVersion(service Service) string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if version, ok := c.versions[service]; ok {
+		return version
+	}
+	return apiVersions[service]
+}
+
+// versionURL sets the version path segment of the service URL u to the version of the service used by the client.
+func (c *Client) versionURL(service Service, u *url.URL) *url.URL {
+	if _, ok := apiVersions[service]; !ok {
+		return u
+	}
+	versioned := *u
+	versioned.Path = path.Join(path.Dir(strings.TrimSuffix(u.Path, "/")), c.apiVersion(service)) + "/"
+	return &versioned
+}
+
+// CompatibilityShim adapts the requests to a service, e.g. to keep call sites unchanged when a query parameter is
+// renamed between API versions.
+type CompatibilityShim struct {
+	// Service to adapt the requests to.
+	Service Service
+	// Version of the service the shim applies to, see SetAPIVersion. The shim applies to all versions when empty.
+	Version string
+	// RenameParameters renames query parameters, from the old to the new name.
+	RenameParameters map[string]string
+	// RemoveParameters removes query parameters not supported by the version.
+	RemoveParameters []string
+	// Rewrite is called last with the request, for adaptations beyond query parameters. Optional.
+	Rewrite func(req *http.Request)
+}
+
+func (s *CompatibilityShim) apply(req *http.Request) {
+	if len(s.RenameParameters) > 0 || len(s.RemoveParameters) > 0 {
+		query := req.URL.Query()
+		for from, to := range s.RenameParameters {
+			if values, ok := query[from]; ok {
+				delete(query, from)
+				query[to] = append(query[to], values...)
+			}
+		}
+		for _, name := range s.RemoveParameters {
+			query.Del(name)
+		}
+		req.URL.RawQuery = query.Encode()
+	}
+	if s.Rewrite != nil {
+		s.Rewrite(req)
+	}
+}
+
+// AddCompatibilityShim adds a shim applied to the requests of its service, in the order the shims are added.
+func (c *Client) AddCompatibilityShim(shim CompatibilityShim) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.shims = append(c.shims, shim)
+}
+
+// shimClient applies the platform headers and the compatibility shims of a Client to the requests sent through it.
+type shimClient struct {
+	c *Client
+}
+
+func (s shimClient) Do(req *http.Request) (*http.Response, error) {
+	if s.c.ProjectHRN != "" || s.c.Realm != "" {
+		req = req.Clone(req.Context())
+		hereapi.SetPlatformHeaders(req, s.c.ProjectHRN, s.c.Realm)
+	}
+	s.c.mu.Lock()
+	shims := s.c.shims
+	s.c.mu.Unlock()
+	if len(shims) == 0 {
+		return s.c.client.Do(req)
+	}
+	service, ok := serviceOf(req.URL.Host)
+	if !ok {
+		return s.c.client.Do(req)
+	}
+	version := s.c.apiVersion(service)
+	req = req.Clone(req.Context())
+	for i := range shims {
+		if shims[i].Service == service && (shims[i].Version == "" || shims[i].Version == version) {
+			shims[i].apply(req)
+		}
+	}
+	return s.c.client.Do(req)
+}
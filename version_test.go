@@ -0,0 +1,88 @@
+package here_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"go.einride.tech/here"
+	"go.einride.tech/here/geocodingv7"
+	"go.einride.tech/here/isolinev8"
+	"go.einride.tech/here/mapimage"
+	"go.einride.tech/here/maptile"
+	"go.einride.tech/here/routematching"
+	"go.einride.tech/here/routingv8"
+	"go.einride.tech/here/trafficv7"
+	"go.einride.tech/here/transitv8"
+	"gotest.tools/v3/assert"
+)
+
+func TestAPIVersion(t *testing.T) {
+	t.Parallel()
+	client := here.NewClient(nil)
+	for _, tt := range []struct {
+		service here.Service
+		url     *url.URL
+	}{
+		{service: here.ServiceRouting, url: routingv8.NewClient(nil).Routing.URL},
+		{service: here.ServiceMatrix, url: routingv8.NewClient(nil).Matrix.URL},
+		{service: here.ServiceSearch, url: geocodingv7.NewClient(nil).Search.URL},
+		{service: here.ServiceIsoline, url: isolinev8.NewClient(nil).Isoline.URL},
+		{service: here.ServiceTraffic, url: trafficv7.NewClient(nil).Traffic.URL},
+		{service: here.ServiceTransit, url: transitv8.NewClient(nil).Transit.URL},
+		{service: here.ServiceRouteMatching, url: routematching.NewClient(nil).Matching.URL},
+		{service: here.ServiceMapImage, url: mapimage.NewClient(nil).MapImage.URL},
+		{service: here.ServiceRasterTile, url: maptile.NewClient(nil).Raster.URL},
+		{service: here.ServiceVectorTile, url: maptile.NewClient(nil).Vector.URL},
+	} {
+		version, ok := here.APIVersion(tt.service)
+		assert.Assert(t, ok)
+		assert.Assert(t, strings.HasSuffix(tt.url.Path, "/"+version+"/"), "%s: %s", tt.service, tt.url)
+	}
+	assert.Equal(t, "https://router.hereapi.com/v8/", client.Routing().URL.String())
+	assert.Equal(t, "https://image.maps.hereapi.com/mia/v3/", client.MapImage().URL.String())
+	_, ok := here.APIVersion(here.ServiceParking)
+	assert.Assert(t, !ok)
+}
+
+func TestClient_SetAPIVersion(t *testing.T) {
+	t.Parallel()
+	var requested []string
+	var shimHeaders []string
+	client := here.NewClient(httpClientFunc(func(req *http.Request) (*http.Response, error) {
+		requested = append(requested, req.URL.Host+req.URL.Path+"?"+req.URL.RawQuery)
+		shimHeaders = append(shimHeaders, req.Header.Get("X-Shim"))
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(`{}`))}, nil
+	}))
+	assert.NilError(t, client.SetAPIVersion(here.ServiceIsoline, "v9"))
+	assert.ErrorContains(t, client.SetAPIVersion(here.ServiceParking, "v3"), "has no version path segment")
+	assert.ErrorContains(t, client.SetAPIVersion(here.ServiceRouting, "8"), `invalid version "8"`)
+	client.AddCompatibilityShim(here.CompatibilityShim{
+		Service:          here.ServiceIsoline,
+		Version:          "v9",
+		RenameParameters: map[string]string{"range[type]": "rangeType"},
+		RemoveParameters: []string{"range[values]"},
+	})
+	client.AddCompatibilityShim(here.CompatibilityShim{
+		Service: here.ServiceRouting,
+		Rewrite: func(req *http.Request) { req.Header.Set("X-Shim", "routing") },
+	})
+	_, err := client.Isoline().Isolines(context.Background(), &isolinev8.IsolinesRequest{
+		Origin:        &isolinev8.GeoWaypoint{Lat: 1, Long: 2},
+		TransportMode: isolinev8.TransportModeCar,
+		Range:         isolinev8.Range{Type: isolinev8.RangeTypeTime, Values: []int{300}},
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, 1, len(requested))
+	assert.Assert(t, strings.HasPrefix(requested[0], "isoline.router.hereapi.com/v9/isolines?"))
+	assert.Assert(t, strings.Contains(requested[0], "rangeType=time"))
+	assert.Assert(t, !strings.Contains(requested[0], "range%5B"))
+	_, err = client.Routing().Routes(context.Background(), &routingv8.RoutesRequest{
+		TransportMode: routingv8.TransportModeCar,
+	})
+	assert.NilError(t, err)
+	assert.DeepEqual(t, []string{"", "routing"}, shimHeaders)
+}
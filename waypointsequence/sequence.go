@@ -0,0 +1,210 @@
+package waypointsequence
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// MaxDestinations is the maximum number of destinations of a sequence.
+const MaxDestinations = 120
+
+type FindSequenceRequest struct {
+	// Start of the sequence.
+	Start Waypoint
+	// End of the sequence. The sequence ends at the last destination when nil.
+	End *Waypoint
+	// Destinations to visit between start and end, in any order, up to MaxDestinations.
+	Destinations []Waypoint
+	// TransportMode of the vehicle. Defaults to TransportModeCar.
+	TransportMode TransportMode
+	// Traffic enables traffic when calculating the travel times between the waypoints.
+	Traffic bool
+	// DepartureTime from the start. Defaults to now.
+	DepartureTime time.Time
+	// ImproveFor is the objective of the optimization. Defaults to ImproveForTime.
+	ImproveFor ImproveFor
+}
+
+func (r *FindSequenceRequest) QueryString() (string, error) {
+	if len(r.Destinations) == 0 {
+		return "", fmt.Errorf("invalid destinations: at least one destination required")
+	}
+	if len(r.Destinations) > MaxDestinations {
+		return "", fmt.Errorf(
+			"invalid destinations: at most %d destinations allowed, got %d", MaxDestinations, len(r.Destinations),
+		)
+	}
+	ids := make(map[string]struct{}, len(r.Destinations)+2)
+	values := make(url.Values)
+	add := func(key string, w Waypoint) error {
+		if w.ID == "" {
+			return fmt.Errorf("invalid %s: missing id", key)
+		}
+		if _, ok := ids[w.ID]; ok {
+			return fmt.Errorf("invalid %s: duplicate id %q", key, w.ID)
+		}
+		ids[w.ID] = struct{}{}
+		if w.TimeWindow != nil && !w.TimeWindow.End.After(w.TimeWindow.Start) {
+			return fmt.Errorf("invalid %s: time window of %q ends before it starts", key, w.ID)
+		}
+		values.Add(key, w.String())
+		return nil
+	}
+	if err := add("start", r.Start); err != nil {
+		return "", err
+	}
+	for i, destination := range r.Destinations {
+		if err := add("destination"+strconv.Itoa(i+1), destination); err != nil {
+			return "", err
+		}
+	}
+	if r.End != nil {
+		if err := add("end", *r.End); err != nil {
+			return "", err
+		}
+	}
+	transportMode := TransportModeCar
+	if r.TransportMode != TransportModeUnspecified {
+		transportMode = r.TransportMode
+	}
+	if transportMode.String() == invalid {
+		return "", fmt.Errorf("invalid transport mode: %d", int(r.TransportMode))
+	}
+	traffic := "disabled"
+	if r.Traffic {
+		traffic = "enabled"
+	}
+	values.Add("mode", "fastest;"+transportMode.String()+";traffic:"+traffic)
+	if !r.DepartureTime.IsZero() {
+		values.Add("departure", r.DepartureTime.Format(time.RFC3339))
+	}
+	switch improveFor := r.ImproveFor.String(); improveFor {
+	case unspecified:
+	case invalid:
+		return "", fmt.Errorf("invalid improve for: %d", int(r.ImproveFor))
+	default:
+		values.Add("improveFor", improveFor)
+	}
+	return values.Encode(), nil
+}
+
+// FindSequenceResponse contains the optimized sequences of the waypoints.
+type FindSequenceResponse struct {
+	Results      []Sequence `json:"results"`
+	Errors       []string   `json:"errors"`
+	ResponseCode string     `json:"responseCode"`
+	RequestID    string     `json:"requestId"`
+}
+
+// Sequence is an order of visiting the waypoints.
+type Sequence struct {
+	// Waypoints in visiting order.
+	Waypoints []SequencedWaypoint `json:"waypoints"`
+	// Distance of the sequence in meters.
+	Distance json.Number `json:"distance"`
+	// Time of the sequence in seconds.
+	Time json.Number `json:"time"`
+	// Interconnections are the legs between consecutive waypoints.
+	Interconnections []Interconnection `json:"interconnections"`
+	Description      string            `json:"description"`
+	TimeBreakdown    TimeBreakdown     `json:"timeBreakdown"`
+}
+
+// Order returns the IDs of the waypoints in visiting order.
+func (s *Sequence) Order() []string {
+	waypoints := make([]SequencedWaypoint, len(s.Waypoints))
+	copy(waypoints, s.Waypoints)
+	sort.SliceStable(waypoints, func(i, j int) bool {
+		return waypoints[i].Sequence < waypoints[j].Sequence
+	})
+	ids := make([]string, 0, len(waypoints))
+	for _, waypoint := range waypoints {
+		ids = append(ids, waypoint.ID)
+	}
+	return ids
+}
+
+// SequencedWaypoint is a waypoint with its position in a sequence.
+type SequencedWaypoint struct {
+	ID   string  `json:"id"`
+	Lat  float64 `json:"lat"`
+	Long float64 `json:"lng"`
+	// Sequence is the position of the waypoint in the sequence, starting at 0.
+	Sequence int `json:"sequence"`
+	// EstimatedArrival at the waypoint, nil for the start.
+	EstimatedArrival *time.Time `json:"estimatedArrival"`
+	// EstimatedDeparture from the waypoint, nil for the end.
+	EstimatedDeparture *time.Time `json:"estimatedDeparture"`
+	// FulfilledConstraints of the waypoint, e.g. "acc:mo08:00:00+02:00|mo18:00:00+02:00".
+	FulfilledConstraints []string `json:"fulfilledConstraints"`
+}
+
+// Interconnection is the leg between two consecutive waypoints of a sequence.
+type Interconnection struct {
+	FromWaypoint string `json:"fromWaypoint"`
+	ToWaypoint   string `json:"toWaypoint"`
+	// Distance in meters.
+	Distance float64 `json:"distance"`
+	// Time in seconds, including rest and waiting.
+	Time float64 `json:"time"`
+	// Rest time in seconds.
+	Rest float64 `json:"rest"`
+	// Waiting time in seconds, e.g. for a time window to open.
+	Waiting float64 `json:"waiting"`
+}
+
+// Duration returns the time of the leg.
+func (i Interconnection) Duration() time.Duration {
+	return time.Duration(i.Time * float64(time.Second))
+}
+
+// TimeBreakdown is the time of a sequence in seconds, by activity.
+type TimeBreakdown struct {
+	Driving int `json:"driving"`
+	Service int `json:"service"`
+	Rest    int `json:"rest"`
+	Waiting int `json:"waiting"`
+}
+
+// FindSequence returns the optimized order of visiting the destinations between start and end, with the times and
+// distances of the legs between the waypoints.
+// See https://developer.here.com/documentation/routing-waypoints/dev_guide/index.html
+// for details about other parameters.
+func (s *SequenceService) FindSequence(
+	ctx context.Context,
+	req *FindSequenceRequest,
+) (_ *FindSequenceResponse, err error) {
+	defer func() {
+		if err != nil {
+			err = fmt.Errorf("find sequence: %w", err)
+		}
+	}()
+	query, err := req.QueryString()
+	if err != nil {
+		return nil, err
+	}
+	u, err := s.URL.Parse("findsequence2")
+	if err != nil {
+		return nil, err
+	}
+	r, err := s.Client.NewRequest(ctx, u, http.MethodGet, query)
+	if err != nil {
+		return nil, err
+	}
+	var resp FindSequenceResponse
+	if err := s.Client.Do(r, &resp); err != nil {
+		return nil, err
+	}
+	if len(resp.Errors) > 0 {
+		return nil, errors.New(strings.Join(resp.Errors, ", "))
+	}
+	return &resp, nil
+}
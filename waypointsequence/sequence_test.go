@@ -0,0 +1,177 @@
+package waypointsequence_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"go.einride.tech/here/waypointsequence"
+	"gotest.tools/v3/assert"
+)
+
+type httpClientFunc func(*http.Request) (*http.Response, error)
+
+func (f httpClientFunc) Do(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestSequenceService_FindSequence(t *testing.T) {
+	t.Parallel()
+	var requested *url.URL
+	client := waypointsequence.NewClient(httpClientFunc(func(req *http.Request) (*http.Response, error) {
+		requested = req.URL
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(`{
+			"results": [{
+				"waypoints": [
+					{"id": "depot", "lat": 52.5, "lng": 13.4, "sequence": 0, "estimatedArrival": null,
+						"estimatedDeparture": "2022-06-01T08:00:00+02:00", "fulfilledConstraints": []},
+					{"id": "b", "lat": 52.52, "lng": 13.41, "sequence": 2,
+						"estimatedArrival": "2022-06-01T08:20:00+02:00", "estimatedDeparture": null,
+						"fulfilledConstraints": ["st:300"]},
+					{"id": "a", "lat": 52.51, "lng": 13.39, "sequence": 1,
+						"estimatedArrival": "2022-06-01T08:10:00+02:00",
+						"estimatedDeparture": "2022-06-01T08:15:00+02:00",
+						"fulfilledConstraints": ["acc:mo08:00:00+02:00|mo18:00:00+02:00", "st:300"]}
+				],
+				"distance": "4200",
+				"time": "1200",
+				"interconnections": [
+					{"fromWaypoint": "depot", "toWaypoint": "a", "distance": 2000.0, "time": 600.0, "rest": 0.0,
+						"waiting": 0.0},
+					{"fromWaypoint": "a", "toWaypoint": "b", "distance": 2200.0, "time": 300.0, "rest": 0.0,
+						"waiting": 0.0}
+				],
+				"description": "Targeted best time; without traffic",
+				"timeBreakdown": {"driving": 900, "service": 300, "rest": 0, "waiting": 0}
+			}],
+			"errors": [],
+			"responseCode": "200"
+		}`))}, nil
+	}))
+	monday := time.Date(2022, 5, 30, 8, 0, 0, 0, time.FixedZone("CEST", 2*60*60))
+	resp, err := client.Sequence.FindSequence(context.Background(), &waypointsequence.FindSequenceRequest{
+		Start: waypointsequence.Waypoint{ID: "depot", Location: waypointsequence.GeoWaypoint{Lat: 52.5, Long: 13.4}},
+		Destinations: []waypointsequence.Waypoint{
+			{
+				ID:          "a",
+				Location:    waypointsequence.GeoWaypoint{Lat: 52.51, Long: 13.39},
+				TimeWindow:  &waypointsequence.TimeWindow{Start: monday, End: monday.Add(10 * time.Hour)},
+				ServiceTime: 5 * time.Minute,
+			},
+			{ID: "b", Location: waypointsequence.GeoWaypoint{Lat: 52.52, Long: 13.41}, ServiceTime: 5 * time.Minute},
+		},
+		ImproveFor: waypointsequence.ImproveForTime,
+	})
+	assert.NilError(t, err)
+	assert.Equal(t, "/v8/findsequence2", requested.Path)
+	query := requested.Query()
+	assert.Equal(t, "depot;52.5,13.4", query.Get("start"))
+	assert.Equal(t, "a;52.51,13.39;acc:mo08:00:00+02:00|mo18:00:00+02:00;st:300", query.Get("destination1"))
+	assert.Equal(t, "b;52.52,13.41;st:300", query.Get("destination2"))
+	assert.Equal(t, "", query.Get("end"))
+	assert.Equal(t, "fastest;car;traffic:disabled", query.Get("mode"))
+	assert.Equal(t, "time", query.Get("improveFor"))
+	sequence := resp.Results[0]
+	assert.DeepEqual(t, []string{"depot", "a", "b"}, sequence.Order())
+	assert.Equal(t, "4200", sequence.Distance.String())
+	assert.Equal(t, 10*time.Minute, sequence.Interconnections[0].Duration())
+	assert.Assert(t, sequence.Waypoints[0].EstimatedArrival == nil)
+	assert.Equal(t, 300, sequence.TimeBreakdown.Service)
+}
+
+func TestSequenceService_FindSequence_Errors(t *testing.T) {
+	t.Parallel()
+	client := waypointsequence.NewClient(httpClientFunc(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(`{
+			"results": [],
+			"errors": ["No route found between waypoints depot and a"],
+			"responseCode": "400"
+		}`))}, nil
+	}))
+	_, err := client.Sequence.FindSequence(context.Background(), &waypointsequence.FindSequenceRequest{
+		Start:        waypointsequence.Waypoint{ID: "depot"},
+		Destinations: []waypointsequence.Waypoint{{ID: "a"}},
+	})
+	assert.Error(t, err, "find sequence: No route found between waypoints depot and a")
+}
+
+func TestFindSequenceRequest_QueryString(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2022, 6, 1, 8, 0, 0, 0, time.UTC)
+	for _, tt := range []struct {
+		name     string
+		request  waypointsequence.FindSequenceRequest
+		expected string
+		err      string
+	}{
+		{
+			name: "end and truck",
+			request: waypointsequence.FindSequenceRequest{
+				Start:         waypointsequence.Waypoint{ID: "s"},
+				End:           &waypointsequence.Waypoint{ID: "e", Location: waypointsequence.GeoWaypoint{Lat: 1, Long: 2}},
+				Destinations:  []waypointsequence.Waypoint{{ID: "d"}},
+				TransportMode: waypointsequence.TransportModeTruck,
+				Traffic:       true,
+				DepartureTime: now,
+			},
+			expected: "departure=2022-06-01T08%3A00%3A00Z&destination1=d%3B0%2C0&end=e%3B1%2C2" +
+				"&mode=fastest%3Btruck%3Btraffic%3Aenabled&start=s%3B0%2C0",
+		},
+		{
+			name:    "no destinations",
+			request: waypointsequence.FindSequenceRequest{Start: waypointsequence.Waypoint{ID: "s"}},
+			err:     "invalid destinations: at least one destination required",
+		},
+		{
+			name: "too many destinations",
+			request: waypointsequence.FindSequenceRequest{
+				Start:        waypointsequence.Waypoint{ID: "s"},
+				Destinations: make([]waypointsequence.Waypoint, waypointsequence.MaxDestinations+1),
+			},
+			err: "invalid destinations: at most 120 destinations allowed, got 121",
+		},
+		{
+			name: "duplicate id",
+			request: waypointsequence.FindSequenceRequest{
+				Start:        waypointsequence.Waypoint{ID: "s"},
+				Destinations: []waypointsequence.Waypoint{{ID: "s"}},
+			},
+			err: `invalid destination1: duplicate id "s"`,
+		},
+		{
+			name: "empty time window",
+			request: waypointsequence.FindSequenceRequest{
+				Start: waypointsequence.Waypoint{ID: "s"},
+				Destinations: []waypointsequence.Waypoint{
+					{ID: "d", TimeWindow: &waypointsequence.TimeWindow{Start: now, End: now}},
+				},
+			},
+			err: `invalid destination1: time window of "d" ends before it starts`,
+		},
+		{
+			name: "invalid improve for",
+			request: waypointsequence.FindSequenceRequest{
+				Start:        waypointsequence.Waypoint{ID: "s"},
+				Destinations: []waypointsequence.Waypoint{{ID: "d"}},
+				ImproveFor:   42,
+			},
+			err: "invalid improve for: 42",
+		},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := tt.request.QueryString()
+			if tt.err != "" {
+				assert.Error(t, err, tt.err)
+				return
+			}
+			assert.NilError(t, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
@@ -0,0 +1,121 @@
+package waypointsequence
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	invalid     = "invalid"
+	unspecified = "unspecified"
+)
+
+type GeoWaypoint struct {
+	Lat  float64 `json:"lat"`
+	Long float64 `json:"lng"`
+}
+
+func (w GeoWaypoint) String() string {
+	return strconv.FormatFloat(w.Lat, 'f', -1, 64) + "," + strconv.FormatFloat(w.Long, 'f', -1, 64)
+}
+
+// Waypoint is a stop of a sequence.
+type Waypoint struct {
+	// ID of the waypoint, unique within the request.
+	ID string
+	// Location of the waypoint.
+	Location GeoWaypoint
+	// TimeWindow when the waypoint can be visited. The waypoint can be visited any time when nil.
+	TimeWindow *TimeWindow
+	// ServiceTime spent at the waypoint, rounded to seconds.
+	ServiceTime time.Duration
+}
+
+// String returns the waypoint in the format of the waypoint query parameters, e.g.
+// "customer1;52.5,13.4;acc:mo08:00:00+02:00|mo18:00:00+02:00;st:300".
+func (w Waypoint) String() string {
+	var b strings.Builder
+	b.WriteString(w.ID)
+	b.WriteString(";")
+	b.WriteString(w.Location.String())
+	if w.TimeWindow != nil {
+		b.WriteString(";acc:")
+		b.WriteString(w.TimeWindow.String())
+	}
+	if seconds := int64(w.ServiceTime / time.Second); seconds > 0 {
+		b.WriteString(";st:")
+		b.WriteString(strconv.FormatInt(seconds, 10))
+	}
+	return b.String()
+}
+
+// TimeWindow is a weekly recurring window of time, defined by the weekday, time of day and offset of its start and
+// end times.
+type TimeWindow struct {
+	Start time.Time
+	End   time.Time
+}
+
+// String returns the time window in the format of the acc constraint, e.g. "mo08:00:00+02:00|mo18:00:00+02:00".
+func (w TimeWindow) String() string {
+	return formatWeekTime(w.Start) + "|" + formatWeekTime(w.End)
+}
+
+var weekdays = [...]string{"su", "mo", "tu", "we", "th", "fr", "sa"}
+
+func formatWeekTime(t time.Time) string {
+	return weekdays[t.Weekday()] + t.Format("15:04:05-07:00")
+}
+
+// TransportMode of the vehicle visiting the waypoints.
+type TransportMode int
+
+const (
+	TransportModeUnspecified TransportMode = iota
+	TransportModeCar
+	TransportModeTruck
+	TransportModePedestrian
+	TransportModeBicycle
+)
+
+func (t TransportMode) String() string {
+	switch t {
+	case TransportModeUnspecified:
+		return unspecified
+	case TransportModeCar:
+		return "car"
+	case TransportModeTruck:
+		return "truck"
+	case TransportModePedestrian:
+		return "pedestrian"
+	case TransportModeBicycle:
+		return "bicycle"
+	default:
+		return invalid
+	}
+}
+
+// ImproveFor is the objective of the sequence optimization.
+type ImproveFor int
+
+const (
+	ImproveForUnspecified ImproveFor = iota
+	// ImproveForTime minimizes the total time of the sequence.
+	ImproveForTime
+	// ImproveForDistance minimizes the total distance of the sequence.
+	ImproveForDistance
+)
+
+func (i ImproveFor) String() string {
+	switch i {
+	case ImproveForUnspecified:
+		return unspecified
+	case ImproveForTime:
+		return "time"
+	case ImproveForDistance:
+		return "distance"
+	default:
+		return invalid
+	}
+}